@@ -2,85 +2,225 @@ package main
 
 import (
 	"context"
+	"errors"
+	"flag"
 	"fmt"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/vestamart/loms/internal/app/loms"
+	"github.com/vestamart/loms/internal/app/outbox"
+	"github.com/vestamart/loms/internal/app/sweeper"
 	"github.com/vestamart/loms/internal/config"
 	"github.com/vestamart/loms/internal/delivery"
+	"github.com/vestamart/loms/internal/gateway"
+	"github.com/vestamart/loms/internal/health"
+	"github.com/vestamart/loms/internal/kafka"
+	"github.com/vestamart/loms/internal/lifecycle"
+	"github.com/vestamart/loms/internal/logger"
+	"github.com/vestamart/loms/internal/metrics"
 	"github.com/vestamart/loms/internal/mw"
-	"github.com/vestamart/loms/internal/repository/postgres"
+	"github.com/vestamart/loms/internal/tracing"
 	desc "github.com/vestamart/loms/pkg/api/loms/v1"
 	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
 	"log"
+	"log/slog"
 	"net"
+	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
-	"time"
 )
 
 func main() {
+	if err := run(); err != nil {
+		log.Fatal(err)
+	}
+	log.Println("Server gracefully stopped")
+}
+
+// run собирает и запускает сервис. Все созданные ресурсы регистрируются в lifecycle.Manager
+// и освобождаются при выходе из run, в том числе при ошибке запуска
+func run() (err error) {
+	configPath := flag.String("config", "config.yaml", "path to the YAML config, empty to use defaults and environment only")
+	flag.Parse()
+
+	cfg, err := config.LoadConfig(*configPath)
+	if err != nil {
+		return err
+	}
+
+	appLogger, err := logger.New(os.Stdout, cfg.Logger)
+	if err != nil {
+		return err
+	}
+	slog.SetDefault(appLogger)
 	log.Println("App started")
-	cfg, err := config.LoadConfig("config.yaml")
+
+	app := lifecycle.NewManager(cfg.Shutdown.CloseTimeout)
+	defer func() {
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.Shutdown.Timeout)
+		defer cancel()
+		err = errors.Join(err, app.Shutdown(shutdownCtx))
+	}()
+
+	shutdownTracing, err := tracing.Init(context.Background(), cfg.Tracing)
 	if err != nil {
-		log.Fatal(err)
+		return err
 	}
+	app.OnClose("tracing", shutdownTracing)
 
-	lis, err := net.Listen("tcp", fmt.Sprintf(":%s", cfg.LOMSServer.Port))
+	store, err := newStorage(cfg, app)
 	if err != nil {
-		log.Fatal(err)
+		return err
 	}
 
-	grpcServer := grpc.NewServer(grpc.ChainUnaryInterceptor(
-		mw.Panic,
-		mw.Logger,
-	))
-
-	dsn := fmt.Sprintf("postgres://%s:%s@%s:%s/%s?sslmode=%s",
-		cfg.Database.User,
-		cfg.Database.Password,
-		cfg.Database.Host,
-		cfg.Database.Port,
-		cfg.Database.DBName,
-		cfg.Database.SSLMode,
-	)
+	producer, err := newProducer(cfg, app)
+	if err != nil {
+		return err
+	}
 
-	dbConn, err := mw.ConnectWithRetry(context.Background(), dsn, 10, 5*time.Second)
+	lis, err := net.Listen("tcp", fmt.Sprintf(":%s", cfg.LOMSServer.Port))
 	if err != nil {
-		log.Fatal("Failed to connect to database: " + err.Error())
+		return err
 	}
-	defer dbConn.Close(context.Background())
 
-	orderRepoPostgres := postgres.NewOrderRepositoryPostgres(dbConn)
-	//ordersRepo := repository.NewInMemoryOrderRepository(100)
-	//stocksRepo, err := repository.NewInMemoryStocksRepositoryFromFile()
-	stocksRepoPostgres := postgres.NewStocksRepositoryPostgres(dbConn)
-	//if err != nil {
-	//	panic(err)
-	//}
-	service := loms.NewService(orderRepoPostgres, stocksRepoPostgres)
+	grpcServer := grpc.NewServer(mw.ServerOptions(mw.ChainConfig{
+		LogPayloads:            cfg.Logger.LogPayloads,
+		RedactFields:           cfg.Logger.RedactFields,
+		IdempotencyStore:       store.idempotency,
+		IdempotencyLockTimeout: cfg.Idempotency.LockTimeout,
+		IdempotencyTTL:         cfg.Idempotency.TTL,
+	})...)
+
+	strategy, err := loms.ParseReservationStrategy(cfg.Stocks.ReservationStrategy)
+	if err != nil {
+		return err
+	}
+	service := loms.NewService(
+		metrics.NewOrdersRepository(store.orders),
+		metrics.NewStocksStorage(store.stocks),
+		store.txManager,
+		strategy,
+	)
+	businessCollector := metrics.NewBusinessCollector(store.orders, store.stocks, cfg.AdminServer.CollectTimeout, cfg.AdminServer.CollectInterval)
+	prometheus.MustRegister(businessCollector)
 
 	controller := delivery.NewServer(*service)
 
 	desc.RegisterLomsServer(grpcServer, controller)
 
-	// Graceful shutdown setup
-	stop := make(chan os.Signal, 1)
-	signal.Notify(stop, os.Interrupt, syscall.SIGTERM)
+	healthChecker := health.NewChecker(store.pinger, health.Config{
+		Interval: cfg.Health.CheckInterval,
+		Timeout:  cfg.Health.CheckTimeout,
+	}, desc.Loms_ServiceDesc.ServiceName)
+	healthpb.RegisterHealthServer(grpcServer, healthChecker.Server())
+	if cfg.LOMSServer.Reflection {
+		reflection.Register(grpcServer)
+	}
+
+	relay := outbox.NewRelay(store.outbox, producer, store.txManager, outbox.Config{
+		Interval:    cfg.Outbox.RelayInterval,
+		MaxBackoff:  cfg.Outbox.MaxBackoff,
+		BatchSize:   cfg.Outbox.BatchSize,
+		LockTimeout: cfg.Outbox.LockTimeout,
+		MaxAttempts: cfg.Outbox.MaxAttempts,
+	})
+
+	orderSweeper := sweeper.NewSweeper(service, sweeper.Config{
+		Interval:       cfg.Orders.SweepInterval,
+		PaymentTimeout: cfg.Orders.PaymentTimeout,
+		BatchSize:      cfg.Orders.SweepBatchSize,
+		MaxAttempts:    cfg.Orders.SweepMaxAttempts,
+	})
+
+	// Воркеры останавливаются после gRPC и HTTP серверов, но до закрытия продюсера и пула
+	app.OnShutdown("workers", app.StopWorkers)
+	app.Go("outbox relay", relay.Run)
+	app.Go("order sweeper", orderSweeper.Run)
+	app.Go("health checker", healthChecker.Run)
+	app.Go("business metrics", businessCollector.Run)
+
+	gatewayHandler, err := gateway.NewHandler(app.Context(), fmt.Sprintf("localhost:%s", cfg.LOMSServer.Port))
+	if err != nil {
+		return err
+	}
+	httpServer := &http.Server{
+		Addr:    fmt.Sprintf(":%s", cfg.HTTPServer.Port),
+		Handler: gatewayHandler,
+	}
+
+	adminMux := http.NewServeMux()
+	adminMux.Handle("/metrics", promhttp.Handler())
+	adminMux.Handle("/healthz", healthChecker.LivenessHandler())
+	adminMux.Handle("/readyz", healthChecker.ReadinessHandler())
+	adminServer := &http.Server{
+		Addr:    fmt.Sprintf(":%s", cfg.AdminServer.Port),
+		Handler: adminMux,
+	}
+
+	serveErr := make(chan error, 3)
+
+	go func() {
+		log.Printf("Admin server running on port: %s", cfg.AdminServer.Port)
+		if err := adminServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			serveErr <- fmt.Errorf("failed to serve admin HTTP: %w", err)
+		}
+	}()
+	app.OnShutdown("admin server", adminServer.Shutdown)
 
 	go func() {
 		log.Printf("Server running on port: %s", cfg.LOMSServer.Port)
-		if err = grpcServer.Serve(lis); err != nil {
-			log.Fatalf("Failed to serve: %v", err)
+		if err := grpcServer.Serve(lis); err != nil {
+			serveErr <- fmt.Errorf("failed to serve: %w", err)
 		}
 	}()
+	app.OnShutdown("grpc server", func(ctx context.Context) error {
+		return lifecycle.StopGRPC(ctx, grpcServer)
+	})
 
-	<-stop
-	log.Println("Shutdown signal received")
+	go func() {
+		log.Printf("HTTP gateway running on port: %s", cfg.HTTPServer.Port)
+		if err := httpServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			serveErr <- fmt.Errorf("failed to serve HTTP: %w", err)
+		}
+	}()
+	app.OnShutdown("http gateway", httpServer.Shutdown)
 
-	// Graceful shutdown
-	_, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-	defer cancel()
+	// Первым шагом остановки сервис перестает быть ready, чтобы балансировщик перестал слать запросы
+	app.OnShutdown("health", func(context.Context) error {
+		healthChecker.Shutdown()
+		return nil
+	})
 
-	grpcServer.GracefulStop()
-	log.Println("Server gracefully stopped")
+	// Graceful shutdown setup
+	stop := make(chan os.Signal, 1)
+	signal.Notify(stop, os.Interrupt, syscall.SIGTERM)
+
+	select {
+	case <-stop:
+		log.Println("Shutdown signal received")
+		return nil
+	case err = <-serveErr:
+		return err
+	}
+}
+
+// newProducer подключается к Kafka. Для memory события живут только в процессе, поэтому
+// Kafka не требуется и события пишутся в лог; relay при этом продолжает разбирать outbox
+func newProducer(cfg *config.Config, app *lifecycle.Manager) (outbox.Producer, error) {
+	if cfg.Storage.Backend == config.StorageBackendMemory {
+		slog.Info("kafka is disabled", "storage_backend", cfg.Storage.Backend)
+		return kafka.LogProducer{}, nil
+	}
+
+	producer, err := kafka.NewProducer(cfg.Kafka.Brokers, cfg.Kafka.Topic)
+	if err != nil {
+		return nil, err
+	}
+	app.OnClose("kafka producer", func(context.Context) error {
+		return producer.Close()
+	})
+	return producer, nil
 }
@@ -0,0 +1,106 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/vestamart/loms/internal/app/loms"
+	"github.com/vestamart/loms/internal/app/outbox"
+	"github.com/vestamart/loms/internal/config"
+	"github.com/vestamart/loms/internal/health"
+	"github.com/vestamart/loms/internal/lifecycle"
+	"github.com/vestamart/loms/internal/metrics"
+	"github.com/vestamart/loms/internal/mw"
+	"github.com/vestamart/loms/internal/repository"
+	"github.com/vestamart/loms/internal/repository/postgres"
+	"github.com/vestamart/loms/internal/tracing"
+)
+
+type ordersStorage interface {
+	loms.OrdersRepository
+	metrics.OrderStats
+}
+
+type stocksStorage interface {
+	loms.StocksStorage
+	metrics.StockStats
+}
+
+// storage репозитории бэкенда, выбранного в storage.backend
+type storage struct {
+	orders      ordersStorage
+	stocks      stocksStorage
+	outbox      outbox.Storage
+	idempotency mw.IdempotencyStore
+	txManager   loms.TxManager
+	pinger      health.Pinger
+}
+
+func newStorage(cfg *config.Config, app *lifecycle.Manager) (*storage, error) {
+	switch cfg.Storage.Backend {
+	case config.StorageBackendMemory:
+		return newMemoryStorage(cfg.Storage)
+	case config.StorageBackendPostgres:
+		return newPostgresStorage(cfg.Database, app)
+	}
+	return nil, fmt.Errorf("unknown storage backend %q", cfg.Storage.Backend)
+}
+
+// newPostgresStorage подключается к базе. Закрытие пула и воркер статистики регистрируются в app,
+// статистика пула отдается на /metrics
+func newPostgresStorage(cfg config.DatabaseConfig, app *lifecycle.Manager) (*storage, error) {
+	poolCfg, err := mw.NewPoolConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	poolCfg.ConnConfig.Tracer = tracing.NewQueryTracer()
+
+	dbPool, err := mw.ConnectWithRetry(context.Background(), poolCfg, cfg.ConnectAttempts, cfg.ConnectDelay, cfg.ConnectMaxDelay)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to database: %w", err)
+	}
+	app.OnClose("database", func(context.Context) error {
+		dbPool.Close()
+		return nil
+	})
+	app.Go("pool stats", func(ctx context.Context) {
+		mw.LogPoolStats(ctx, dbPool, cfg.StatsInterval)
+	})
+	prometheus.MustRegister(metrics.NewPoolCollector(dbPool))
+
+	return &storage{
+		orders:      postgres.NewOrderRepositoryPostgres(dbPool),
+		stocks:      postgres.NewStocksRepositoryPostgres(dbPool),
+		outbox:      postgres.NewOutboxRepositoryPostgres(dbPool),
+		idempotency: postgres.NewIdempotencyRepositoryPostgres(dbPool),
+		txManager:   postgres.NewTxManager(dbPool),
+		pinger:      dbPool,
+	}, nil
+}
+
+// newMemoryStorage создает in-memory репозитории, данные теряются при перезапуске
+func newMemoryStorage(cfg config.StorageConfig) (*storage, error) {
+	stocks, err := repository.NewInMemoryStocksRepository(cfg.StocksSeedFile)
+	if err != nil {
+		return nil, err
+	}
+	outboxRepo := repository.NewInMemoryOutboxRepository()
+	orders := repository.NewInMemoryOrderRepository(100, outboxRepo)
+
+	return &storage{
+		orders:      orders,
+		stocks:      stocks,
+		outbox:      outboxRepo,
+		idempotency: repository.NewInMemoryIdempotencyRepository(),
+		txManager:   repository.NewInMemoryTxManager(),
+		pinger:      alwaysAvailable{},
+	}, nil
+}
+
+// alwaysAvailable - Pinger для in-memory бэкенда, которому не нужны внешние соединения
+type alwaysAvailable struct{}
+
+func (alwaysAvailable) Ping(context.Context) error {
+	return nil
+}
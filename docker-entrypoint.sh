@@ -0,0 +1,20 @@
+#!/bin/sh
+# Применяет миграции и запускает сервис. Подключение для goose собирается из тех же
+# переменных окружения и файла с паролем, что читает сервис (см. internal/config)
+set -eu
+
+password="${LOMS_DATABASE_PASSWORD:-${POSTGRES_PASSWORD:-}}"
+password_file="${LOMS_DATABASE_PASSWORD_FILE:-${POSTGRES_PASSWORD_FILE:-}}"
+if [ -n "$password_file" ]; then
+	password="$(cat "$password_file")"
+fi
+
+# pgx читает параметры подключения из переменных PG*, поэтому пароль не попадает в командную строку
+export PGHOST="${LOMS_DATABASE_HOST:-${POSTGRES_HOST:-postgres}}"
+export PGPORT="${LOMS_DATABASE_PORT:-${POSTGRES_PORT:-5432}}"
+export PGUSER="${LOMS_DATABASE_USER:-${POSTGRES_USER:-root}}"
+export PGDATABASE="${LOMS_DATABASE_NAME:-${POSTGRES_DB:-loms_db}}"
+export PGPASSWORD="$password"
+
+goose -dir ./migrations postgres "sslmode=${LOMS_DATABASE_SSLMODE:-disable}" up
+exec ./loms-service
@@ -0,0 +1,118 @@
+package loms_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vestamart/loms/internal/domain"
+	"github.com/vestamart/loms/internal/localErr"
+	desc "github.com/vestamart/loms/pkg/api/loms/v1"
+)
+
+func TestCancelExpiredReleasesStocks(t *testing.T) {
+	svc, orders, stocks := newInMemoryService(t)
+	ctx := context.Background()
+
+	ids := make([]int64, 0, 3)
+	for i := 0; i < 3; i++ {
+		resp, err := svc.OrderCreate(ctx, &desc.OrderCreateRequest{User: 1, Items: []*desc.Item{{Sku: 1002, Count: 10}}})
+		require.NoError(t, err)
+		ids = append(ids, resp.OrderId)
+	}
+	_, err := svc.OrderPay(ctx, &desc.OrderPayRequest{OrderID: ids[0]})
+	require.NoError(t, err)
+
+	cancelled, failed, err := svc.CancelExpired(ctx, time.Now().Add(time.Minute), nil, 10)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, ids[1:], cancelled)
+	assert.Empty(t, failed)
+
+	for _, id := range ids[1:] {
+		order, err := orders.GetByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, domain.Cancelled, order.Status)
+	}
+	got, err := stocks.GetBySKUs(ctx, []uint32{1002})
+	require.NoError(t, err)
+	assert.Equal(t, domain.StocksItem{TotalCount: 190, Reserved: 20}, got[1002])
+
+	cancelled, failed, err = svc.CancelExpired(ctx, time.Now().Add(time.Minute), nil, 10)
+	require.NoError(t, err)
+	assert.Empty(t, cancelled)
+	assert.Empty(t, failed)
+}
+
+func TestCancelExpiredPartialFailure(t *testing.T) {
+	svc, orders, stocks := newInMemoryService(t)
+	ctx := context.Background()
+
+	broken, err := svc.OrderCreate(ctx, &desc.OrderCreateRequest{User: 1, Items: []*desc.Item{{Sku: 1003, Count: 5}}})
+	require.NoError(t, err)
+	healthy, err := svc.OrderCreate(ctx, &desc.OrderCreateRequest{User: 1, Items: []*desc.Item{{Sku: 1002, Count: 5}}})
+	require.NoError(t, err)
+
+	// Резерв 1003 снят в обход заказа, поэтому вернуть его при отмене не получится
+	key := domain.StockKey{WarehouseID: domain.DefaultWarehouseID, Sku: 1003}
+	require.NoError(t, stocks.ReserveCancel(ctx, map[domain.StockKey]uint32{key: 35}))
+
+	cancelled, failed, err := svc.CancelExpired(ctx, time.Now().Add(time.Minute), nil, 10)
+	assert.ErrorIs(t, err, localErr.ReservedNotEnoughErr)
+	assert.Equal(t, []int64{healthy.OrderId}, cancelled)
+	assert.Equal(t, []int64{broken.OrderId}, failed)
+
+	order, err := orders.GetByID(ctx, broken.OrderId)
+	require.NoError(t, err)
+	assert.Equal(t, domain.AwaitingPayment, order.Status, "failed cancel must be rolled back")
+
+	cancelled, failed, err = svc.CancelExpired(ctx, time.Now().Add(time.Minute), []int64{broken.OrderId}, 10)
+	require.NoError(t, err)
+	assert.Empty(t, cancelled)
+	assert.Empty(t, failed, "skipped order must not be retried")
+}
+
+func TestCancelExpiredConcurrentReplicas(t *testing.T) {
+	svc, _, stocks := newInMemoryService(t)
+	ctx := context.Background()
+
+	const orderCount = 20
+	for i := 0; i < orderCount; i++ {
+		_, err := svc.OrderCreate(ctx, &desc.OrderCreateRequest{User: 1, Items: []*desc.Item{{Sku: 1002, Count: 1}}})
+		require.NoError(t, err)
+	}
+
+	var mu sync.Mutex
+	seen := make(map[int64]int)
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				cancelled, failed, err := svc.CancelExpired(ctx, time.Now().Add(time.Minute), nil, 3)
+				assert.NoError(t, err)
+				assert.Empty(t, failed)
+				if len(cancelled) == 0 {
+					return
+				}
+				mu.Lock()
+				for _, id := range cancelled {
+					seen[id]++
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.Len(t, seen, orderCount)
+	for id, n := range seen {
+		assert.Equal(t, 1, n, "order %d cancelled more than once", id)
+	}
+	got, err := stocks.GetBySKUs(ctx, []uint32{1002})
+	require.NoError(t, err)
+	assert.Equal(t, domain.StocksItem{TotalCount: 200, Reserved: 20}, got[1002])
+}
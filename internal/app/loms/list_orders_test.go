@@ -0,0 +1,136 @@
+package loms_test
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vestamart/loms/internal/app/loms"
+	"github.com/vestamart/loms/internal/app/loms/mock"
+	"github.com/vestamart/loms/internal/domain"
+	"github.com/vestamart/loms/internal/localErr"
+	desc "github.com/vestamart/loms/pkg/api/loms/v1"
+)
+
+func TestListOrdersPagination(t *testing.T) {
+	svc, _, _ := newInMemoryService(t)
+	ctx := context.Background()
+
+	created := make([]int64, 0, 5)
+	for i := 0; i < 5; i++ {
+		resp, err := svc.OrderCreate(ctx, &desc.OrderCreateRequest{User: 1, Items: []*desc.Item{{Sku: 1002, Count: 1}}})
+		require.NoError(t, err)
+		created = append(created, resp.OrderId)
+	}
+
+	var (
+		listed []int64
+		pages  int
+		token  string
+	)
+	for {
+		resp, err := svc.ListOrders(ctx, &desc.ListOrdersRequest{User: 1, PageSize: 2, PageToken: token})
+		require.NoError(t, err)
+		pages++
+		for _, order := range resp.Orders {
+			listed = append(listed, order.OrderId)
+		}
+		if resp.NextPageToken == "" {
+			break
+		}
+		token = resp.NextPageToken
+	}
+	assert.Equal(t, 3, pages)
+	assert.ElementsMatch(t, created, listed)
+
+	// Ровно заполненная страница не должна возвращать токен на пустую следующую
+	resp, err := svc.ListOrders(ctx, &desc.ListOrdersRequest{User: 1, PageSize: 5})
+	require.NoError(t, err)
+	assert.Len(t, resp.Orders, 5)
+	assert.Empty(t, resp.NextPageToken)
+}
+
+func TestListOrdersPageSize(t *testing.T) {
+	tests := []struct {
+		name      string
+		pageSize  uint32
+		wantLimit int32
+	}{
+		{name: "default", pageSize: 0, wantLimit: 51},
+		{name: "explicit", pageSize: 10, wantLimit: 11},
+		{name: "max", pageSize: 500, wantLimit: 501},
+		{name: "clamped", pageSize: 10000, wantLimit: 501},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := minimock.NewController(t)
+			orders := mock.NewOrdersRepositoryMock(mc)
+			svc := loms.NewService(orders, nil, nil, loms.NearestFirst)
+
+			orders.ListMock.Expect(minimock.AnyContext, domain.OrderFilter{UserID: 1, Limit: tt.wantLimit}).Return(nil, nil)
+
+			resp, err := svc.ListOrders(context.Background(), &desc.ListOrdersRequest{User: 1, PageSize: tt.pageSize})
+			require.NoError(t, err)
+			assert.Empty(t, resp.Orders)
+			assert.Empty(t, resp.NextPageToken)
+		})
+	}
+}
+
+func TestListOrdersPageTokenRoundTrip(t *testing.T) {
+	mc := minimock.NewController(t)
+	orders := mock.NewOrdersRepositoryMock(mc)
+	svc := loms.NewService(orders, nil, nil, loms.NearestFirst)
+
+	base := time.Date(2025, 4, 1, 12, 0, 0, 123456789, time.UTC)
+	page := []domain.Order{
+		{ID: 3, UserID: 1, CreatedAt: base.Add(2 * time.Second)},
+		{ID: 2, UserID: 1, CreatedAt: base},
+		{ID: 1, UserID: 1, CreatedAt: base.Add(-time.Second)},
+	}
+
+	var filters []domain.OrderFilter
+	orders.ListMock.Set(func(_ context.Context, filter domain.OrderFilter) ([]domain.Order, error) {
+		filters = append(filters, filter)
+		if filter.After == nil {
+			return page, nil
+		}
+		return page[2:], nil
+	})
+
+	resp, err := svc.ListOrders(context.Background(), &desc.ListOrdersRequest{User: 1, PageSize: 2})
+	require.NoError(t, err)
+	require.Len(t, resp.Orders, 2)
+	require.NotEmpty(t, resp.NextPageToken)
+
+	resp, err = svc.ListOrders(context.Background(), &desc.ListOrdersRequest{User: 1, PageSize: 2, PageToken: resp.NextPageToken})
+	require.NoError(t, err)
+	require.Len(t, resp.Orders, 1)
+	assert.Empty(t, resp.NextPageToken)
+
+	require.Len(t, filters, 2)
+	require.NotNil(t, filters[1].After)
+	assert.EqualValues(t, 2, filters[1].After.ID)
+	assert.True(t, base.Equal(filters[1].After.CreatedAt), "cursor must keep nanosecond precision")
+}
+
+func TestListOrdersInvalidPageToken(t *testing.T) {
+	mc := minimock.NewController(t)
+	svc := loms.NewService(mock.NewOrdersRepositoryMock(mc), nil, nil, loms.NearestFirst)
+
+	encode := func(raw string) string { return base64.RawURLEncoding.EncodeToString([]byte(raw)) }
+	for _, token := range []string{
+		"not base64!",
+		encode("no separator"),
+		encode("time:1"),
+		encode("1700000000:id"),
+	} {
+		_, err := svc.ListOrders(context.Background(), &desc.ListOrdersRequest{User: 1, PageToken: token})
+		assert.ErrorIs(t, err, localErr.InvalidPageTokenErr, token)
+	}
+}
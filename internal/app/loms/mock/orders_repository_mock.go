@@ -8,6 +8,7 @@ import (
 	"context"
 	"sync"
 	mm_atomic "sync/atomic"
+	"time"
 	mm_time "time"
 
 	"github.com/gojuno/minimock/v3"
@@ -33,9 +34,30 @@ type OrdersRepositoryMock struct {
 	beforeGetByIDCounter uint64
 	GetByIDMock          mOrdersRepositoryMockGetByID
 
-	funcSetStatus          func(ctx context.Context, orderID int64, status domain.OrderStatus) (err error)
+	funcHistory          func(ctx context.Context, orderID int64) (sa1 []domain.StatusChange, err error)
+	funcHistoryOrigin    string
+	inspectFuncHistory   func(ctx context.Context, orderID int64)
+	afterHistoryCounter  uint64
+	beforeHistoryCounter uint64
+	HistoryMock          mOrdersRepositoryMockHistory
+
+	funcList          func(ctx context.Context, filter domain.OrderFilter) (oa1 []domain.Order, err error)
+	funcListOrigin    string
+	inspectFuncList   func(ctx context.Context, filter domain.OrderFilter)
+	afterListCounter  uint64
+	beforeListCounter uint64
+	ListMock          mOrdersRepositoryMockList
+
+	funcListExpired          func(ctx context.Context, status domain.OrderStatus, updatedBefore time.Time, exclude []int64, limit int32) (ia1 []int64, err error)
+	funcListExpiredOrigin    string
+	inspectFuncListExpired   func(ctx context.Context, status domain.OrderStatus, updatedBefore time.Time, exclude []int64, limit int32)
+	afterListExpiredCounter  uint64
+	beforeListExpiredCounter uint64
+	ListExpiredMock          mOrdersRepositoryMockListExpired
+
+	funcSetStatus          func(ctx context.Context, orderID int64, expected domain.OrderStatus, status domain.OrderStatus, reason string) (err error)
 	funcSetStatusOrigin    string
-	inspectFuncSetStatus   func(ctx context.Context, orderID int64, status domain.OrderStatus)
+	inspectFuncSetStatus   func(ctx context.Context, orderID int64, expected domain.OrderStatus, status domain.OrderStatus, reason string)
 	afterSetStatusCounter  uint64
 	beforeSetStatusCounter uint64
 	SetStatusMock          mOrdersRepositoryMockSetStatus
@@ -55,6 +77,15 @@ func NewOrdersRepositoryMock(t minimock.Tester) *OrdersRepositoryMock {
 	m.GetByIDMock = mOrdersRepositoryMockGetByID{mock: m}
 	m.GetByIDMock.callArgs = []*OrdersRepositoryMockGetByIDParams{}
 
+	m.HistoryMock = mOrdersRepositoryMockHistory{mock: m}
+	m.HistoryMock.callArgs = []*OrdersRepositoryMockHistoryParams{}
+
+	m.ListMock = mOrdersRepositoryMockList{mock: m}
+	m.ListMock.callArgs = []*OrdersRepositoryMockListParams{}
+
+	m.ListExpiredMock = mOrdersRepositoryMockListExpired{mock: m}
+	m.ListExpiredMock.callArgs = []*OrdersRepositoryMockListExpiredParams{}
+
 	m.SetStatusMock = mOrdersRepositoryMockSetStatus{mock: m}
 	m.SetStatusMock.callArgs = []*OrdersRepositoryMockSetStatusParams{}
 
@@ -780,55 +811,53 @@ func (m *OrdersRepositoryMock) MinimockGetByIDInspect() {
 	}
 }
 
-type mOrdersRepositoryMockSetStatus struct {
+type mOrdersRepositoryMockHistory struct {
 	optional           bool
 	mock               *OrdersRepositoryMock
-	defaultExpectation *OrdersRepositoryMockSetStatusExpectation
-	expectations       []*OrdersRepositoryMockSetStatusExpectation
+	defaultExpectation *OrdersRepositoryMockHistoryExpectation
+	expectations       []*OrdersRepositoryMockHistoryExpectation
 
-	callArgs []*OrdersRepositoryMockSetStatusParams
+	callArgs []*OrdersRepositoryMockHistoryParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// OrdersRepositoryMockSetStatusExpectation specifies expectation struct of the OrdersRepository.SetStatus
-type OrdersRepositoryMockSetStatusExpectation struct {
+// OrdersRepositoryMockHistoryExpectation specifies expectation struct of the OrdersRepository.History
+type OrdersRepositoryMockHistoryExpectation struct {
 	mock               *OrdersRepositoryMock
-	params             *OrdersRepositoryMockSetStatusParams
-	paramPtrs          *OrdersRepositoryMockSetStatusParamPtrs
-	expectationOrigins OrdersRepositoryMockSetStatusExpectationOrigins
-	results            *OrdersRepositoryMockSetStatusResults
+	params             *OrdersRepositoryMockHistoryParams
+	paramPtrs          *OrdersRepositoryMockHistoryParamPtrs
+	expectationOrigins OrdersRepositoryMockHistoryExpectationOrigins
+	results            *OrdersRepositoryMockHistoryResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// OrdersRepositoryMockSetStatusParams contains parameters of the OrdersRepository.SetStatus
-type OrdersRepositoryMockSetStatusParams struct {
+// OrdersRepositoryMockHistoryParams contains parameters of the OrdersRepository.History
+type OrdersRepositoryMockHistoryParams struct {
 	ctx     context.Context
 	orderID int64
-	status  domain.OrderStatus
 }
 
-// OrdersRepositoryMockSetStatusParamPtrs contains pointers to parameters of the OrdersRepository.SetStatus
-type OrdersRepositoryMockSetStatusParamPtrs struct {
+// OrdersRepositoryMockHistoryParamPtrs contains pointers to parameters of the OrdersRepository.History
+type OrdersRepositoryMockHistoryParamPtrs struct {
 	ctx     *context.Context
 	orderID *int64
-	status  *domain.OrderStatus
 }
 
-// OrdersRepositoryMockSetStatusResults contains results of the OrdersRepository.SetStatus
-type OrdersRepositoryMockSetStatusResults struct {
+// OrdersRepositoryMockHistoryResults contains results of the OrdersRepository.History
+type OrdersRepositoryMockHistoryResults struct {
+	sa1 []domain.StatusChange
 	err error
 }
 
-// OrdersRepositoryMockSetStatusOrigins contains origins of expectations of the OrdersRepository.SetStatus
-type OrdersRepositoryMockSetStatusExpectationOrigins struct {
+// OrdersRepositoryMockHistoryOrigins contains origins of expectations of the OrdersRepository.History
+type OrdersRepositoryMockHistoryExpectationOrigins struct {
 	origin        string
 	originCtx     string
 	originOrderID string
-	originStatus  string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -836,330 +865,1522 @@ type OrdersRepositoryMockSetStatusExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmSetStatus *mOrdersRepositoryMockSetStatus) Optional() *mOrdersRepositoryMockSetStatus {
-	mmSetStatus.optional = true
-	return mmSetStatus
+func (mmHistory *mOrdersRepositoryMockHistory) Optional() *mOrdersRepositoryMockHistory {
+	mmHistory.optional = true
+	return mmHistory
 }
 
-// Expect sets up expected params for OrdersRepository.SetStatus
-func (mmSetStatus *mOrdersRepositoryMockSetStatus) Expect(ctx context.Context, orderID int64, status domain.OrderStatus) *mOrdersRepositoryMockSetStatus {
-	if mmSetStatus.mock.funcSetStatus != nil {
-		mmSetStatus.mock.t.Fatalf("OrdersRepositoryMock.SetStatus mock is already set by Set")
+// Expect sets up expected params for OrdersRepository.History
+func (mmHistory *mOrdersRepositoryMockHistory) Expect(ctx context.Context, orderID int64) *mOrdersRepositoryMockHistory {
+	if mmHistory.mock.funcHistory != nil {
+		mmHistory.mock.t.Fatalf("OrdersRepositoryMock.History mock is already set by Set")
 	}
 
-	if mmSetStatus.defaultExpectation == nil {
-		mmSetStatus.defaultExpectation = &OrdersRepositoryMockSetStatusExpectation{}
+	if mmHistory.defaultExpectation == nil {
+		mmHistory.defaultExpectation = &OrdersRepositoryMockHistoryExpectation{}
 	}
 
-	if mmSetStatus.defaultExpectation.paramPtrs != nil {
-		mmSetStatus.mock.t.Fatalf("OrdersRepositoryMock.SetStatus mock is already set by ExpectParams functions")
+	if mmHistory.defaultExpectation.paramPtrs != nil {
+		mmHistory.mock.t.Fatalf("OrdersRepositoryMock.History mock is already set by ExpectParams functions")
 	}
 
-	mmSetStatus.defaultExpectation.params = &OrdersRepositoryMockSetStatusParams{ctx, orderID, status}
-	mmSetStatus.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmSetStatus.expectations {
-		if minimock.Equal(e.params, mmSetStatus.defaultExpectation.params) {
-			mmSetStatus.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSetStatus.defaultExpectation.params)
+	mmHistory.defaultExpectation.params = &OrdersRepositoryMockHistoryParams{ctx, orderID}
+	mmHistory.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmHistory.expectations {
+		if minimock.Equal(e.params, mmHistory.defaultExpectation.params) {
+			mmHistory.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmHistory.defaultExpectation.params)
 		}
 	}
 
-	return mmSetStatus
-}
-
-// ExpectCtxParam1 sets up expected param ctx for OrdersRepository.SetStatus
-func (mmSetStatus *mOrdersRepositoryMockSetStatus) ExpectCtxParam1(ctx context.Context) *mOrdersRepositoryMockSetStatus {
-	if mmSetStatus.mock.funcSetStatus != nil {
-		mmSetStatus.mock.t.Fatalf("OrdersRepositoryMock.SetStatus mock is already set by Set")
-	}
-
-	if mmSetStatus.defaultExpectation == nil {
-		mmSetStatus.defaultExpectation = &OrdersRepositoryMockSetStatusExpectation{}
-	}
-
-	if mmSetStatus.defaultExpectation.params != nil {
-		mmSetStatus.mock.t.Fatalf("OrdersRepositoryMock.SetStatus mock is already set by Expect")
-	}
-
-	if mmSetStatus.defaultExpectation.paramPtrs == nil {
-		mmSetStatus.defaultExpectation.paramPtrs = &OrdersRepositoryMockSetStatusParamPtrs{}
-	}
-	mmSetStatus.defaultExpectation.paramPtrs.ctx = &ctx
-	mmSetStatus.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
-
-	return mmSetStatus
+	return mmHistory
 }
 
-// ExpectOrderIDParam2 sets up expected param orderID for OrdersRepository.SetStatus
-func (mmSetStatus *mOrdersRepositoryMockSetStatus) ExpectOrderIDParam2(orderID int64) *mOrdersRepositoryMockSetStatus {
-	if mmSetStatus.mock.funcSetStatus != nil {
-		mmSetStatus.mock.t.Fatalf("OrdersRepositoryMock.SetStatus mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for OrdersRepository.History
+func (mmHistory *mOrdersRepositoryMockHistory) ExpectCtxParam1(ctx context.Context) *mOrdersRepositoryMockHistory {
+	if mmHistory.mock.funcHistory != nil {
+		mmHistory.mock.t.Fatalf("OrdersRepositoryMock.History mock is already set by Set")
 	}
 
-	if mmSetStatus.defaultExpectation == nil {
-		mmSetStatus.defaultExpectation = &OrdersRepositoryMockSetStatusExpectation{}
+	if mmHistory.defaultExpectation == nil {
+		mmHistory.defaultExpectation = &OrdersRepositoryMockHistoryExpectation{}
 	}
 
-	if mmSetStatus.defaultExpectation.params != nil {
-		mmSetStatus.mock.t.Fatalf("OrdersRepositoryMock.SetStatus mock is already set by Expect")
+	if mmHistory.defaultExpectation.params != nil {
+		mmHistory.mock.t.Fatalf("OrdersRepositoryMock.History mock is already set by Expect")
 	}
 
-	if mmSetStatus.defaultExpectation.paramPtrs == nil {
-		mmSetStatus.defaultExpectation.paramPtrs = &OrdersRepositoryMockSetStatusParamPtrs{}
+	if mmHistory.defaultExpectation.paramPtrs == nil {
+		mmHistory.defaultExpectation.paramPtrs = &OrdersRepositoryMockHistoryParamPtrs{}
 	}
-	mmSetStatus.defaultExpectation.paramPtrs.orderID = &orderID
-	mmSetStatus.defaultExpectation.expectationOrigins.originOrderID = minimock.CallerInfo(1)
+	mmHistory.defaultExpectation.paramPtrs.ctx = &ctx
+	mmHistory.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmSetStatus
+	return mmHistory
 }
 
-// ExpectStatusParam3 sets up expected param status for OrdersRepository.SetStatus
-func (mmSetStatus *mOrdersRepositoryMockSetStatus) ExpectStatusParam3(status domain.OrderStatus) *mOrdersRepositoryMockSetStatus {
-	if mmSetStatus.mock.funcSetStatus != nil {
-		mmSetStatus.mock.t.Fatalf("OrdersRepositoryMock.SetStatus mock is already set by Set")
+// ExpectOrderIDParam2 sets up expected param orderID for OrdersRepository.History
+func (mmHistory *mOrdersRepositoryMockHistory) ExpectOrderIDParam2(orderID int64) *mOrdersRepositoryMockHistory {
+	if mmHistory.mock.funcHistory != nil {
+		mmHistory.mock.t.Fatalf("OrdersRepositoryMock.History mock is already set by Set")
 	}
 
-	if mmSetStatus.defaultExpectation == nil {
-		mmSetStatus.defaultExpectation = &OrdersRepositoryMockSetStatusExpectation{}
+	if mmHistory.defaultExpectation == nil {
+		mmHistory.defaultExpectation = &OrdersRepositoryMockHistoryExpectation{}
 	}
 
-	if mmSetStatus.defaultExpectation.params != nil {
-		mmSetStatus.mock.t.Fatalf("OrdersRepositoryMock.SetStatus mock is already set by Expect")
+	if mmHistory.defaultExpectation.params != nil {
+		mmHistory.mock.t.Fatalf("OrdersRepositoryMock.History mock is already set by Expect")
 	}
 
-	if mmSetStatus.defaultExpectation.paramPtrs == nil {
-		mmSetStatus.defaultExpectation.paramPtrs = &OrdersRepositoryMockSetStatusParamPtrs{}
+	if mmHistory.defaultExpectation.paramPtrs == nil {
+		mmHistory.defaultExpectation.paramPtrs = &OrdersRepositoryMockHistoryParamPtrs{}
 	}
-	mmSetStatus.defaultExpectation.paramPtrs.status = &status
-	mmSetStatus.defaultExpectation.expectationOrigins.originStatus = minimock.CallerInfo(1)
+	mmHistory.defaultExpectation.paramPtrs.orderID = &orderID
+	mmHistory.defaultExpectation.expectationOrigins.originOrderID = minimock.CallerInfo(1)
 
-	return mmSetStatus
+	return mmHistory
 }
 
-// Inspect accepts an inspector function that has same arguments as the OrdersRepository.SetStatus
-func (mmSetStatus *mOrdersRepositoryMockSetStatus) Inspect(f func(ctx context.Context, orderID int64, status domain.OrderStatus)) *mOrdersRepositoryMockSetStatus {
-	if mmSetStatus.mock.inspectFuncSetStatus != nil {
-		mmSetStatus.mock.t.Fatalf("Inspect function is already set for OrdersRepositoryMock.SetStatus")
+// Inspect accepts an inspector function that has same arguments as the OrdersRepository.History
+func (mmHistory *mOrdersRepositoryMockHistory) Inspect(f func(ctx context.Context, orderID int64)) *mOrdersRepositoryMockHistory {
+	if mmHistory.mock.inspectFuncHistory != nil {
+		mmHistory.mock.t.Fatalf("Inspect function is already set for OrdersRepositoryMock.History")
 	}
 
-	mmSetStatus.mock.inspectFuncSetStatus = f
+	mmHistory.mock.inspectFuncHistory = f
 
-	return mmSetStatus
+	return mmHistory
 }
 
-// Return sets up results that will be returned by OrdersRepository.SetStatus
-func (mmSetStatus *mOrdersRepositoryMockSetStatus) Return(err error) *OrdersRepositoryMock {
-	if mmSetStatus.mock.funcSetStatus != nil {
-		mmSetStatus.mock.t.Fatalf("OrdersRepositoryMock.SetStatus mock is already set by Set")
+// Return sets up results that will be returned by OrdersRepository.History
+func (mmHistory *mOrdersRepositoryMockHistory) Return(sa1 []domain.StatusChange, err error) *OrdersRepositoryMock {
+	if mmHistory.mock.funcHistory != nil {
+		mmHistory.mock.t.Fatalf("OrdersRepositoryMock.History mock is already set by Set")
 	}
 
-	if mmSetStatus.defaultExpectation == nil {
-		mmSetStatus.defaultExpectation = &OrdersRepositoryMockSetStatusExpectation{mock: mmSetStatus.mock}
+	if mmHistory.defaultExpectation == nil {
+		mmHistory.defaultExpectation = &OrdersRepositoryMockHistoryExpectation{mock: mmHistory.mock}
 	}
-	mmSetStatus.defaultExpectation.results = &OrdersRepositoryMockSetStatusResults{err}
-	mmSetStatus.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmSetStatus.mock
+	mmHistory.defaultExpectation.results = &OrdersRepositoryMockHistoryResults{sa1, err}
+	mmHistory.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmHistory.mock
 }
 
-// Set uses given function f to mock the OrdersRepository.SetStatus method
-func (mmSetStatus *mOrdersRepositoryMockSetStatus) Set(f func(ctx context.Context, orderID int64, status domain.OrderStatus) (err error)) *OrdersRepositoryMock {
-	if mmSetStatus.defaultExpectation != nil {
-		mmSetStatus.mock.t.Fatalf("Default expectation is already set for the OrdersRepository.SetStatus method")
+// Set uses given function f to mock the OrdersRepository.History method
+func (mmHistory *mOrdersRepositoryMockHistory) Set(f func(ctx context.Context, orderID int64) (sa1 []domain.StatusChange, err error)) *OrdersRepositoryMock {
+	if mmHistory.defaultExpectation != nil {
+		mmHistory.mock.t.Fatalf("Default expectation is already set for the OrdersRepository.History method")
 	}
 
-	if len(mmSetStatus.expectations) > 0 {
-		mmSetStatus.mock.t.Fatalf("Some expectations are already set for the OrdersRepository.SetStatus method")
+	if len(mmHistory.expectations) > 0 {
+		mmHistory.mock.t.Fatalf("Some expectations are already set for the OrdersRepository.History method")
 	}
 
-	mmSetStatus.mock.funcSetStatus = f
-	mmSetStatus.mock.funcSetStatusOrigin = minimock.CallerInfo(1)
-	return mmSetStatus.mock
+	mmHistory.mock.funcHistory = f
+	mmHistory.mock.funcHistoryOrigin = minimock.CallerInfo(1)
+	return mmHistory.mock
 }
 
-// When sets expectation for the OrdersRepository.SetStatus which will trigger the result defined by the following
+// When sets expectation for the OrdersRepository.History which will trigger the result defined by the following
 // Then helper
-func (mmSetStatus *mOrdersRepositoryMockSetStatus) When(ctx context.Context, orderID int64, status domain.OrderStatus) *OrdersRepositoryMockSetStatusExpectation {
-	if mmSetStatus.mock.funcSetStatus != nil {
-		mmSetStatus.mock.t.Fatalf("OrdersRepositoryMock.SetStatus mock is already set by Set")
+func (mmHistory *mOrdersRepositoryMockHistory) When(ctx context.Context, orderID int64) *OrdersRepositoryMockHistoryExpectation {
+	if mmHistory.mock.funcHistory != nil {
+		mmHistory.mock.t.Fatalf("OrdersRepositoryMock.History mock is already set by Set")
 	}
 
-	expectation := &OrdersRepositoryMockSetStatusExpectation{
-		mock:               mmSetStatus.mock,
-		params:             &OrdersRepositoryMockSetStatusParams{ctx, orderID, status},
-		expectationOrigins: OrdersRepositoryMockSetStatusExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &OrdersRepositoryMockHistoryExpectation{
+		mock:               mmHistory.mock,
+		params:             &OrdersRepositoryMockHistoryParams{ctx, orderID},
+		expectationOrigins: OrdersRepositoryMockHistoryExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmSetStatus.expectations = append(mmSetStatus.expectations, expectation)
+	mmHistory.expectations = append(mmHistory.expectations, expectation)
 	return expectation
 }
 
-// Then sets up OrdersRepository.SetStatus return parameters for the expectation previously defined by the When method
-func (e *OrdersRepositoryMockSetStatusExpectation) Then(err error) *OrdersRepositoryMock {
-	e.results = &OrdersRepositoryMockSetStatusResults{err}
+// Then sets up OrdersRepository.History return parameters for the expectation previously defined by the When method
+func (e *OrdersRepositoryMockHistoryExpectation) Then(sa1 []domain.StatusChange, err error) *OrdersRepositoryMock {
+	e.results = &OrdersRepositoryMockHistoryResults{sa1, err}
 	return e.mock
 }
 
-// Times sets number of times OrdersRepository.SetStatus should be invoked
-func (mmSetStatus *mOrdersRepositoryMockSetStatus) Times(n uint64) *mOrdersRepositoryMockSetStatus {
+// Times sets number of times OrdersRepository.History should be invoked
+func (mmHistory *mOrdersRepositoryMockHistory) Times(n uint64) *mOrdersRepositoryMockHistory {
 	if n == 0 {
-		mmSetStatus.mock.t.Fatalf("Times of OrdersRepositoryMock.SetStatus mock can not be zero")
+		mmHistory.mock.t.Fatalf("Times of OrdersRepositoryMock.History mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmSetStatus.expectedInvocations, n)
-	mmSetStatus.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmSetStatus
+	mm_atomic.StoreUint64(&mmHistory.expectedInvocations, n)
+	mmHistory.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmHistory
 }
 
-func (mmSetStatus *mOrdersRepositoryMockSetStatus) invocationsDone() bool {
-	if len(mmSetStatus.expectations) == 0 && mmSetStatus.defaultExpectation == nil && mmSetStatus.mock.funcSetStatus == nil {
+func (mmHistory *mOrdersRepositoryMockHistory) invocationsDone() bool {
+	if len(mmHistory.expectations) == 0 && mmHistory.defaultExpectation == nil && mmHistory.mock.funcHistory == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmSetStatus.mock.afterSetStatusCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmSetStatus.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmHistory.mock.afterHistoryCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmHistory.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// SetStatus implements mm_loms.OrdersRepository
-func (mmSetStatus *OrdersRepositoryMock) SetStatus(ctx context.Context, orderID int64, status domain.OrderStatus) (err error) {
-	mm_atomic.AddUint64(&mmSetStatus.beforeSetStatusCounter, 1)
-	defer mm_atomic.AddUint64(&mmSetStatus.afterSetStatusCounter, 1)
+// History implements mm_loms.OrdersRepository
+func (mmHistory *OrdersRepositoryMock) History(ctx context.Context, orderID int64) (sa1 []domain.StatusChange, err error) {
+	mm_atomic.AddUint64(&mmHistory.beforeHistoryCounter, 1)
+	defer mm_atomic.AddUint64(&mmHistory.afterHistoryCounter, 1)
 
-	mmSetStatus.t.Helper()
+	mmHistory.t.Helper()
 
-	if mmSetStatus.inspectFuncSetStatus != nil {
-		mmSetStatus.inspectFuncSetStatus(ctx, orderID, status)
+	if mmHistory.inspectFuncHistory != nil {
+		mmHistory.inspectFuncHistory(ctx, orderID)
 	}
 
-	mm_params := OrdersRepositoryMockSetStatusParams{ctx, orderID, status}
+	mm_params := OrdersRepositoryMockHistoryParams{ctx, orderID}
 
 	// Record call args
-	mmSetStatus.SetStatusMock.mutex.Lock()
-	mmSetStatus.SetStatusMock.callArgs = append(mmSetStatus.SetStatusMock.callArgs, &mm_params)
-	mmSetStatus.SetStatusMock.mutex.Unlock()
+	mmHistory.HistoryMock.mutex.Lock()
+	mmHistory.HistoryMock.callArgs = append(mmHistory.HistoryMock.callArgs, &mm_params)
+	mmHistory.HistoryMock.mutex.Unlock()
 
-	for _, e := range mmSetStatus.SetStatusMock.expectations {
+	for _, e := range mmHistory.HistoryMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.err
+			return e.results.sa1, e.results.err
 		}
 	}
 
-	if mmSetStatus.SetStatusMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmSetStatus.SetStatusMock.defaultExpectation.Counter, 1)
-		mm_want := mmSetStatus.SetStatusMock.defaultExpectation.params
-		mm_want_ptrs := mmSetStatus.SetStatusMock.defaultExpectation.paramPtrs
+	if mmHistory.HistoryMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmHistory.HistoryMock.defaultExpectation.Counter, 1)
+		mm_want := mmHistory.HistoryMock.defaultExpectation.params
+		mm_want_ptrs := mmHistory.HistoryMock.defaultExpectation.paramPtrs
 
-		mm_got := OrdersRepositoryMockSetStatusParams{ctx, orderID, status}
+		mm_got := OrdersRepositoryMockHistoryParams{ctx, orderID}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmSetStatus.t.Errorf("OrdersRepositoryMock.SetStatus got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmSetStatus.SetStatusMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmHistory.t.Errorf("OrdersRepositoryMock.History got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmHistory.HistoryMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
 			if mm_want_ptrs.orderID != nil && !minimock.Equal(*mm_want_ptrs.orderID, mm_got.orderID) {
-				mmSetStatus.t.Errorf("OrdersRepositoryMock.SetStatus got unexpected parameter orderID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmSetStatus.SetStatusMock.defaultExpectation.expectationOrigins.originOrderID, *mm_want_ptrs.orderID, mm_got.orderID, minimock.Diff(*mm_want_ptrs.orderID, mm_got.orderID))
-			}
-
-			if mm_want_ptrs.status != nil && !minimock.Equal(*mm_want_ptrs.status, mm_got.status) {
-				mmSetStatus.t.Errorf("OrdersRepositoryMock.SetStatus got unexpected parameter status, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmSetStatus.SetStatusMock.defaultExpectation.expectationOrigins.originStatus, *mm_want_ptrs.status, mm_got.status, minimock.Diff(*mm_want_ptrs.status, mm_got.status))
+				mmHistory.t.Errorf("OrdersRepositoryMock.History got unexpected parameter orderID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmHistory.HistoryMock.defaultExpectation.expectationOrigins.originOrderID, *mm_want_ptrs.orderID, mm_got.orderID, minimock.Diff(*mm_want_ptrs.orderID, mm_got.orderID))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmSetStatus.t.Errorf("OrdersRepositoryMock.SetStatus got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmSetStatus.SetStatusMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmHistory.t.Errorf("OrdersRepositoryMock.History got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmHistory.HistoryMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmSetStatus.SetStatusMock.defaultExpectation.results
+		mm_results := mmHistory.HistoryMock.defaultExpectation.results
 		if mm_results == nil {
-			mmSetStatus.t.Fatal("No results are set for the OrdersRepositoryMock.SetStatus")
+			mmHistory.t.Fatal("No results are set for the OrdersRepositoryMock.History")
 		}
-		return (*mm_results).err
+		return (*mm_results).sa1, (*mm_results).err
 	}
-	if mmSetStatus.funcSetStatus != nil {
-		return mmSetStatus.funcSetStatus(ctx, orderID, status)
+	if mmHistory.funcHistory != nil {
+		return mmHistory.funcHistory(ctx, orderID)
 	}
-	mmSetStatus.t.Fatalf("Unexpected call to OrdersRepositoryMock.SetStatus. %v %v %v", ctx, orderID, status)
+	mmHistory.t.Fatalf("Unexpected call to OrdersRepositoryMock.History. %v %v", ctx, orderID)
 	return
 }
 
-// SetStatusAfterCounter returns a count of finished OrdersRepositoryMock.SetStatus invocations
-func (mmSetStatus *OrdersRepositoryMock) SetStatusAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmSetStatus.afterSetStatusCounter)
+// HistoryAfterCounter returns a count of finished OrdersRepositoryMock.History invocations
+func (mmHistory *OrdersRepositoryMock) HistoryAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmHistory.afterHistoryCounter)
 }
 
-// SetStatusBeforeCounter returns a count of OrdersRepositoryMock.SetStatus invocations
-func (mmSetStatus *OrdersRepositoryMock) SetStatusBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmSetStatus.beforeSetStatusCounter)
+// HistoryBeforeCounter returns a count of OrdersRepositoryMock.History invocations
+func (mmHistory *OrdersRepositoryMock) HistoryBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmHistory.beforeHistoryCounter)
 }
 
-// Calls returns a list of arguments used in each call to OrdersRepositoryMock.SetStatus.
+// Calls returns a list of arguments used in each call to OrdersRepositoryMock.History.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmSetStatus *mOrdersRepositoryMockSetStatus) Calls() []*OrdersRepositoryMockSetStatusParams {
-	mmSetStatus.mutex.RLock()
+func (mmHistory *mOrdersRepositoryMockHistory) Calls() []*OrdersRepositoryMockHistoryParams {
+	mmHistory.mutex.RLock()
 
-	argCopy := make([]*OrdersRepositoryMockSetStatusParams, len(mmSetStatus.callArgs))
-	copy(argCopy, mmSetStatus.callArgs)
+	argCopy := make([]*OrdersRepositoryMockHistoryParams, len(mmHistory.callArgs))
+	copy(argCopy, mmHistory.callArgs)
 
-	mmSetStatus.mutex.RUnlock()
+	mmHistory.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockSetStatusDone returns true if the count of the SetStatus invocations corresponds
+// MinimockHistoryDone returns true if the count of the History invocations corresponds
 // the number of defined expectations
-func (m *OrdersRepositoryMock) MinimockSetStatusDone() bool {
-	if m.SetStatusMock.optional {
+func (m *OrdersRepositoryMock) MinimockHistoryDone() bool {
+	if m.HistoryMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.SetStatusMock.expectations {
+	for _, e := range m.HistoryMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.SetStatusMock.invocationsDone()
+	return m.HistoryMock.invocationsDone()
 }
 
-// MinimockSetStatusInspect logs each unmet expectation
-func (m *OrdersRepositoryMock) MinimockSetStatusInspect() {
-	for _, e := range m.SetStatusMock.expectations {
+// MinimockHistoryInspect logs each unmet expectation
+func (m *OrdersRepositoryMock) MinimockHistoryInspect() {
+	for _, e := range m.HistoryMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to OrdersRepositoryMock.SetStatus at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to OrdersRepositoryMock.History at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterSetStatusCounter := mm_atomic.LoadUint64(&m.afterSetStatusCounter)
+	afterHistoryCounter := mm_atomic.LoadUint64(&m.afterHistoryCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.SetStatusMock.defaultExpectation != nil && afterSetStatusCounter < 1 {
-		if m.SetStatusMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to OrdersRepositoryMock.SetStatus at\n%s", m.SetStatusMock.defaultExpectation.returnOrigin)
+	if m.HistoryMock.defaultExpectation != nil && afterHistoryCounter < 1 {
+		if m.HistoryMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OrdersRepositoryMock.History at\n%s", m.HistoryMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to OrdersRepositoryMock.SetStatus at\n%s with params: %#v", m.SetStatusMock.defaultExpectation.expectationOrigins.origin, *m.SetStatusMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to OrdersRepositoryMock.History at\n%s with params: %#v", m.HistoryMock.defaultExpectation.expectationOrigins.origin, *m.HistoryMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcSetStatus != nil && afterSetStatusCounter < 1 {
-		m.t.Errorf("Expected call to OrdersRepositoryMock.SetStatus at\n%s", m.funcSetStatusOrigin)
+	if m.funcHistory != nil && afterHistoryCounter < 1 {
+		m.t.Errorf("Expected call to OrdersRepositoryMock.History at\n%s", m.funcHistoryOrigin)
 	}
 
-	if !m.SetStatusMock.invocationsDone() && afterSetStatusCounter > 0 {
-		m.t.Errorf("Expected %d calls to OrdersRepositoryMock.SetStatus at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.SetStatusMock.expectedInvocations), m.SetStatusMock.expectedInvocationsOrigin, afterSetStatusCounter)
+	if !m.HistoryMock.invocationsDone() && afterHistoryCounter > 0 {
+		m.t.Errorf("Expected %d calls to OrdersRepositoryMock.History at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.HistoryMock.expectedInvocations), m.HistoryMock.expectedInvocationsOrigin, afterHistoryCounter)
 	}
 }
 
-// MinimockFinish checks that all mocked methods have been called the expected number of times
-func (m *OrdersRepositoryMock) MinimockFinish() {
-	m.finishOnce.Do(func() {
-		if !m.minimockDone() {
-			m.MinimockCreateInspect()
+type mOrdersRepositoryMockList struct {
+	optional           bool
+	mock               *OrdersRepositoryMock
+	defaultExpectation *OrdersRepositoryMockListExpectation
+	expectations       []*OrdersRepositoryMockListExpectation
 
-			m.MinimockGetByIDInspect()
+	callArgs []*OrdersRepositoryMockListParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OrdersRepositoryMockListExpectation specifies expectation struct of the OrdersRepository.List
+type OrdersRepositoryMockListExpectation struct {
+	mock               *OrdersRepositoryMock
+	params             *OrdersRepositoryMockListParams
+	paramPtrs          *OrdersRepositoryMockListParamPtrs
+	expectationOrigins OrdersRepositoryMockListExpectationOrigins
+	results            *OrdersRepositoryMockListResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OrdersRepositoryMockListParams contains parameters of the OrdersRepository.List
+type OrdersRepositoryMockListParams struct {
+	ctx    context.Context
+	filter domain.OrderFilter
+}
+
+// OrdersRepositoryMockListParamPtrs contains pointers to parameters of the OrdersRepository.List
+type OrdersRepositoryMockListParamPtrs struct {
+	ctx    *context.Context
+	filter *domain.OrderFilter
+}
+
+// OrdersRepositoryMockListResults contains results of the OrdersRepository.List
+type OrdersRepositoryMockListResults struct {
+	oa1 []domain.Order
+	err error
+}
+
+// OrdersRepositoryMockListOrigins contains origins of expectations of the OrdersRepository.List
+type OrdersRepositoryMockListExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originFilter string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmList *mOrdersRepositoryMockList) Optional() *mOrdersRepositoryMockList {
+	mmList.optional = true
+	return mmList
+}
+
+// Expect sets up expected params for OrdersRepository.List
+func (mmList *mOrdersRepositoryMockList) Expect(ctx context.Context, filter domain.OrderFilter) *mOrdersRepositoryMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("OrdersRepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &OrdersRepositoryMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.paramPtrs != nil {
+		mmList.mock.t.Fatalf("OrdersRepositoryMock.List mock is already set by ExpectParams functions")
+	}
+
+	mmList.defaultExpectation.params = &OrdersRepositoryMockListParams{ctx, filter}
+	mmList.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmList.expectations {
+		if minimock.Equal(e.params, mmList.defaultExpectation.params) {
+			mmList.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmList.defaultExpectation.params)
+		}
+	}
+
+	return mmList
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OrdersRepository.List
+func (mmList *mOrdersRepositoryMockList) ExpectCtxParam1(ctx context.Context) *mOrdersRepositoryMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("OrdersRepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &OrdersRepositoryMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.params != nil {
+		mmList.mock.t.Fatalf("OrdersRepositoryMock.List mock is already set by Expect")
+	}
+
+	if mmList.defaultExpectation.paramPtrs == nil {
+		mmList.defaultExpectation.paramPtrs = &OrdersRepositoryMockListParamPtrs{}
+	}
+	mmList.defaultExpectation.paramPtrs.ctx = &ctx
+	mmList.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmList
+}
+
+// ExpectFilterParam2 sets up expected param filter for OrdersRepository.List
+func (mmList *mOrdersRepositoryMockList) ExpectFilterParam2(filter domain.OrderFilter) *mOrdersRepositoryMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("OrdersRepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &OrdersRepositoryMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.params != nil {
+		mmList.mock.t.Fatalf("OrdersRepositoryMock.List mock is already set by Expect")
+	}
+
+	if mmList.defaultExpectation.paramPtrs == nil {
+		mmList.defaultExpectation.paramPtrs = &OrdersRepositoryMockListParamPtrs{}
+	}
+	mmList.defaultExpectation.paramPtrs.filter = &filter
+	mmList.defaultExpectation.expectationOrigins.originFilter = minimock.CallerInfo(1)
+
+	return mmList
+}
+
+// Inspect accepts an inspector function that has same arguments as the OrdersRepository.List
+func (mmList *mOrdersRepositoryMockList) Inspect(f func(ctx context.Context, filter domain.OrderFilter)) *mOrdersRepositoryMockList {
+	if mmList.mock.inspectFuncList != nil {
+		mmList.mock.t.Fatalf("Inspect function is already set for OrdersRepositoryMock.List")
+	}
+
+	mmList.mock.inspectFuncList = f
+
+	return mmList
+}
+
+// Return sets up results that will be returned by OrdersRepository.List
+func (mmList *mOrdersRepositoryMockList) Return(oa1 []domain.Order, err error) *OrdersRepositoryMock {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("OrdersRepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &OrdersRepositoryMockListExpectation{mock: mmList.mock}
+	}
+	mmList.defaultExpectation.results = &OrdersRepositoryMockListResults{oa1, err}
+	mmList.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmList.mock
+}
+
+// Set uses given function f to mock the OrdersRepository.List method
+func (mmList *mOrdersRepositoryMockList) Set(f func(ctx context.Context, filter domain.OrderFilter) (oa1 []domain.Order, err error)) *OrdersRepositoryMock {
+	if mmList.defaultExpectation != nil {
+		mmList.mock.t.Fatalf("Default expectation is already set for the OrdersRepository.List method")
+	}
+
+	if len(mmList.expectations) > 0 {
+		mmList.mock.t.Fatalf("Some expectations are already set for the OrdersRepository.List method")
+	}
+
+	mmList.mock.funcList = f
+	mmList.mock.funcListOrigin = minimock.CallerInfo(1)
+	return mmList.mock
+}
+
+// When sets expectation for the OrdersRepository.List which will trigger the result defined by the following
+// Then helper
+func (mmList *mOrdersRepositoryMockList) When(ctx context.Context, filter domain.OrderFilter) *OrdersRepositoryMockListExpectation {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("OrdersRepositoryMock.List mock is already set by Set")
+	}
+
+	expectation := &OrdersRepositoryMockListExpectation{
+		mock:               mmList.mock,
+		params:             &OrdersRepositoryMockListParams{ctx, filter},
+		expectationOrigins: OrdersRepositoryMockListExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmList.expectations = append(mmList.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OrdersRepository.List return parameters for the expectation previously defined by the When method
+func (e *OrdersRepositoryMockListExpectation) Then(oa1 []domain.Order, err error) *OrdersRepositoryMock {
+	e.results = &OrdersRepositoryMockListResults{oa1, err}
+	return e.mock
+}
+
+// Times sets number of times OrdersRepository.List should be invoked
+func (mmList *mOrdersRepositoryMockList) Times(n uint64) *mOrdersRepositoryMockList {
+	if n == 0 {
+		mmList.mock.t.Fatalf("Times of OrdersRepositoryMock.List mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmList.expectedInvocations, n)
+	mmList.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmList
+}
+
+func (mmList *mOrdersRepositoryMockList) invocationsDone() bool {
+	if len(mmList.expectations) == 0 && mmList.defaultExpectation == nil && mmList.mock.funcList == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmList.mock.afterListCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmList.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// List implements mm_loms.OrdersRepository
+func (mmList *OrdersRepositoryMock) List(ctx context.Context, filter domain.OrderFilter) (oa1 []domain.Order, err error) {
+	mm_atomic.AddUint64(&mmList.beforeListCounter, 1)
+	defer mm_atomic.AddUint64(&mmList.afterListCounter, 1)
+
+	mmList.t.Helper()
+
+	if mmList.inspectFuncList != nil {
+		mmList.inspectFuncList(ctx, filter)
+	}
+
+	mm_params := OrdersRepositoryMockListParams{ctx, filter}
+
+	// Record call args
+	mmList.ListMock.mutex.Lock()
+	mmList.ListMock.callArgs = append(mmList.ListMock.callArgs, &mm_params)
+	mmList.ListMock.mutex.Unlock()
+
+	for _, e := range mmList.ListMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.oa1, e.results.err
+		}
+	}
+
+	if mmList.ListMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmList.ListMock.defaultExpectation.Counter, 1)
+		mm_want := mmList.ListMock.defaultExpectation.params
+		mm_want_ptrs := mmList.ListMock.defaultExpectation.paramPtrs
+
+		mm_got := OrdersRepositoryMockListParams{ctx, filter}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmList.t.Errorf("OrdersRepositoryMock.List got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmList.ListMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
+				mmList.t.Errorf("OrdersRepositoryMock.List got unexpected parameter filter, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmList.ListMock.defaultExpectation.expectationOrigins.originFilter, *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmList.t.Errorf("OrdersRepositoryMock.List got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmList.ListMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmList.ListMock.defaultExpectation.results
+		if mm_results == nil {
+			mmList.t.Fatal("No results are set for the OrdersRepositoryMock.List")
+		}
+		return (*mm_results).oa1, (*mm_results).err
+	}
+	if mmList.funcList != nil {
+		return mmList.funcList(ctx, filter)
+	}
+	mmList.t.Fatalf("Unexpected call to OrdersRepositoryMock.List. %v %v", ctx, filter)
+	return
+}
+
+// ListAfterCounter returns a count of finished OrdersRepositoryMock.List invocations
+func (mmList *OrdersRepositoryMock) ListAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmList.afterListCounter)
+}
+
+// ListBeforeCounter returns a count of OrdersRepositoryMock.List invocations
+func (mmList *OrdersRepositoryMock) ListBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmList.beforeListCounter)
+}
+
+// Calls returns a list of arguments used in each call to OrdersRepositoryMock.List.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmList *mOrdersRepositoryMockList) Calls() []*OrdersRepositoryMockListParams {
+	mmList.mutex.RLock()
+
+	argCopy := make([]*OrdersRepositoryMockListParams, len(mmList.callArgs))
+	copy(argCopy, mmList.callArgs)
+
+	mmList.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListDone returns true if the count of the List invocations corresponds
+// the number of defined expectations
+func (m *OrdersRepositoryMock) MinimockListDone() bool {
+	if m.ListMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListMock.invocationsDone()
+}
+
+// MinimockListInspect logs each unmet expectation
+func (m *OrdersRepositoryMock) MinimockListInspect() {
+	for _, e := range m.ListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OrdersRepositoryMock.List at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListCounter := mm_atomic.LoadUint64(&m.afterListCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListMock.defaultExpectation != nil && afterListCounter < 1 {
+		if m.ListMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OrdersRepositoryMock.List at\n%s", m.ListMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OrdersRepositoryMock.List at\n%s with params: %#v", m.ListMock.defaultExpectation.expectationOrigins.origin, *m.ListMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcList != nil && afterListCounter < 1 {
+		m.t.Errorf("Expected call to OrdersRepositoryMock.List at\n%s", m.funcListOrigin)
+	}
+
+	if !m.ListMock.invocationsDone() && afterListCounter > 0 {
+		m.t.Errorf("Expected %d calls to OrdersRepositoryMock.List at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListMock.expectedInvocations), m.ListMock.expectedInvocationsOrigin, afterListCounter)
+	}
+}
+
+type mOrdersRepositoryMockListExpired struct {
+	optional           bool
+	mock               *OrdersRepositoryMock
+	defaultExpectation *OrdersRepositoryMockListExpiredExpectation
+	expectations       []*OrdersRepositoryMockListExpiredExpectation
+
+	callArgs []*OrdersRepositoryMockListExpiredParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OrdersRepositoryMockListExpiredExpectation specifies expectation struct of the OrdersRepository.ListExpired
+type OrdersRepositoryMockListExpiredExpectation struct {
+	mock               *OrdersRepositoryMock
+	params             *OrdersRepositoryMockListExpiredParams
+	paramPtrs          *OrdersRepositoryMockListExpiredParamPtrs
+	expectationOrigins OrdersRepositoryMockListExpiredExpectationOrigins
+	results            *OrdersRepositoryMockListExpiredResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OrdersRepositoryMockListExpiredParams contains parameters of the OrdersRepository.ListExpired
+type OrdersRepositoryMockListExpiredParams struct {
+	ctx           context.Context
+	status        domain.OrderStatus
+	updatedBefore time.Time
+	exclude       []int64
+	limit         int32
+}
+
+// OrdersRepositoryMockListExpiredParamPtrs contains pointers to parameters of the OrdersRepository.ListExpired
+type OrdersRepositoryMockListExpiredParamPtrs struct {
+	ctx           *context.Context
+	status        *domain.OrderStatus
+	updatedBefore *time.Time
+	exclude       *[]int64
+	limit         *int32
+}
+
+// OrdersRepositoryMockListExpiredResults contains results of the OrdersRepository.ListExpired
+type OrdersRepositoryMockListExpiredResults struct {
+	ia1 []int64
+	err error
+}
+
+// OrdersRepositoryMockListExpiredOrigins contains origins of expectations of the OrdersRepository.ListExpired
+type OrdersRepositoryMockListExpiredExpectationOrigins struct {
+	origin              string
+	originCtx           string
+	originStatus        string
+	originUpdatedBefore string
+	originExclude       string
+	originLimit         string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListExpired *mOrdersRepositoryMockListExpired) Optional() *mOrdersRepositoryMockListExpired {
+	mmListExpired.optional = true
+	return mmListExpired
+}
+
+// Expect sets up expected params for OrdersRepository.ListExpired
+func (mmListExpired *mOrdersRepositoryMockListExpired) Expect(ctx context.Context, status domain.OrderStatus, updatedBefore time.Time, exclude []int64, limit int32) *mOrdersRepositoryMockListExpired {
+	if mmListExpired.mock.funcListExpired != nil {
+		mmListExpired.mock.t.Fatalf("OrdersRepositoryMock.ListExpired mock is already set by Set")
+	}
+
+	if mmListExpired.defaultExpectation == nil {
+		mmListExpired.defaultExpectation = &OrdersRepositoryMockListExpiredExpectation{}
+	}
+
+	if mmListExpired.defaultExpectation.paramPtrs != nil {
+		mmListExpired.mock.t.Fatalf("OrdersRepositoryMock.ListExpired mock is already set by ExpectParams functions")
+	}
+
+	mmListExpired.defaultExpectation.params = &OrdersRepositoryMockListExpiredParams{ctx, status, updatedBefore, exclude, limit}
+	mmListExpired.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListExpired.expectations {
+		if minimock.Equal(e.params, mmListExpired.defaultExpectation.params) {
+			mmListExpired.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListExpired.defaultExpectation.params)
+		}
+	}
+
+	return mmListExpired
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OrdersRepository.ListExpired
+func (mmListExpired *mOrdersRepositoryMockListExpired) ExpectCtxParam1(ctx context.Context) *mOrdersRepositoryMockListExpired {
+	if mmListExpired.mock.funcListExpired != nil {
+		mmListExpired.mock.t.Fatalf("OrdersRepositoryMock.ListExpired mock is already set by Set")
+	}
+
+	if mmListExpired.defaultExpectation == nil {
+		mmListExpired.defaultExpectation = &OrdersRepositoryMockListExpiredExpectation{}
+	}
+
+	if mmListExpired.defaultExpectation.params != nil {
+		mmListExpired.mock.t.Fatalf("OrdersRepositoryMock.ListExpired mock is already set by Expect")
+	}
+
+	if mmListExpired.defaultExpectation.paramPtrs == nil {
+		mmListExpired.defaultExpectation.paramPtrs = &OrdersRepositoryMockListExpiredParamPtrs{}
+	}
+	mmListExpired.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListExpired.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListExpired
+}
+
+// ExpectStatusParam2 sets up expected param status for OrdersRepository.ListExpired
+func (mmListExpired *mOrdersRepositoryMockListExpired) ExpectStatusParam2(status domain.OrderStatus) *mOrdersRepositoryMockListExpired {
+	if mmListExpired.mock.funcListExpired != nil {
+		mmListExpired.mock.t.Fatalf("OrdersRepositoryMock.ListExpired mock is already set by Set")
+	}
+
+	if mmListExpired.defaultExpectation == nil {
+		mmListExpired.defaultExpectation = &OrdersRepositoryMockListExpiredExpectation{}
+	}
+
+	if mmListExpired.defaultExpectation.params != nil {
+		mmListExpired.mock.t.Fatalf("OrdersRepositoryMock.ListExpired mock is already set by Expect")
+	}
+
+	if mmListExpired.defaultExpectation.paramPtrs == nil {
+		mmListExpired.defaultExpectation.paramPtrs = &OrdersRepositoryMockListExpiredParamPtrs{}
+	}
+	mmListExpired.defaultExpectation.paramPtrs.status = &status
+	mmListExpired.defaultExpectation.expectationOrigins.originStatus = minimock.CallerInfo(1)
+
+	return mmListExpired
+}
+
+// ExpectUpdatedBeforeParam3 sets up expected param updatedBefore for OrdersRepository.ListExpired
+func (mmListExpired *mOrdersRepositoryMockListExpired) ExpectUpdatedBeforeParam3(updatedBefore time.Time) *mOrdersRepositoryMockListExpired {
+	if mmListExpired.mock.funcListExpired != nil {
+		mmListExpired.mock.t.Fatalf("OrdersRepositoryMock.ListExpired mock is already set by Set")
+	}
+
+	if mmListExpired.defaultExpectation == nil {
+		mmListExpired.defaultExpectation = &OrdersRepositoryMockListExpiredExpectation{}
+	}
+
+	if mmListExpired.defaultExpectation.params != nil {
+		mmListExpired.mock.t.Fatalf("OrdersRepositoryMock.ListExpired mock is already set by Expect")
+	}
+
+	if mmListExpired.defaultExpectation.paramPtrs == nil {
+		mmListExpired.defaultExpectation.paramPtrs = &OrdersRepositoryMockListExpiredParamPtrs{}
+	}
+	mmListExpired.defaultExpectation.paramPtrs.updatedBefore = &updatedBefore
+	mmListExpired.defaultExpectation.expectationOrigins.originUpdatedBefore = minimock.CallerInfo(1)
+
+	return mmListExpired
+}
+
+// ExpectExcludeParam4 sets up expected param exclude for OrdersRepository.ListExpired
+func (mmListExpired *mOrdersRepositoryMockListExpired) ExpectExcludeParam4(exclude []int64) *mOrdersRepositoryMockListExpired {
+	if mmListExpired.mock.funcListExpired != nil {
+		mmListExpired.mock.t.Fatalf("OrdersRepositoryMock.ListExpired mock is already set by Set")
+	}
+
+	if mmListExpired.defaultExpectation == nil {
+		mmListExpired.defaultExpectation = &OrdersRepositoryMockListExpiredExpectation{}
+	}
+
+	if mmListExpired.defaultExpectation.params != nil {
+		mmListExpired.mock.t.Fatalf("OrdersRepositoryMock.ListExpired mock is already set by Expect")
+	}
+
+	if mmListExpired.defaultExpectation.paramPtrs == nil {
+		mmListExpired.defaultExpectation.paramPtrs = &OrdersRepositoryMockListExpiredParamPtrs{}
+	}
+	mmListExpired.defaultExpectation.paramPtrs.exclude = &exclude
+	mmListExpired.defaultExpectation.expectationOrigins.originExclude = minimock.CallerInfo(1)
+
+	return mmListExpired
+}
+
+// ExpectLimitParam5 sets up expected param limit for OrdersRepository.ListExpired
+func (mmListExpired *mOrdersRepositoryMockListExpired) ExpectLimitParam5(limit int32) *mOrdersRepositoryMockListExpired {
+	if mmListExpired.mock.funcListExpired != nil {
+		mmListExpired.mock.t.Fatalf("OrdersRepositoryMock.ListExpired mock is already set by Set")
+	}
+
+	if mmListExpired.defaultExpectation == nil {
+		mmListExpired.defaultExpectation = &OrdersRepositoryMockListExpiredExpectation{}
+	}
+
+	if mmListExpired.defaultExpectation.params != nil {
+		mmListExpired.mock.t.Fatalf("OrdersRepositoryMock.ListExpired mock is already set by Expect")
+	}
+
+	if mmListExpired.defaultExpectation.paramPtrs == nil {
+		mmListExpired.defaultExpectation.paramPtrs = &OrdersRepositoryMockListExpiredParamPtrs{}
+	}
+	mmListExpired.defaultExpectation.paramPtrs.limit = &limit
+	mmListExpired.defaultExpectation.expectationOrigins.originLimit = minimock.CallerInfo(1)
+
+	return mmListExpired
+}
+
+// Inspect accepts an inspector function that has same arguments as the OrdersRepository.ListExpired
+func (mmListExpired *mOrdersRepositoryMockListExpired) Inspect(f func(ctx context.Context, status domain.OrderStatus, updatedBefore time.Time, exclude []int64, limit int32)) *mOrdersRepositoryMockListExpired {
+	if mmListExpired.mock.inspectFuncListExpired != nil {
+		mmListExpired.mock.t.Fatalf("Inspect function is already set for OrdersRepositoryMock.ListExpired")
+	}
+
+	mmListExpired.mock.inspectFuncListExpired = f
+
+	return mmListExpired
+}
+
+// Return sets up results that will be returned by OrdersRepository.ListExpired
+func (mmListExpired *mOrdersRepositoryMockListExpired) Return(ia1 []int64, err error) *OrdersRepositoryMock {
+	if mmListExpired.mock.funcListExpired != nil {
+		mmListExpired.mock.t.Fatalf("OrdersRepositoryMock.ListExpired mock is already set by Set")
+	}
+
+	if mmListExpired.defaultExpectation == nil {
+		mmListExpired.defaultExpectation = &OrdersRepositoryMockListExpiredExpectation{mock: mmListExpired.mock}
+	}
+	mmListExpired.defaultExpectation.results = &OrdersRepositoryMockListExpiredResults{ia1, err}
+	mmListExpired.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListExpired.mock
+}
+
+// Set uses given function f to mock the OrdersRepository.ListExpired method
+func (mmListExpired *mOrdersRepositoryMockListExpired) Set(f func(ctx context.Context, status domain.OrderStatus, updatedBefore time.Time, exclude []int64, limit int32) (ia1 []int64, err error)) *OrdersRepositoryMock {
+	if mmListExpired.defaultExpectation != nil {
+		mmListExpired.mock.t.Fatalf("Default expectation is already set for the OrdersRepository.ListExpired method")
+	}
+
+	if len(mmListExpired.expectations) > 0 {
+		mmListExpired.mock.t.Fatalf("Some expectations are already set for the OrdersRepository.ListExpired method")
+	}
+
+	mmListExpired.mock.funcListExpired = f
+	mmListExpired.mock.funcListExpiredOrigin = minimock.CallerInfo(1)
+	return mmListExpired.mock
+}
+
+// When sets expectation for the OrdersRepository.ListExpired which will trigger the result defined by the following
+// Then helper
+func (mmListExpired *mOrdersRepositoryMockListExpired) When(ctx context.Context, status domain.OrderStatus, updatedBefore time.Time, exclude []int64, limit int32) *OrdersRepositoryMockListExpiredExpectation {
+	if mmListExpired.mock.funcListExpired != nil {
+		mmListExpired.mock.t.Fatalf("OrdersRepositoryMock.ListExpired mock is already set by Set")
+	}
+
+	expectation := &OrdersRepositoryMockListExpiredExpectation{
+		mock:               mmListExpired.mock,
+		params:             &OrdersRepositoryMockListExpiredParams{ctx, status, updatedBefore, exclude, limit},
+		expectationOrigins: OrdersRepositoryMockListExpiredExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListExpired.expectations = append(mmListExpired.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OrdersRepository.ListExpired return parameters for the expectation previously defined by the When method
+func (e *OrdersRepositoryMockListExpiredExpectation) Then(ia1 []int64, err error) *OrdersRepositoryMock {
+	e.results = &OrdersRepositoryMockListExpiredResults{ia1, err}
+	return e.mock
+}
+
+// Times sets number of times OrdersRepository.ListExpired should be invoked
+func (mmListExpired *mOrdersRepositoryMockListExpired) Times(n uint64) *mOrdersRepositoryMockListExpired {
+	if n == 0 {
+		mmListExpired.mock.t.Fatalf("Times of OrdersRepositoryMock.ListExpired mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListExpired.expectedInvocations, n)
+	mmListExpired.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListExpired
+}
+
+func (mmListExpired *mOrdersRepositoryMockListExpired) invocationsDone() bool {
+	if len(mmListExpired.expectations) == 0 && mmListExpired.defaultExpectation == nil && mmListExpired.mock.funcListExpired == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListExpired.mock.afterListExpiredCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListExpired.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListExpired implements mm_loms.OrdersRepository
+func (mmListExpired *OrdersRepositoryMock) ListExpired(ctx context.Context, status domain.OrderStatus, updatedBefore time.Time, exclude []int64, limit int32) (ia1 []int64, err error) {
+	mm_atomic.AddUint64(&mmListExpired.beforeListExpiredCounter, 1)
+	defer mm_atomic.AddUint64(&mmListExpired.afterListExpiredCounter, 1)
+
+	mmListExpired.t.Helper()
+
+	if mmListExpired.inspectFuncListExpired != nil {
+		mmListExpired.inspectFuncListExpired(ctx, status, updatedBefore, exclude, limit)
+	}
+
+	mm_params := OrdersRepositoryMockListExpiredParams{ctx, status, updatedBefore, exclude, limit}
+
+	// Record call args
+	mmListExpired.ListExpiredMock.mutex.Lock()
+	mmListExpired.ListExpiredMock.callArgs = append(mmListExpired.ListExpiredMock.callArgs, &mm_params)
+	mmListExpired.ListExpiredMock.mutex.Unlock()
+
+	for _, e := range mmListExpired.ListExpiredMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ia1, e.results.err
+		}
+	}
+
+	if mmListExpired.ListExpiredMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListExpired.ListExpiredMock.defaultExpectation.Counter, 1)
+		mm_want := mmListExpired.ListExpiredMock.defaultExpectation.params
+		mm_want_ptrs := mmListExpired.ListExpiredMock.defaultExpectation.paramPtrs
+
+		mm_got := OrdersRepositoryMockListExpiredParams{ctx, status, updatedBefore, exclude, limit}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListExpired.t.Errorf("OrdersRepositoryMock.ListExpired got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListExpired.ListExpiredMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.status != nil && !minimock.Equal(*mm_want_ptrs.status, mm_got.status) {
+				mmListExpired.t.Errorf("OrdersRepositoryMock.ListExpired got unexpected parameter status, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListExpired.ListExpiredMock.defaultExpectation.expectationOrigins.originStatus, *mm_want_ptrs.status, mm_got.status, minimock.Diff(*mm_want_ptrs.status, mm_got.status))
+			}
+
+			if mm_want_ptrs.updatedBefore != nil && !minimock.Equal(*mm_want_ptrs.updatedBefore, mm_got.updatedBefore) {
+				mmListExpired.t.Errorf("OrdersRepositoryMock.ListExpired got unexpected parameter updatedBefore, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListExpired.ListExpiredMock.defaultExpectation.expectationOrigins.originUpdatedBefore, *mm_want_ptrs.updatedBefore, mm_got.updatedBefore, minimock.Diff(*mm_want_ptrs.updatedBefore, mm_got.updatedBefore))
+			}
+
+			if mm_want_ptrs.exclude != nil && !minimock.Equal(*mm_want_ptrs.exclude, mm_got.exclude) {
+				mmListExpired.t.Errorf("OrdersRepositoryMock.ListExpired got unexpected parameter exclude, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListExpired.ListExpiredMock.defaultExpectation.expectationOrigins.originExclude, *mm_want_ptrs.exclude, mm_got.exclude, minimock.Diff(*mm_want_ptrs.exclude, mm_got.exclude))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmListExpired.t.Errorf("OrdersRepositoryMock.ListExpired got unexpected parameter limit, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListExpired.ListExpiredMock.defaultExpectation.expectationOrigins.originLimit, *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListExpired.t.Errorf("OrdersRepositoryMock.ListExpired got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListExpired.ListExpiredMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListExpired.ListExpiredMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListExpired.t.Fatal("No results are set for the OrdersRepositoryMock.ListExpired")
+		}
+		return (*mm_results).ia1, (*mm_results).err
+	}
+	if mmListExpired.funcListExpired != nil {
+		return mmListExpired.funcListExpired(ctx, status, updatedBefore, exclude, limit)
+	}
+	mmListExpired.t.Fatalf("Unexpected call to OrdersRepositoryMock.ListExpired. %v %v %v %v %v", ctx, status, updatedBefore, exclude, limit)
+	return
+}
+
+// ListExpiredAfterCounter returns a count of finished OrdersRepositoryMock.ListExpired invocations
+func (mmListExpired *OrdersRepositoryMock) ListExpiredAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListExpired.afterListExpiredCounter)
+}
+
+// ListExpiredBeforeCounter returns a count of OrdersRepositoryMock.ListExpired invocations
+func (mmListExpired *OrdersRepositoryMock) ListExpiredBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListExpired.beforeListExpiredCounter)
+}
+
+// Calls returns a list of arguments used in each call to OrdersRepositoryMock.ListExpired.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListExpired *mOrdersRepositoryMockListExpired) Calls() []*OrdersRepositoryMockListExpiredParams {
+	mmListExpired.mutex.RLock()
+
+	argCopy := make([]*OrdersRepositoryMockListExpiredParams, len(mmListExpired.callArgs))
+	copy(argCopy, mmListExpired.callArgs)
+
+	mmListExpired.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListExpiredDone returns true if the count of the ListExpired invocations corresponds
+// the number of defined expectations
+func (m *OrdersRepositoryMock) MinimockListExpiredDone() bool {
+	if m.ListExpiredMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListExpiredMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListExpiredMock.invocationsDone()
+}
+
+// MinimockListExpiredInspect logs each unmet expectation
+func (m *OrdersRepositoryMock) MinimockListExpiredInspect() {
+	for _, e := range m.ListExpiredMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OrdersRepositoryMock.ListExpired at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListExpiredCounter := mm_atomic.LoadUint64(&m.afterListExpiredCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListExpiredMock.defaultExpectation != nil && afterListExpiredCounter < 1 {
+		if m.ListExpiredMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OrdersRepositoryMock.ListExpired at\n%s", m.ListExpiredMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OrdersRepositoryMock.ListExpired at\n%s with params: %#v", m.ListExpiredMock.defaultExpectation.expectationOrigins.origin, *m.ListExpiredMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListExpired != nil && afterListExpiredCounter < 1 {
+		m.t.Errorf("Expected call to OrdersRepositoryMock.ListExpired at\n%s", m.funcListExpiredOrigin)
+	}
+
+	if !m.ListExpiredMock.invocationsDone() && afterListExpiredCounter > 0 {
+		m.t.Errorf("Expected %d calls to OrdersRepositoryMock.ListExpired at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListExpiredMock.expectedInvocations), m.ListExpiredMock.expectedInvocationsOrigin, afterListExpiredCounter)
+	}
+}
+
+type mOrdersRepositoryMockSetStatus struct {
+	optional           bool
+	mock               *OrdersRepositoryMock
+	defaultExpectation *OrdersRepositoryMockSetStatusExpectation
+	expectations       []*OrdersRepositoryMockSetStatusExpectation
+
+	callArgs []*OrdersRepositoryMockSetStatusParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OrdersRepositoryMockSetStatusExpectation specifies expectation struct of the OrdersRepository.SetStatus
+type OrdersRepositoryMockSetStatusExpectation struct {
+	mock               *OrdersRepositoryMock
+	params             *OrdersRepositoryMockSetStatusParams
+	paramPtrs          *OrdersRepositoryMockSetStatusParamPtrs
+	expectationOrigins OrdersRepositoryMockSetStatusExpectationOrigins
+	results            *OrdersRepositoryMockSetStatusResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OrdersRepositoryMockSetStatusParams contains parameters of the OrdersRepository.SetStatus
+type OrdersRepositoryMockSetStatusParams struct {
+	ctx      context.Context
+	orderID  int64
+	expected domain.OrderStatus
+	status   domain.OrderStatus
+	reason   string
+}
+
+// OrdersRepositoryMockSetStatusParamPtrs contains pointers to parameters of the OrdersRepository.SetStatus
+type OrdersRepositoryMockSetStatusParamPtrs struct {
+	ctx      *context.Context
+	orderID  *int64
+	expected *domain.OrderStatus
+	status   *domain.OrderStatus
+	reason   *string
+}
+
+// OrdersRepositoryMockSetStatusResults contains results of the OrdersRepository.SetStatus
+type OrdersRepositoryMockSetStatusResults struct {
+	err error
+}
+
+// OrdersRepositoryMockSetStatusOrigins contains origins of expectations of the OrdersRepository.SetStatus
+type OrdersRepositoryMockSetStatusExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originOrderID  string
+	originExpected string
+	originStatus   string
+	originReason   string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSetStatus *mOrdersRepositoryMockSetStatus) Optional() *mOrdersRepositoryMockSetStatus {
+	mmSetStatus.optional = true
+	return mmSetStatus
+}
+
+// Expect sets up expected params for OrdersRepository.SetStatus
+func (mmSetStatus *mOrdersRepositoryMockSetStatus) Expect(ctx context.Context, orderID int64, expected domain.OrderStatus, status domain.OrderStatus, reason string) *mOrdersRepositoryMockSetStatus {
+	if mmSetStatus.mock.funcSetStatus != nil {
+		mmSetStatus.mock.t.Fatalf("OrdersRepositoryMock.SetStatus mock is already set by Set")
+	}
+
+	if mmSetStatus.defaultExpectation == nil {
+		mmSetStatus.defaultExpectation = &OrdersRepositoryMockSetStatusExpectation{}
+	}
+
+	if mmSetStatus.defaultExpectation.paramPtrs != nil {
+		mmSetStatus.mock.t.Fatalf("OrdersRepositoryMock.SetStatus mock is already set by ExpectParams functions")
+	}
+
+	mmSetStatus.defaultExpectation.params = &OrdersRepositoryMockSetStatusParams{ctx, orderID, expected, status, reason}
+	mmSetStatus.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSetStatus.expectations {
+		if minimock.Equal(e.params, mmSetStatus.defaultExpectation.params) {
+			mmSetStatus.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSetStatus.defaultExpectation.params)
+		}
+	}
+
+	return mmSetStatus
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OrdersRepository.SetStatus
+func (mmSetStatus *mOrdersRepositoryMockSetStatus) ExpectCtxParam1(ctx context.Context) *mOrdersRepositoryMockSetStatus {
+	if mmSetStatus.mock.funcSetStatus != nil {
+		mmSetStatus.mock.t.Fatalf("OrdersRepositoryMock.SetStatus mock is already set by Set")
+	}
+
+	if mmSetStatus.defaultExpectation == nil {
+		mmSetStatus.defaultExpectation = &OrdersRepositoryMockSetStatusExpectation{}
+	}
+
+	if mmSetStatus.defaultExpectation.params != nil {
+		mmSetStatus.mock.t.Fatalf("OrdersRepositoryMock.SetStatus mock is already set by Expect")
+	}
+
+	if mmSetStatus.defaultExpectation.paramPtrs == nil {
+		mmSetStatus.defaultExpectation.paramPtrs = &OrdersRepositoryMockSetStatusParamPtrs{}
+	}
+	mmSetStatus.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSetStatus.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSetStatus
+}
+
+// ExpectOrderIDParam2 sets up expected param orderID for OrdersRepository.SetStatus
+func (mmSetStatus *mOrdersRepositoryMockSetStatus) ExpectOrderIDParam2(orderID int64) *mOrdersRepositoryMockSetStatus {
+	if mmSetStatus.mock.funcSetStatus != nil {
+		mmSetStatus.mock.t.Fatalf("OrdersRepositoryMock.SetStatus mock is already set by Set")
+	}
+
+	if mmSetStatus.defaultExpectation == nil {
+		mmSetStatus.defaultExpectation = &OrdersRepositoryMockSetStatusExpectation{}
+	}
+
+	if mmSetStatus.defaultExpectation.params != nil {
+		mmSetStatus.mock.t.Fatalf("OrdersRepositoryMock.SetStatus mock is already set by Expect")
+	}
+
+	if mmSetStatus.defaultExpectation.paramPtrs == nil {
+		mmSetStatus.defaultExpectation.paramPtrs = &OrdersRepositoryMockSetStatusParamPtrs{}
+	}
+	mmSetStatus.defaultExpectation.paramPtrs.orderID = &orderID
+	mmSetStatus.defaultExpectation.expectationOrigins.originOrderID = minimock.CallerInfo(1)
+
+	return mmSetStatus
+}
+
+// ExpectExpectedParam3 sets up expected param expected for OrdersRepository.SetStatus
+func (mmSetStatus *mOrdersRepositoryMockSetStatus) ExpectExpectedParam3(expected domain.OrderStatus) *mOrdersRepositoryMockSetStatus {
+	if mmSetStatus.mock.funcSetStatus != nil {
+		mmSetStatus.mock.t.Fatalf("OrdersRepositoryMock.SetStatus mock is already set by Set")
+	}
+
+	if mmSetStatus.defaultExpectation == nil {
+		mmSetStatus.defaultExpectation = &OrdersRepositoryMockSetStatusExpectation{}
+	}
+
+	if mmSetStatus.defaultExpectation.params != nil {
+		mmSetStatus.mock.t.Fatalf("OrdersRepositoryMock.SetStatus mock is already set by Expect")
+	}
+
+	if mmSetStatus.defaultExpectation.paramPtrs == nil {
+		mmSetStatus.defaultExpectation.paramPtrs = &OrdersRepositoryMockSetStatusParamPtrs{}
+	}
+	mmSetStatus.defaultExpectation.paramPtrs.expected = &expected
+	mmSetStatus.defaultExpectation.expectationOrigins.originExpected = minimock.CallerInfo(1)
+
+	return mmSetStatus
+}
+
+// ExpectStatusParam4 sets up expected param status for OrdersRepository.SetStatus
+func (mmSetStatus *mOrdersRepositoryMockSetStatus) ExpectStatusParam4(status domain.OrderStatus) *mOrdersRepositoryMockSetStatus {
+	if mmSetStatus.mock.funcSetStatus != nil {
+		mmSetStatus.mock.t.Fatalf("OrdersRepositoryMock.SetStatus mock is already set by Set")
+	}
+
+	if mmSetStatus.defaultExpectation == nil {
+		mmSetStatus.defaultExpectation = &OrdersRepositoryMockSetStatusExpectation{}
+	}
+
+	if mmSetStatus.defaultExpectation.params != nil {
+		mmSetStatus.mock.t.Fatalf("OrdersRepositoryMock.SetStatus mock is already set by Expect")
+	}
+
+	if mmSetStatus.defaultExpectation.paramPtrs == nil {
+		mmSetStatus.defaultExpectation.paramPtrs = &OrdersRepositoryMockSetStatusParamPtrs{}
+	}
+	mmSetStatus.defaultExpectation.paramPtrs.status = &status
+	mmSetStatus.defaultExpectation.expectationOrigins.originStatus = minimock.CallerInfo(1)
+
+	return mmSetStatus
+}
+
+// ExpectReasonParam5 sets up expected param reason for OrdersRepository.SetStatus
+func (mmSetStatus *mOrdersRepositoryMockSetStatus) ExpectReasonParam5(reason string) *mOrdersRepositoryMockSetStatus {
+	if mmSetStatus.mock.funcSetStatus != nil {
+		mmSetStatus.mock.t.Fatalf("OrdersRepositoryMock.SetStatus mock is already set by Set")
+	}
+
+	if mmSetStatus.defaultExpectation == nil {
+		mmSetStatus.defaultExpectation = &OrdersRepositoryMockSetStatusExpectation{}
+	}
+
+	if mmSetStatus.defaultExpectation.params != nil {
+		mmSetStatus.mock.t.Fatalf("OrdersRepositoryMock.SetStatus mock is already set by Expect")
+	}
+
+	if mmSetStatus.defaultExpectation.paramPtrs == nil {
+		mmSetStatus.defaultExpectation.paramPtrs = &OrdersRepositoryMockSetStatusParamPtrs{}
+	}
+	mmSetStatus.defaultExpectation.paramPtrs.reason = &reason
+	mmSetStatus.defaultExpectation.expectationOrigins.originReason = minimock.CallerInfo(1)
+
+	return mmSetStatus
+}
+
+// Inspect accepts an inspector function that has same arguments as the OrdersRepository.SetStatus
+func (mmSetStatus *mOrdersRepositoryMockSetStatus) Inspect(f func(ctx context.Context, orderID int64, expected domain.OrderStatus, status domain.OrderStatus, reason string)) *mOrdersRepositoryMockSetStatus {
+	if mmSetStatus.mock.inspectFuncSetStatus != nil {
+		mmSetStatus.mock.t.Fatalf("Inspect function is already set for OrdersRepositoryMock.SetStatus")
+	}
+
+	mmSetStatus.mock.inspectFuncSetStatus = f
+
+	return mmSetStatus
+}
+
+// Return sets up results that will be returned by OrdersRepository.SetStatus
+func (mmSetStatus *mOrdersRepositoryMockSetStatus) Return(err error) *OrdersRepositoryMock {
+	if mmSetStatus.mock.funcSetStatus != nil {
+		mmSetStatus.mock.t.Fatalf("OrdersRepositoryMock.SetStatus mock is already set by Set")
+	}
+
+	if mmSetStatus.defaultExpectation == nil {
+		mmSetStatus.defaultExpectation = &OrdersRepositoryMockSetStatusExpectation{mock: mmSetStatus.mock}
+	}
+	mmSetStatus.defaultExpectation.results = &OrdersRepositoryMockSetStatusResults{err}
+	mmSetStatus.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSetStatus.mock
+}
+
+// Set uses given function f to mock the OrdersRepository.SetStatus method
+func (mmSetStatus *mOrdersRepositoryMockSetStatus) Set(f func(ctx context.Context, orderID int64, expected domain.OrderStatus, status domain.OrderStatus, reason string) (err error)) *OrdersRepositoryMock {
+	if mmSetStatus.defaultExpectation != nil {
+		mmSetStatus.mock.t.Fatalf("Default expectation is already set for the OrdersRepository.SetStatus method")
+	}
+
+	if len(mmSetStatus.expectations) > 0 {
+		mmSetStatus.mock.t.Fatalf("Some expectations are already set for the OrdersRepository.SetStatus method")
+	}
+
+	mmSetStatus.mock.funcSetStatus = f
+	mmSetStatus.mock.funcSetStatusOrigin = minimock.CallerInfo(1)
+	return mmSetStatus.mock
+}
+
+// When sets expectation for the OrdersRepository.SetStatus which will trigger the result defined by the following
+// Then helper
+func (mmSetStatus *mOrdersRepositoryMockSetStatus) When(ctx context.Context, orderID int64, expected domain.OrderStatus, status domain.OrderStatus, reason string) *OrdersRepositoryMockSetStatusExpectation {
+	if mmSetStatus.mock.funcSetStatus != nil {
+		mmSetStatus.mock.t.Fatalf("OrdersRepositoryMock.SetStatus mock is already set by Set")
+	}
+
+	expectation := &OrdersRepositoryMockSetStatusExpectation{
+		mock:               mmSetStatus.mock,
+		params:             &OrdersRepositoryMockSetStatusParams{ctx, orderID, expected, status, reason},
+		expectationOrigins: OrdersRepositoryMockSetStatusExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSetStatus.expectations = append(mmSetStatus.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OrdersRepository.SetStatus return parameters for the expectation previously defined by the When method
+func (e *OrdersRepositoryMockSetStatusExpectation) Then(err error) *OrdersRepositoryMock {
+	e.results = &OrdersRepositoryMockSetStatusResults{err}
+	return e.mock
+}
+
+// Times sets number of times OrdersRepository.SetStatus should be invoked
+func (mmSetStatus *mOrdersRepositoryMockSetStatus) Times(n uint64) *mOrdersRepositoryMockSetStatus {
+	if n == 0 {
+		mmSetStatus.mock.t.Fatalf("Times of OrdersRepositoryMock.SetStatus mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSetStatus.expectedInvocations, n)
+	mmSetStatus.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSetStatus
+}
+
+func (mmSetStatus *mOrdersRepositoryMockSetStatus) invocationsDone() bool {
+	if len(mmSetStatus.expectations) == 0 && mmSetStatus.defaultExpectation == nil && mmSetStatus.mock.funcSetStatus == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSetStatus.mock.afterSetStatusCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSetStatus.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SetStatus implements mm_loms.OrdersRepository
+func (mmSetStatus *OrdersRepositoryMock) SetStatus(ctx context.Context, orderID int64, expected domain.OrderStatus, status domain.OrderStatus, reason string) (err error) {
+	mm_atomic.AddUint64(&mmSetStatus.beforeSetStatusCounter, 1)
+	defer mm_atomic.AddUint64(&mmSetStatus.afterSetStatusCounter, 1)
+
+	mmSetStatus.t.Helper()
+
+	if mmSetStatus.inspectFuncSetStatus != nil {
+		mmSetStatus.inspectFuncSetStatus(ctx, orderID, expected, status, reason)
+	}
+
+	mm_params := OrdersRepositoryMockSetStatusParams{ctx, orderID, expected, status, reason}
+
+	// Record call args
+	mmSetStatus.SetStatusMock.mutex.Lock()
+	mmSetStatus.SetStatusMock.callArgs = append(mmSetStatus.SetStatusMock.callArgs, &mm_params)
+	mmSetStatus.SetStatusMock.mutex.Unlock()
+
+	for _, e := range mmSetStatus.SetStatusMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSetStatus.SetStatusMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSetStatus.SetStatusMock.defaultExpectation.Counter, 1)
+		mm_want := mmSetStatus.SetStatusMock.defaultExpectation.params
+		mm_want_ptrs := mmSetStatus.SetStatusMock.defaultExpectation.paramPtrs
+
+		mm_got := OrdersRepositoryMockSetStatusParams{ctx, orderID, expected, status, reason}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSetStatus.t.Errorf("OrdersRepositoryMock.SetStatus got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetStatus.SetStatusMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.orderID != nil && !minimock.Equal(*mm_want_ptrs.orderID, mm_got.orderID) {
+				mmSetStatus.t.Errorf("OrdersRepositoryMock.SetStatus got unexpected parameter orderID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetStatus.SetStatusMock.defaultExpectation.expectationOrigins.originOrderID, *mm_want_ptrs.orderID, mm_got.orderID, minimock.Diff(*mm_want_ptrs.orderID, mm_got.orderID))
+			}
+
+			if mm_want_ptrs.expected != nil && !minimock.Equal(*mm_want_ptrs.expected, mm_got.expected) {
+				mmSetStatus.t.Errorf("OrdersRepositoryMock.SetStatus got unexpected parameter expected, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetStatus.SetStatusMock.defaultExpectation.expectationOrigins.originExpected, *mm_want_ptrs.expected, mm_got.expected, minimock.Diff(*mm_want_ptrs.expected, mm_got.expected))
+			}
+
+			if mm_want_ptrs.status != nil && !minimock.Equal(*mm_want_ptrs.status, mm_got.status) {
+				mmSetStatus.t.Errorf("OrdersRepositoryMock.SetStatus got unexpected parameter status, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetStatus.SetStatusMock.defaultExpectation.expectationOrigins.originStatus, *mm_want_ptrs.status, mm_got.status, minimock.Diff(*mm_want_ptrs.status, mm_got.status))
+			}
+
+			if mm_want_ptrs.reason != nil && !minimock.Equal(*mm_want_ptrs.reason, mm_got.reason) {
+				mmSetStatus.t.Errorf("OrdersRepositoryMock.SetStatus got unexpected parameter reason, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetStatus.SetStatusMock.defaultExpectation.expectationOrigins.originReason, *mm_want_ptrs.reason, mm_got.reason, minimock.Diff(*mm_want_ptrs.reason, mm_got.reason))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSetStatus.t.Errorf("OrdersRepositoryMock.SetStatus got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSetStatus.SetStatusMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSetStatus.SetStatusMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSetStatus.t.Fatal("No results are set for the OrdersRepositoryMock.SetStatus")
+		}
+		return (*mm_results).err
+	}
+	if mmSetStatus.funcSetStatus != nil {
+		return mmSetStatus.funcSetStatus(ctx, orderID, expected, status, reason)
+	}
+	mmSetStatus.t.Fatalf("Unexpected call to OrdersRepositoryMock.SetStatus. %v %v %v %v %v", ctx, orderID, expected, status, reason)
+	return
+}
+
+// SetStatusAfterCounter returns a count of finished OrdersRepositoryMock.SetStatus invocations
+func (mmSetStatus *OrdersRepositoryMock) SetStatusAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetStatus.afterSetStatusCounter)
+}
+
+// SetStatusBeforeCounter returns a count of OrdersRepositoryMock.SetStatus invocations
+func (mmSetStatus *OrdersRepositoryMock) SetStatusBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetStatus.beforeSetStatusCounter)
+}
+
+// Calls returns a list of arguments used in each call to OrdersRepositoryMock.SetStatus.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSetStatus *mOrdersRepositoryMockSetStatus) Calls() []*OrdersRepositoryMockSetStatusParams {
+	mmSetStatus.mutex.RLock()
+
+	argCopy := make([]*OrdersRepositoryMockSetStatusParams, len(mmSetStatus.callArgs))
+	copy(argCopy, mmSetStatus.callArgs)
+
+	mmSetStatus.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSetStatusDone returns true if the count of the SetStatus invocations corresponds
+// the number of defined expectations
+func (m *OrdersRepositoryMock) MinimockSetStatusDone() bool {
+	if m.SetStatusMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SetStatusMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SetStatusMock.invocationsDone()
+}
+
+// MinimockSetStatusInspect logs each unmet expectation
+func (m *OrdersRepositoryMock) MinimockSetStatusInspect() {
+	for _, e := range m.SetStatusMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OrdersRepositoryMock.SetStatus at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSetStatusCounter := mm_atomic.LoadUint64(&m.afterSetStatusCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SetStatusMock.defaultExpectation != nil && afterSetStatusCounter < 1 {
+		if m.SetStatusMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OrdersRepositoryMock.SetStatus at\n%s", m.SetStatusMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OrdersRepositoryMock.SetStatus at\n%s with params: %#v", m.SetStatusMock.defaultExpectation.expectationOrigins.origin, *m.SetStatusMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSetStatus != nil && afterSetStatusCounter < 1 {
+		m.t.Errorf("Expected call to OrdersRepositoryMock.SetStatus at\n%s", m.funcSetStatusOrigin)
+	}
+
+	if !m.SetStatusMock.invocationsDone() && afterSetStatusCounter > 0 {
+		m.t.Errorf("Expected %d calls to OrdersRepositoryMock.SetStatus at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SetStatusMock.expectedInvocations), m.SetStatusMock.expectedInvocationsOrigin, afterSetStatusCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *OrdersRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCreateInspect()
+
+			m.MinimockGetByIDInspect()
+
+			m.MinimockHistoryInspect()
+
+			m.MinimockListInspect()
+
+			m.MinimockListExpiredInspect()
 
 			m.MinimockSetStatusInspect()
 		}
@@ -1187,5 +2408,8 @@ func (m *OrdersRepositoryMock) minimockDone() bool {
 	return done &&
 		m.MinimockCreateDone() &&
 		m.MinimockGetByIDDone() &&
+		m.MinimockHistoryDone() &&
+		m.MinimockListDone() &&
+		m.MinimockListExpiredDone() &&
 		m.MinimockSetStatusDone()
 }
@@ -11,6 +11,7 @@ import (
 	mm_time "time"
 
 	"github.com/gojuno/minimock/v3"
+	"github.com/vestamart/loms/internal/domain"
 )
 
 // StocksStorageMock implements mm_loms.StocksStorage
@@ -18,40 +19,68 @@ type StocksStorageMock struct {
 	t          minimock.Tester
 	finishOnce sync.Once
 
-	funcGetBySKU          func(ctx context.Context, sku uint32) (u1 uint32, err error)
-	funcGetBySKUOrigin    string
-	inspectFuncGetBySKU   func(ctx context.Context, sku uint32)
-	afterGetBySKUCounter  uint64
-	beforeGetBySKUCounter uint64
-	GetBySKUMock          mStocksStorageMockGetBySKU
-
-	funcReserve          func(ctx context.Context, sku uint32, count uint32) (err error)
+	funcAdjust          func(ctx context.Context, key domain.StockKey, delta int32, reason string) (s1 domain.StocksItem, err error)
+	funcAdjustOrigin    string
+	inspectFuncAdjust   func(ctx context.Context, key domain.StockKey, delta int32, reason string)
+	afterAdjustCounter  uint64
+	beforeAdjustCounter uint64
+	AdjustMock          mStocksStorageMockAdjust
+
+	funcCreate          func(ctx context.Context, key domain.StockKey, totalCount uint32) (err error)
+	funcCreateOrigin    string
+	inspectFuncCreate   func(ctx context.Context, key domain.StockKey, totalCount uint32)
+	afterCreateCounter  uint64
+	beforeCreateCounter uint64
+	CreateMock          mStocksStorageMockCreate
+
+	funcDelete          func(ctx context.Context, key domain.StockKey) (err error)
+	funcDeleteOrigin    string
+	inspectFuncDelete   func(ctx context.Context, key domain.StockKey)
+	afterDeleteCounter  uint64
+	beforeDeleteCounter uint64
+	DeleteMock          mStocksStorageMockDelete
+
+	funcGetBySKUs          func(ctx context.Context, skus []uint32) (m1 map[uint32]domain.StocksItem, err error)
+	funcGetBySKUsOrigin    string
+	inspectFuncGetBySKUs   func(ctx context.Context, skus []uint32)
+	afterGetBySKUsCounter  uint64
+	beforeGetBySKUsCounter uint64
+	GetBySKUsMock          mStocksStorageMockGetBySKUs
+
+	funcGetWarehouseStocks          func(ctx context.Context, sku uint32) (wa1 []domain.WarehouseStock, err error)
+	funcGetWarehouseStocksOrigin    string
+	inspectFuncGetWarehouseStocks   func(ctx context.Context, sku uint32)
+	afterGetWarehouseStocksCounter  uint64
+	beforeGetWarehouseStocksCounter uint64
+	GetWarehouseStocksMock          mStocksStorageMockGetWarehouseStocks
+
+	funcReplenish          func(ctx context.Context, key domain.StockKey, count uint32) (s1 domain.StocksItem, err error)
+	funcReplenishOrigin    string
+	inspectFuncReplenish   func(ctx context.Context, key domain.StockKey, count uint32)
+	afterReplenishCounter  uint64
+	beforeReplenishCounter uint64
+	ReplenishMock          mStocksStorageMockReplenish
+
+	funcReserve          func(ctx context.Context, key domain.StockKey, count uint32) (err error)
 	funcReserveOrigin    string
-	inspectFuncReserve   func(ctx context.Context, sku uint32, count uint32)
+	inspectFuncReserve   func(ctx context.Context, key domain.StockKey, count uint32)
 	afterReserveCounter  uint64
 	beforeReserveCounter uint64
 	ReserveMock          mStocksStorageMockReserve
 
-	funcReserveCancel          func(ctx context.Context, skus map[uint32]uint32) (err error)
+	funcReserveCancel          func(ctx context.Context, items map[domain.StockKey]uint32) (err error)
 	funcReserveCancelOrigin    string
-	inspectFuncReserveCancel   func(ctx context.Context, skus map[uint32]uint32)
+	inspectFuncReserveCancel   func(ctx context.Context, items map[domain.StockKey]uint32)
 	afterReserveCancelCounter  uint64
 	beforeReserveCancelCounter uint64
 	ReserveCancelMock          mStocksStorageMockReserveCancel
 
-	funcReserveRemove          func(ctx context.Context, sku uint32, count uint32) (err error)
+	funcReserveRemove          func(ctx context.Context, items map[domain.StockKey]uint32) (err error)
 	funcReserveRemoveOrigin    string
-	inspectFuncReserveRemove   func(ctx context.Context, sku uint32, count uint32)
+	inspectFuncReserveRemove   func(ctx context.Context, items map[domain.StockKey]uint32)
 	afterReserveRemoveCounter  uint64
 	beforeReserveRemoveCounter uint64
 	ReserveRemoveMock          mStocksStorageMockReserveRemove
-
-	funcRollbackReserve          func(ctx context.Context, skus map[uint32]uint32) (err error)
-	funcRollbackReserveOrigin    string
-	inspectFuncRollbackReserve   func(ctx context.Context, skus map[uint32]uint32)
-	afterRollbackReserveCounter  uint64
-	beforeRollbackReserveCounter uint64
-	RollbackReserveMock          mStocksStorageMockRollbackReserve
 }
 
 // NewStocksStorageMock returns a mock for mm_loms.StocksStorage
@@ -62,8 +91,23 @@ func NewStocksStorageMock(t minimock.Tester) *StocksStorageMock {
 		controller.RegisterMocker(m)
 	}
 
-	m.GetBySKUMock = mStocksStorageMockGetBySKU{mock: m}
-	m.GetBySKUMock.callArgs = []*StocksStorageMockGetBySKUParams{}
+	m.AdjustMock = mStocksStorageMockAdjust{mock: m}
+	m.AdjustMock.callArgs = []*StocksStorageMockAdjustParams{}
+
+	m.CreateMock = mStocksStorageMockCreate{mock: m}
+	m.CreateMock.callArgs = []*StocksStorageMockCreateParams{}
+
+	m.DeleteMock = mStocksStorageMockDelete{mock: m}
+	m.DeleteMock.callArgs = []*StocksStorageMockDeleteParams{}
+
+	m.GetBySKUsMock = mStocksStorageMockGetBySKUs{mock: m}
+	m.GetBySKUsMock.callArgs = []*StocksStorageMockGetBySKUsParams{}
+
+	m.GetWarehouseStocksMock = mStocksStorageMockGetWarehouseStocks{mock: m}
+	m.GetWarehouseStocksMock.callArgs = []*StocksStorageMockGetWarehouseStocksParams{}
+
+	m.ReplenishMock = mStocksStorageMockReplenish{mock: m}
+	m.ReplenishMock.callArgs = []*StocksStorageMockReplenishParams{}
 
 	m.ReserveMock = mStocksStorageMockReserve{mock: m}
 	m.ReserveMock.callArgs = []*StocksStorageMockReserveParams{}
@@ -74,61 +118,1867 @@ func NewStocksStorageMock(t minimock.Tester) *StocksStorageMock {
 	m.ReserveRemoveMock = mStocksStorageMockReserveRemove{mock: m}
 	m.ReserveRemoveMock.callArgs = []*StocksStorageMockReserveRemoveParams{}
 
-	m.RollbackReserveMock = mStocksStorageMockRollbackReserve{mock: m}
-	m.RollbackReserveMock.callArgs = []*StocksStorageMockRollbackReserveParams{}
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mStocksStorageMockAdjust struct {
+	optional           bool
+	mock               *StocksStorageMock
+	defaultExpectation *StocksStorageMockAdjustExpectation
+	expectations       []*StocksStorageMockAdjustExpectation
+
+	callArgs []*StocksStorageMockAdjustParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StocksStorageMockAdjustExpectation specifies expectation struct of the StocksStorage.Adjust
+type StocksStorageMockAdjustExpectation struct {
+	mock               *StocksStorageMock
+	params             *StocksStorageMockAdjustParams
+	paramPtrs          *StocksStorageMockAdjustParamPtrs
+	expectationOrigins StocksStorageMockAdjustExpectationOrigins
+	results            *StocksStorageMockAdjustResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StocksStorageMockAdjustParams contains parameters of the StocksStorage.Adjust
+type StocksStorageMockAdjustParams struct {
+	ctx    context.Context
+	key    domain.StockKey
+	delta  int32
+	reason string
+}
+
+// StocksStorageMockAdjustParamPtrs contains pointers to parameters of the StocksStorage.Adjust
+type StocksStorageMockAdjustParamPtrs struct {
+	ctx    *context.Context
+	key    *domain.StockKey
+	delta  *int32
+	reason *string
+}
+
+// StocksStorageMockAdjustResults contains results of the StocksStorage.Adjust
+type StocksStorageMockAdjustResults struct {
+	s1  domain.StocksItem
+	err error
+}
+
+// StocksStorageMockAdjustOrigins contains origins of expectations of the StocksStorage.Adjust
+type StocksStorageMockAdjustExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originKey    string
+	originDelta  string
+	originReason string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmAdjust *mStocksStorageMockAdjust) Optional() *mStocksStorageMockAdjust {
+	mmAdjust.optional = true
+	return mmAdjust
+}
+
+// Expect sets up expected params for StocksStorage.Adjust
+func (mmAdjust *mStocksStorageMockAdjust) Expect(ctx context.Context, key domain.StockKey, delta int32, reason string) *mStocksStorageMockAdjust {
+	if mmAdjust.mock.funcAdjust != nil {
+		mmAdjust.mock.t.Fatalf("StocksStorageMock.Adjust mock is already set by Set")
+	}
+
+	if mmAdjust.defaultExpectation == nil {
+		mmAdjust.defaultExpectation = &StocksStorageMockAdjustExpectation{}
+	}
+
+	if mmAdjust.defaultExpectation.paramPtrs != nil {
+		mmAdjust.mock.t.Fatalf("StocksStorageMock.Adjust mock is already set by ExpectParams functions")
+	}
+
+	mmAdjust.defaultExpectation.params = &StocksStorageMockAdjustParams{ctx, key, delta, reason}
+	mmAdjust.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAdjust.expectations {
+		if minimock.Equal(e.params, mmAdjust.defaultExpectation.params) {
+			mmAdjust.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAdjust.defaultExpectation.params)
+		}
+	}
+
+	return mmAdjust
+}
+
+// ExpectCtxParam1 sets up expected param ctx for StocksStorage.Adjust
+func (mmAdjust *mStocksStorageMockAdjust) ExpectCtxParam1(ctx context.Context) *mStocksStorageMockAdjust {
+	if mmAdjust.mock.funcAdjust != nil {
+		mmAdjust.mock.t.Fatalf("StocksStorageMock.Adjust mock is already set by Set")
+	}
+
+	if mmAdjust.defaultExpectation == nil {
+		mmAdjust.defaultExpectation = &StocksStorageMockAdjustExpectation{}
+	}
+
+	if mmAdjust.defaultExpectation.params != nil {
+		mmAdjust.mock.t.Fatalf("StocksStorageMock.Adjust mock is already set by Expect")
+	}
+
+	if mmAdjust.defaultExpectation.paramPtrs == nil {
+		mmAdjust.defaultExpectation.paramPtrs = &StocksStorageMockAdjustParamPtrs{}
+	}
+	mmAdjust.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAdjust.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmAdjust
+}
+
+// ExpectKeyParam2 sets up expected param key for StocksStorage.Adjust
+func (mmAdjust *mStocksStorageMockAdjust) ExpectKeyParam2(key domain.StockKey) *mStocksStorageMockAdjust {
+	if mmAdjust.mock.funcAdjust != nil {
+		mmAdjust.mock.t.Fatalf("StocksStorageMock.Adjust mock is already set by Set")
+	}
+
+	if mmAdjust.defaultExpectation == nil {
+		mmAdjust.defaultExpectation = &StocksStorageMockAdjustExpectation{}
+	}
+
+	if mmAdjust.defaultExpectation.params != nil {
+		mmAdjust.mock.t.Fatalf("StocksStorageMock.Adjust mock is already set by Expect")
+	}
+
+	if mmAdjust.defaultExpectation.paramPtrs == nil {
+		mmAdjust.defaultExpectation.paramPtrs = &StocksStorageMockAdjustParamPtrs{}
+	}
+	mmAdjust.defaultExpectation.paramPtrs.key = &key
+	mmAdjust.defaultExpectation.expectationOrigins.originKey = minimock.CallerInfo(1)
+
+	return mmAdjust
+}
+
+// ExpectDeltaParam3 sets up expected param delta for StocksStorage.Adjust
+func (mmAdjust *mStocksStorageMockAdjust) ExpectDeltaParam3(delta int32) *mStocksStorageMockAdjust {
+	if mmAdjust.mock.funcAdjust != nil {
+		mmAdjust.mock.t.Fatalf("StocksStorageMock.Adjust mock is already set by Set")
+	}
+
+	if mmAdjust.defaultExpectation == nil {
+		mmAdjust.defaultExpectation = &StocksStorageMockAdjustExpectation{}
+	}
+
+	if mmAdjust.defaultExpectation.params != nil {
+		mmAdjust.mock.t.Fatalf("StocksStorageMock.Adjust mock is already set by Expect")
+	}
+
+	if mmAdjust.defaultExpectation.paramPtrs == nil {
+		mmAdjust.defaultExpectation.paramPtrs = &StocksStorageMockAdjustParamPtrs{}
+	}
+	mmAdjust.defaultExpectation.paramPtrs.delta = &delta
+	mmAdjust.defaultExpectation.expectationOrigins.originDelta = minimock.CallerInfo(1)
+
+	return mmAdjust
+}
+
+// ExpectReasonParam4 sets up expected param reason for StocksStorage.Adjust
+func (mmAdjust *mStocksStorageMockAdjust) ExpectReasonParam4(reason string) *mStocksStorageMockAdjust {
+	if mmAdjust.mock.funcAdjust != nil {
+		mmAdjust.mock.t.Fatalf("StocksStorageMock.Adjust mock is already set by Set")
+	}
+
+	if mmAdjust.defaultExpectation == nil {
+		mmAdjust.defaultExpectation = &StocksStorageMockAdjustExpectation{}
+	}
+
+	if mmAdjust.defaultExpectation.params != nil {
+		mmAdjust.mock.t.Fatalf("StocksStorageMock.Adjust mock is already set by Expect")
+	}
+
+	if mmAdjust.defaultExpectation.paramPtrs == nil {
+		mmAdjust.defaultExpectation.paramPtrs = &StocksStorageMockAdjustParamPtrs{}
+	}
+	mmAdjust.defaultExpectation.paramPtrs.reason = &reason
+	mmAdjust.defaultExpectation.expectationOrigins.originReason = minimock.CallerInfo(1)
+
+	return mmAdjust
+}
+
+// Inspect accepts an inspector function that has same arguments as the StocksStorage.Adjust
+func (mmAdjust *mStocksStorageMockAdjust) Inspect(f func(ctx context.Context, key domain.StockKey, delta int32, reason string)) *mStocksStorageMockAdjust {
+	if mmAdjust.mock.inspectFuncAdjust != nil {
+		mmAdjust.mock.t.Fatalf("Inspect function is already set for StocksStorageMock.Adjust")
+	}
+
+	mmAdjust.mock.inspectFuncAdjust = f
+
+	return mmAdjust
+}
+
+// Return sets up results that will be returned by StocksStorage.Adjust
+func (mmAdjust *mStocksStorageMockAdjust) Return(s1 domain.StocksItem, err error) *StocksStorageMock {
+	if mmAdjust.mock.funcAdjust != nil {
+		mmAdjust.mock.t.Fatalf("StocksStorageMock.Adjust mock is already set by Set")
+	}
+
+	if mmAdjust.defaultExpectation == nil {
+		mmAdjust.defaultExpectation = &StocksStorageMockAdjustExpectation{mock: mmAdjust.mock}
+	}
+	mmAdjust.defaultExpectation.results = &StocksStorageMockAdjustResults{s1, err}
+	mmAdjust.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAdjust.mock
+}
+
+// Set uses given function f to mock the StocksStorage.Adjust method
+func (mmAdjust *mStocksStorageMockAdjust) Set(f func(ctx context.Context, key domain.StockKey, delta int32, reason string) (s1 domain.StocksItem, err error)) *StocksStorageMock {
+	if mmAdjust.defaultExpectation != nil {
+		mmAdjust.mock.t.Fatalf("Default expectation is already set for the StocksStorage.Adjust method")
+	}
+
+	if len(mmAdjust.expectations) > 0 {
+		mmAdjust.mock.t.Fatalf("Some expectations are already set for the StocksStorage.Adjust method")
+	}
+
+	mmAdjust.mock.funcAdjust = f
+	mmAdjust.mock.funcAdjustOrigin = minimock.CallerInfo(1)
+	return mmAdjust.mock
+}
+
+// When sets expectation for the StocksStorage.Adjust which will trigger the result defined by the following
+// Then helper
+func (mmAdjust *mStocksStorageMockAdjust) When(ctx context.Context, key domain.StockKey, delta int32, reason string) *StocksStorageMockAdjustExpectation {
+	if mmAdjust.mock.funcAdjust != nil {
+		mmAdjust.mock.t.Fatalf("StocksStorageMock.Adjust mock is already set by Set")
+	}
+
+	expectation := &StocksStorageMockAdjustExpectation{
+		mock:               mmAdjust.mock,
+		params:             &StocksStorageMockAdjustParams{ctx, key, delta, reason},
+		expectationOrigins: StocksStorageMockAdjustExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmAdjust.expectations = append(mmAdjust.expectations, expectation)
+	return expectation
+}
+
+// Then sets up StocksStorage.Adjust return parameters for the expectation previously defined by the When method
+func (e *StocksStorageMockAdjustExpectation) Then(s1 domain.StocksItem, err error) *StocksStorageMock {
+	e.results = &StocksStorageMockAdjustResults{s1, err}
+	return e.mock
+}
+
+// Times sets number of times StocksStorage.Adjust should be invoked
+func (mmAdjust *mStocksStorageMockAdjust) Times(n uint64) *mStocksStorageMockAdjust {
+	if n == 0 {
+		mmAdjust.mock.t.Fatalf("Times of StocksStorageMock.Adjust mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmAdjust.expectedInvocations, n)
+	mmAdjust.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAdjust
+}
+
+func (mmAdjust *mStocksStorageMockAdjust) invocationsDone() bool {
+	if len(mmAdjust.expectations) == 0 && mmAdjust.defaultExpectation == nil && mmAdjust.mock.funcAdjust == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmAdjust.mock.afterAdjustCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAdjust.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Adjust implements mm_loms.StocksStorage
+func (mmAdjust *StocksStorageMock) Adjust(ctx context.Context, key domain.StockKey, delta int32, reason string) (s1 domain.StocksItem, err error) {
+	mm_atomic.AddUint64(&mmAdjust.beforeAdjustCounter, 1)
+	defer mm_atomic.AddUint64(&mmAdjust.afterAdjustCounter, 1)
+
+	mmAdjust.t.Helper()
+
+	if mmAdjust.inspectFuncAdjust != nil {
+		mmAdjust.inspectFuncAdjust(ctx, key, delta, reason)
+	}
+
+	mm_params := StocksStorageMockAdjustParams{ctx, key, delta, reason}
+
+	// Record call args
+	mmAdjust.AdjustMock.mutex.Lock()
+	mmAdjust.AdjustMock.callArgs = append(mmAdjust.AdjustMock.callArgs, &mm_params)
+	mmAdjust.AdjustMock.mutex.Unlock()
+
+	for _, e := range mmAdjust.AdjustMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.s1, e.results.err
+		}
+	}
+
+	if mmAdjust.AdjustMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAdjust.AdjustMock.defaultExpectation.Counter, 1)
+		mm_want := mmAdjust.AdjustMock.defaultExpectation.params
+		mm_want_ptrs := mmAdjust.AdjustMock.defaultExpectation.paramPtrs
+
+		mm_got := StocksStorageMockAdjustParams{ctx, key, delta, reason}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAdjust.t.Errorf("StocksStorageMock.Adjust got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAdjust.AdjustMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.key != nil && !minimock.Equal(*mm_want_ptrs.key, mm_got.key) {
+				mmAdjust.t.Errorf("StocksStorageMock.Adjust got unexpected parameter key, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAdjust.AdjustMock.defaultExpectation.expectationOrigins.originKey, *mm_want_ptrs.key, mm_got.key, minimock.Diff(*mm_want_ptrs.key, mm_got.key))
+			}
+
+			if mm_want_ptrs.delta != nil && !minimock.Equal(*mm_want_ptrs.delta, mm_got.delta) {
+				mmAdjust.t.Errorf("StocksStorageMock.Adjust got unexpected parameter delta, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAdjust.AdjustMock.defaultExpectation.expectationOrigins.originDelta, *mm_want_ptrs.delta, mm_got.delta, minimock.Diff(*mm_want_ptrs.delta, mm_got.delta))
+			}
+
+			if mm_want_ptrs.reason != nil && !minimock.Equal(*mm_want_ptrs.reason, mm_got.reason) {
+				mmAdjust.t.Errorf("StocksStorageMock.Adjust got unexpected parameter reason, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAdjust.AdjustMock.defaultExpectation.expectationOrigins.originReason, *mm_want_ptrs.reason, mm_got.reason, minimock.Diff(*mm_want_ptrs.reason, mm_got.reason))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAdjust.t.Errorf("StocksStorageMock.Adjust got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAdjust.AdjustMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAdjust.AdjustMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAdjust.t.Fatal("No results are set for the StocksStorageMock.Adjust")
+		}
+		return (*mm_results).s1, (*mm_results).err
+	}
+	if mmAdjust.funcAdjust != nil {
+		return mmAdjust.funcAdjust(ctx, key, delta, reason)
+	}
+	mmAdjust.t.Fatalf("Unexpected call to StocksStorageMock.Adjust. %v %v %v %v", ctx, key, delta, reason)
+	return
+}
+
+// AdjustAfterCounter returns a count of finished StocksStorageMock.Adjust invocations
+func (mmAdjust *StocksStorageMock) AdjustAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAdjust.afterAdjustCounter)
+}
+
+// AdjustBeforeCounter returns a count of StocksStorageMock.Adjust invocations
+func (mmAdjust *StocksStorageMock) AdjustBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAdjust.beforeAdjustCounter)
+}
+
+// Calls returns a list of arguments used in each call to StocksStorageMock.Adjust.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAdjust *mStocksStorageMockAdjust) Calls() []*StocksStorageMockAdjustParams {
+	mmAdjust.mutex.RLock()
+
+	argCopy := make([]*StocksStorageMockAdjustParams, len(mmAdjust.callArgs))
+	copy(argCopy, mmAdjust.callArgs)
+
+	mmAdjust.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAdjustDone returns true if the count of the Adjust invocations corresponds
+// the number of defined expectations
+func (m *StocksStorageMock) MinimockAdjustDone() bool {
+	if m.AdjustMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.AdjustMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.AdjustMock.invocationsDone()
+}
+
+// MinimockAdjustInspect logs each unmet expectation
+func (m *StocksStorageMock) MinimockAdjustInspect() {
+	for _, e := range m.AdjustMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StocksStorageMock.Adjust at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterAdjustCounter := mm_atomic.LoadUint64(&m.afterAdjustCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AdjustMock.defaultExpectation != nil && afterAdjustCounter < 1 {
+		if m.AdjustMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StocksStorageMock.Adjust at\n%s", m.AdjustMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StocksStorageMock.Adjust at\n%s with params: %#v", m.AdjustMock.defaultExpectation.expectationOrigins.origin, *m.AdjustMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAdjust != nil && afterAdjustCounter < 1 {
+		m.t.Errorf("Expected call to StocksStorageMock.Adjust at\n%s", m.funcAdjustOrigin)
+	}
+
+	if !m.AdjustMock.invocationsDone() && afterAdjustCounter > 0 {
+		m.t.Errorf("Expected %d calls to StocksStorageMock.Adjust at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AdjustMock.expectedInvocations), m.AdjustMock.expectedInvocationsOrigin, afterAdjustCounter)
+	}
+}
+
+type mStocksStorageMockCreate struct {
+	optional           bool
+	mock               *StocksStorageMock
+	defaultExpectation *StocksStorageMockCreateExpectation
+	expectations       []*StocksStorageMockCreateExpectation
+
+	callArgs []*StocksStorageMockCreateParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StocksStorageMockCreateExpectation specifies expectation struct of the StocksStorage.Create
+type StocksStorageMockCreateExpectation struct {
+	mock               *StocksStorageMock
+	params             *StocksStorageMockCreateParams
+	paramPtrs          *StocksStorageMockCreateParamPtrs
+	expectationOrigins StocksStorageMockCreateExpectationOrigins
+	results            *StocksStorageMockCreateResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StocksStorageMockCreateParams contains parameters of the StocksStorage.Create
+type StocksStorageMockCreateParams struct {
+	ctx        context.Context
+	key        domain.StockKey
+	totalCount uint32
+}
+
+// StocksStorageMockCreateParamPtrs contains pointers to parameters of the StocksStorage.Create
+type StocksStorageMockCreateParamPtrs struct {
+	ctx        *context.Context
+	key        *domain.StockKey
+	totalCount *uint32
+}
+
+// StocksStorageMockCreateResults contains results of the StocksStorage.Create
+type StocksStorageMockCreateResults struct {
+	err error
+}
+
+// StocksStorageMockCreateOrigins contains origins of expectations of the StocksStorage.Create
+type StocksStorageMockCreateExpectationOrigins struct {
+	origin           string
+	originCtx        string
+	originKey        string
+	originTotalCount string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreate *mStocksStorageMockCreate) Optional() *mStocksStorageMockCreate {
+	mmCreate.optional = true
+	return mmCreate
+}
+
+// Expect sets up expected params for StocksStorage.Create
+func (mmCreate *mStocksStorageMockCreate) Expect(ctx context.Context, key domain.StockKey, totalCount uint32) *mStocksStorageMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("StocksStorageMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &StocksStorageMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs != nil {
+		mmCreate.mock.t.Fatalf("StocksStorageMock.Create mock is already set by ExpectParams functions")
+	}
+
+	mmCreate.defaultExpectation.params = &StocksStorageMockCreateParams{ctx, key, totalCount}
+	mmCreate.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreate.expectations {
+		if minimock.Equal(e.params, mmCreate.defaultExpectation.params) {
+			mmCreate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreate.defaultExpectation.params)
+		}
+	}
+
+	return mmCreate
+}
+
+// ExpectCtxParam1 sets up expected param ctx for StocksStorage.Create
+func (mmCreate *mStocksStorageMockCreate) ExpectCtxParam1(ctx context.Context) *mStocksStorageMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("StocksStorageMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &StocksStorageMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("StocksStorageMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &StocksStorageMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreate.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreate
+}
+
+// ExpectKeyParam2 sets up expected param key for StocksStorage.Create
+func (mmCreate *mStocksStorageMockCreate) ExpectKeyParam2(key domain.StockKey) *mStocksStorageMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("StocksStorageMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &StocksStorageMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("StocksStorageMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &StocksStorageMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.key = &key
+	mmCreate.defaultExpectation.expectationOrigins.originKey = minimock.CallerInfo(1)
+
+	return mmCreate
+}
+
+// ExpectTotalCountParam3 sets up expected param totalCount for StocksStorage.Create
+func (mmCreate *mStocksStorageMockCreate) ExpectTotalCountParam3(totalCount uint32) *mStocksStorageMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("StocksStorageMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &StocksStorageMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("StocksStorageMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &StocksStorageMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.totalCount = &totalCount
+	mmCreate.defaultExpectation.expectationOrigins.originTotalCount = minimock.CallerInfo(1)
+
+	return mmCreate
+}
+
+// Inspect accepts an inspector function that has same arguments as the StocksStorage.Create
+func (mmCreate *mStocksStorageMockCreate) Inspect(f func(ctx context.Context, key domain.StockKey, totalCount uint32)) *mStocksStorageMockCreate {
+	if mmCreate.mock.inspectFuncCreate != nil {
+		mmCreate.mock.t.Fatalf("Inspect function is already set for StocksStorageMock.Create")
+	}
+
+	mmCreate.mock.inspectFuncCreate = f
+
+	return mmCreate
+}
+
+// Return sets up results that will be returned by StocksStorage.Create
+func (mmCreate *mStocksStorageMockCreate) Return(err error) *StocksStorageMock {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("StocksStorageMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &StocksStorageMockCreateExpectation{mock: mmCreate.mock}
+	}
+	mmCreate.defaultExpectation.results = &StocksStorageMockCreateResults{err}
+	mmCreate.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreate.mock
+}
+
+// Set uses given function f to mock the StocksStorage.Create method
+func (mmCreate *mStocksStorageMockCreate) Set(f func(ctx context.Context, key domain.StockKey, totalCount uint32) (err error)) *StocksStorageMock {
+	if mmCreate.defaultExpectation != nil {
+		mmCreate.mock.t.Fatalf("Default expectation is already set for the StocksStorage.Create method")
+	}
+
+	if len(mmCreate.expectations) > 0 {
+		mmCreate.mock.t.Fatalf("Some expectations are already set for the StocksStorage.Create method")
+	}
+
+	mmCreate.mock.funcCreate = f
+	mmCreate.mock.funcCreateOrigin = minimock.CallerInfo(1)
+	return mmCreate.mock
+}
+
+// When sets expectation for the StocksStorage.Create which will trigger the result defined by the following
+// Then helper
+func (mmCreate *mStocksStorageMockCreate) When(ctx context.Context, key domain.StockKey, totalCount uint32) *StocksStorageMockCreateExpectation {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("StocksStorageMock.Create mock is already set by Set")
+	}
+
+	expectation := &StocksStorageMockCreateExpectation{
+		mock:               mmCreate.mock,
+		params:             &StocksStorageMockCreateParams{ctx, key, totalCount},
+		expectationOrigins: StocksStorageMockCreateExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreate.expectations = append(mmCreate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up StocksStorage.Create return parameters for the expectation previously defined by the When method
+func (e *StocksStorageMockCreateExpectation) Then(err error) *StocksStorageMock {
+	e.results = &StocksStorageMockCreateResults{err}
+	return e.mock
+}
+
+// Times sets number of times StocksStorage.Create should be invoked
+func (mmCreate *mStocksStorageMockCreate) Times(n uint64) *mStocksStorageMockCreate {
+	if n == 0 {
+		mmCreate.mock.t.Fatalf("Times of StocksStorageMock.Create mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreate.expectedInvocations, n)
+	mmCreate.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreate
+}
+
+func (mmCreate *mStocksStorageMockCreate) invocationsDone() bool {
+	if len(mmCreate.expectations) == 0 && mmCreate.defaultExpectation == nil && mmCreate.mock.funcCreate == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreate.mock.afterCreateCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreate.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Create implements mm_loms.StocksStorage
+func (mmCreate *StocksStorageMock) Create(ctx context.Context, key domain.StockKey, totalCount uint32) (err error) {
+	mm_atomic.AddUint64(&mmCreate.beforeCreateCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreate.afterCreateCounter, 1)
+
+	mmCreate.t.Helper()
+
+	if mmCreate.inspectFuncCreate != nil {
+		mmCreate.inspectFuncCreate(ctx, key, totalCount)
+	}
+
+	mm_params := StocksStorageMockCreateParams{ctx, key, totalCount}
+
+	// Record call args
+	mmCreate.CreateMock.mutex.Lock()
+	mmCreate.CreateMock.callArgs = append(mmCreate.CreateMock.callArgs, &mm_params)
+	mmCreate.CreateMock.mutex.Unlock()
+
+	for _, e := range mmCreate.CreateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCreate.CreateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreate.CreateMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreate.CreateMock.defaultExpectation.params
+		mm_want_ptrs := mmCreate.CreateMock.defaultExpectation.paramPtrs
+
+		mm_got := StocksStorageMockCreateParams{ctx, key, totalCount}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreate.t.Errorf("StocksStorageMock.Create got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreate.CreateMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.key != nil && !minimock.Equal(*mm_want_ptrs.key, mm_got.key) {
+				mmCreate.t.Errorf("StocksStorageMock.Create got unexpected parameter key, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreate.CreateMock.defaultExpectation.expectationOrigins.originKey, *mm_want_ptrs.key, mm_got.key, minimock.Diff(*mm_want_ptrs.key, mm_got.key))
+			}
+
+			if mm_want_ptrs.totalCount != nil && !minimock.Equal(*mm_want_ptrs.totalCount, mm_got.totalCount) {
+				mmCreate.t.Errorf("StocksStorageMock.Create got unexpected parameter totalCount, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreate.CreateMock.defaultExpectation.expectationOrigins.originTotalCount, *mm_want_ptrs.totalCount, mm_got.totalCount, minimock.Diff(*mm_want_ptrs.totalCount, mm_got.totalCount))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreate.t.Errorf("StocksStorageMock.Create got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreate.CreateMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreate.CreateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreate.t.Fatal("No results are set for the StocksStorageMock.Create")
+		}
+		return (*mm_results).err
+	}
+	if mmCreate.funcCreate != nil {
+		return mmCreate.funcCreate(ctx, key, totalCount)
+	}
+	mmCreate.t.Fatalf("Unexpected call to StocksStorageMock.Create. %v %v %v", ctx, key, totalCount)
+	return
+}
+
+// CreateAfterCounter returns a count of finished StocksStorageMock.Create invocations
+func (mmCreate *StocksStorageMock) CreateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.afterCreateCounter)
+}
+
+// CreateBeforeCounter returns a count of StocksStorageMock.Create invocations
+func (mmCreate *StocksStorageMock) CreateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.beforeCreateCounter)
+}
+
+// Calls returns a list of arguments used in each call to StocksStorageMock.Create.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreate *mStocksStorageMockCreate) Calls() []*StocksStorageMockCreateParams {
+	mmCreate.mutex.RLock()
+
+	argCopy := make([]*StocksStorageMockCreateParams, len(mmCreate.callArgs))
+	copy(argCopy, mmCreate.callArgs)
+
+	mmCreate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateDone returns true if the count of the Create invocations corresponds
+// the number of defined expectations
+func (m *StocksStorageMock) MinimockCreateDone() bool {
+	if m.CreateMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateMock.invocationsDone()
+}
+
+// MinimockCreateInspect logs each unmet expectation
+func (m *StocksStorageMock) MinimockCreateInspect() {
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StocksStorageMock.Create at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateCounter := mm_atomic.LoadUint64(&m.afterCreateCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateMock.defaultExpectation != nil && afterCreateCounter < 1 {
+		if m.CreateMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StocksStorageMock.Create at\n%s", m.CreateMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StocksStorageMock.Create at\n%s with params: %#v", m.CreateMock.defaultExpectation.expectationOrigins.origin, *m.CreateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreate != nil && afterCreateCounter < 1 {
+		m.t.Errorf("Expected call to StocksStorageMock.Create at\n%s", m.funcCreateOrigin)
+	}
+
+	if !m.CreateMock.invocationsDone() && afterCreateCounter > 0 {
+		m.t.Errorf("Expected %d calls to StocksStorageMock.Create at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateMock.expectedInvocations), m.CreateMock.expectedInvocationsOrigin, afterCreateCounter)
+	}
+}
+
+type mStocksStorageMockDelete struct {
+	optional           bool
+	mock               *StocksStorageMock
+	defaultExpectation *StocksStorageMockDeleteExpectation
+	expectations       []*StocksStorageMockDeleteExpectation
+
+	callArgs []*StocksStorageMockDeleteParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StocksStorageMockDeleteExpectation specifies expectation struct of the StocksStorage.Delete
+type StocksStorageMockDeleteExpectation struct {
+	mock               *StocksStorageMock
+	params             *StocksStorageMockDeleteParams
+	paramPtrs          *StocksStorageMockDeleteParamPtrs
+	expectationOrigins StocksStorageMockDeleteExpectationOrigins
+	results            *StocksStorageMockDeleteResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StocksStorageMockDeleteParams contains parameters of the StocksStorage.Delete
+type StocksStorageMockDeleteParams struct {
+	ctx context.Context
+	key domain.StockKey
+}
+
+// StocksStorageMockDeleteParamPtrs contains pointers to parameters of the StocksStorage.Delete
+type StocksStorageMockDeleteParamPtrs struct {
+	ctx *context.Context
+	key *domain.StockKey
+}
+
+// StocksStorageMockDeleteResults contains results of the StocksStorage.Delete
+type StocksStorageMockDeleteResults struct {
+	err error
+}
+
+// StocksStorageMockDeleteOrigins contains origins of expectations of the StocksStorage.Delete
+type StocksStorageMockDeleteExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originKey string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDelete *mStocksStorageMockDelete) Optional() *mStocksStorageMockDelete {
+	mmDelete.optional = true
+	return mmDelete
+}
+
+// Expect sets up expected params for StocksStorage.Delete
+func (mmDelete *mStocksStorageMockDelete) Expect(ctx context.Context, key domain.StockKey) *mStocksStorageMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("StocksStorageMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &StocksStorageMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs != nil {
+		mmDelete.mock.t.Fatalf("StocksStorageMock.Delete mock is already set by ExpectParams functions")
+	}
+
+	mmDelete.defaultExpectation.params = &StocksStorageMockDeleteParams{ctx, key}
+	mmDelete.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDelete.expectations {
+		if minimock.Equal(e.params, mmDelete.defaultExpectation.params) {
+			mmDelete.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDelete.defaultExpectation.params)
+		}
+	}
+
+	return mmDelete
+}
+
+// ExpectCtxParam1 sets up expected param ctx for StocksStorage.Delete
+func (mmDelete *mStocksStorageMockDelete) ExpectCtxParam1(ctx context.Context) *mStocksStorageMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("StocksStorageMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &StocksStorageMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.params != nil {
+		mmDelete.mock.t.Fatalf("StocksStorageMock.Delete mock is already set by Expect")
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs == nil {
+		mmDelete.defaultExpectation.paramPtrs = &StocksStorageMockDeleteParamPtrs{}
+	}
+	mmDelete.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDelete.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDelete
+}
+
+// ExpectKeyParam2 sets up expected param key for StocksStorage.Delete
+func (mmDelete *mStocksStorageMockDelete) ExpectKeyParam2(key domain.StockKey) *mStocksStorageMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("StocksStorageMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &StocksStorageMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.params != nil {
+		mmDelete.mock.t.Fatalf("StocksStorageMock.Delete mock is already set by Expect")
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs == nil {
+		mmDelete.defaultExpectation.paramPtrs = &StocksStorageMockDeleteParamPtrs{}
+	}
+	mmDelete.defaultExpectation.paramPtrs.key = &key
+	mmDelete.defaultExpectation.expectationOrigins.originKey = minimock.CallerInfo(1)
+
+	return mmDelete
+}
+
+// Inspect accepts an inspector function that has same arguments as the StocksStorage.Delete
+func (mmDelete *mStocksStorageMockDelete) Inspect(f func(ctx context.Context, key domain.StockKey)) *mStocksStorageMockDelete {
+	if mmDelete.mock.inspectFuncDelete != nil {
+		mmDelete.mock.t.Fatalf("Inspect function is already set for StocksStorageMock.Delete")
+	}
+
+	mmDelete.mock.inspectFuncDelete = f
+
+	return mmDelete
+}
+
+// Return sets up results that will be returned by StocksStorage.Delete
+func (mmDelete *mStocksStorageMockDelete) Return(err error) *StocksStorageMock {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("StocksStorageMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &StocksStorageMockDeleteExpectation{mock: mmDelete.mock}
+	}
+	mmDelete.defaultExpectation.results = &StocksStorageMockDeleteResults{err}
+	mmDelete.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDelete.mock
+}
+
+// Set uses given function f to mock the StocksStorage.Delete method
+func (mmDelete *mStocksStorageMockDelete) Set(f func(ctx context.Context, key domain.StockKey) (err error)) *StocksStorageMock {
+	if mmDelete.defaultExpectation != nil {
+		mmDelete.mock.t.Fatalf("Default expectation is already set for the StocksStorage.Delete method")
+	}
+
+	if len(mmDelete.expectations) > 0 {
+		mmDelete.mock.t.Fatalf("Some expectations are already set for the StocksStorage.Delete method")
+	}
+
+	mmDelete.mock.funcDelete = f
+	mmDelete.mock.funcDeleteOrigin = minimock.CallerInfo(1)
+	return mmDelete.mock
+}
+
+// When sets expectation for the StocksStorage.Delete which will trigger the result defined by the following
+// Then helper
+func (mmDelete *mStocksStorageMockDelete) When(ctx context.Context, key domain.StockKey) *StocksStorageMockDeleteExpectation {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("StocksStorageMock.Delete mock is already set by Set")
+	}
+
+	expectation := &StocksStorageMockDeleteExpectation{
+		mock:               mmDelete.mock,
+		params:             &StocksStorageMockDeleteParams{ctx, key},
+		expectationOrigins: StocksStorageMockDeleteExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDelete.expectations = append(mmDelete.expectations, expectation)
+	return expectation
+}
+
+// Then sets up StocksStorage.Delete return parameters for the expectation previously defined by the When method
+func (e *StocksStorageMockDeleteExpectation) Then(err error) *StocksStorageMock {
+	e.results = &StocksStorageMockDeleteResults{err}
+	return e.mock
+}
+
+// Times sets number of times StocksStorage.Delete should be invoked
+func (mmDelete *mStocksStorageMockDelete) Times(n uint64) *mStocksStorageMockDelete {
+	if n == 0 {
+		mmDelete.mock.t.Fatalf("Times of StocksStorageMock.Delete mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDelete.expectedInvocations, n)
+	mmDelete.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDelete
+}
+
+func (mmDelete *mStocksStorageMockDelete) invocationsDone() bool {
+	if len(mmDelete.expectations) == 0 && mmDelete.defaultExpectation == nil && mmDelete.mock.funcDelete == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDelete.mock.afterDeleteCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDelete.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Delete implements mm_loms.StocksStorage
+func (mmDelete *StocksStorageMock) Delete(ctx context.Context, key domain.StockKey) (err error) {
+	mm_atomic.AddUint64(&mmDelete.beforeDeleteCounter, 1)
+	defer mm_atomic.AddUint64(&mmDelete.afterDeleteCounter, 1)
+
+	mmDelete.t.Helper()
+
+	if mmDelete.inspectFuncDelete != nil {
+		mmDelete.inspectFuncDelete(ctx, key)
+	}
+
+	mm_params := StocksStorageMockDeleteParams{ctx, key}
+
+	// Record call args
+	mmDelete.DeleteMock.mutex.Lock()
+	mmDelete.DeleteMock.callArgs = append(mmDelete.DeleteMock.callArgs, &mm_params)
+	mmDelete.DeleteMock.mutex.Unlock()
+
+	for _, e := range mmDelete.DeleteMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDelete.DeleteMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDelete.DeleteMock.defaultExpectation.Counter, 1)
+		mm_want := mmDelete.DeleteMock.defaultExpectation.params
+		mm_want_ptrs := mmDelete.DeleteMock.defaultExpectation.paramPtrs
+
+		mm_got := StocksStorageMockDeleteParams{ctx, key}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDelete.t.Errorf("StocksStorageMock.Delete got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDelete.DeleteMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.key != nil && !minimock.Equal(*mm_want_ptrs.key, mm_got.key) {
+				mmDelete.t.Errorf("StocksStorageMock.Delete got unexpected parameter key, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDelete.DeleteMock.defaultExpectation.expectationOrigins.originKey, *mm_want_ptrs.key, mm_got.key, minimock.Diff(*mm_want_ptrs.key, mm_got.key))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDelete.t.Errorf("StocksStorageMock.Delete got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDelete.DeleteMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDelete.DeleteMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDelete.t.Fatal("No results are set for the StocksStorageMock.Delete")
+		}
+		return (*mm_results).err
+	}
+	if mmDelete.funcDelete != nil {
+		return mmDelete.funcDelete(ctx, key)
+	}
+	mmDelete.t.Fatalf("Unexpected call to StocksStorageMock.Delete. %v %v", ctx, key)
+	return
+}
+
+// DeleteAfterCounter returns a count of finished StocksStorageMock.Delete invocations
+func (mmDelete *StocksStorageMock) DeleteAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDelete.afterDeleteCounter)
+}
+
+// DeleteBeforeCounter returns a count of StocksStorageMock.Delete invocations
+func (mmDelete *StocksStorageMock) DeleteBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDelete.beforeDeleteCounter)
+}
+
+// Calls returns a list of arguments used in each call to StocksStorageMock.Delete.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDelete *mStocksStorageMockDelete) Calls() []*StocksStorageMockDeleteParams {
+	mmDelete.mutex.RLock()
+
+	argCopy := make([]*StocksStorageMockDeleteParams, len(mmDelete.callArgs))
+	copy(argCopy, mmDelete.callArgs)
+
+	mmDelete.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteDone returns true if the count of the Delete invocations corresponds
+// the number of defined expectations
+func (m *StocksStorageMock) MinimockDeleteDone() bool {
+	if m.DeleteMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteMock.invocationsDone()
+}
+
+// MinimockDeleteInspect logs each unmet expectation
+func (m *StocksStorageMock) MinimockDeleteInspect() {
+	for _, e := range m.DeleteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StocksStorageMock.Delete at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteCounter := mm_atomic.LoadUint64(&m.afterDeleteCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteMock.defaultExpectation != nil && afterDeleteCounter < 1 {
+		if m.DeleteMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StocksStorageMock.Delete at\n%s", m.DeleteMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StocksStorageMock.Delete at\n%s with params: %#v", m.DeleteMock.defaultExpectation.expectationOrigins.origin, *m.DeleteMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDelete != nil && afterDeleteCounter < 1 {
+		m.t.Errorf("Expected call to StocksStorageMock.Delete at\n%s", m.funcDeleteOrigin)
+	}
+
+	if !m.DeleteMock.invocationsDone() && afterDeleteCounter > 0 {
+		m.t.Errorf("Expected %d calls to StocksStorageMock.Delete at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteMock.expectedInvocations), m.DeleteMock.expectedInvocationsOrigin, afterDeleteCounter)
+	}
+}
+
+type mStocksStorageMockGetBySKUs struct {
+	optional           bool
+	mock               *StocksStorageMock
+	defaultExpectation *StocksStorageMockGetBySKUsExpectation
+	expectations       []*StocksStorageMockGetBySKUsExpectation
+
+	callArgs []*StocksStorageMockGetBySKUsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StocksStorageMockGetBySKUsExpectation specifies expectation struct of the StocksStorage.GetBySKUs
+type StocksStorageMockGetBySKUsExpectation struct {
+	mock               *StocksStorageMock
+	params             *StocksStorageMockGetBySKUsParams
+	paramPtrs          *StocksStorageMockGetBySKUsParamPtrs
+	expectationOrigins StocksStorageMockGetBySKUsExpectationOrigins
+	results            *StocksStorageMockGetBySKUsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StocksStorageMockGetBySKUsParams contains parameters of the StocksStorage.GetBySKUs
+type StocksStorageMockGetBySKUsParams struct {
+	ctx  context.Context
+	skus []uint32
+}
+
+// StocksStorageMockGetBySKUsParamPtrs contains pointers to parameters of the StocksStorage.GetBySKUs
+type StocksStorageMockGetBySKUsParamPtrs struct {
+	ctx  *context.Context
+	skus *[]uint32
+}
+
+// StocksStorageMockGetBySKUsResults contains results of the StocksStorage.GetBySKUs
+type StocksStorageMockGetBySKUsResults struct {
+	m1  map[uint32]domain.StocksItem
+	err error
+}
+
+// StocksStorageMockGetBySKUsOrigins contains origins of expectations of the StocksStorage.GetBySKUs
+type StocksStorageMockGetBySKUsExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originSkus string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetBySKUs *mStocksStorageMockGetBySKUs) Optional() *mStocksStorageMockGetBySKUs {
+	mmGetBySKUs.optional = true
+	return mmGetBySKUs
+}
+
+// Expect sets up expected params for StocksStorage.GetBySKUs
+func (mmGetBySKUs *mStocksStorageMockGetBySKUs) Expect(ctx context.Context, skus []uint32) *mStocksStorageMockGetBySKUs {
+	if mmGetBySKUs.mock.funcGetBySKUs != nil {
+		mmGetBySKUs.mock.t.Fatalf("StocksStorageMock.GetBySKUs mock is already set by Set")
+	}
+
+	if mmGetBySKUs.defaultExpectation == nil {
+		mmGetBySKUs.defaultExpectation = &StocksStorageMockGetBySKUsExpectation{}
+	}
+
+	if mmGetBySKUs.defaultExpectation.paramPtrs != nil {
+		mmGetBySKUs.mock.t.Fatalf("StocksStorageMock.GetBySKUs mock is already set by ExpectParams functions")
+	}
+
+	mmGetBySKUs.defaultExpectation.params = &StocksStorageMockGetBySKUsParams{ctx, skus}
+	mmGetBySKUs.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetBySKUs.expectations {
+		if minimock.Equal(e.params, mmGetBySKUs.defaultExpectation.params) {
+			mmGetBySKUs.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetBySKUs.defaultExpectation.params)
+		}
+	}
+
+	return mmGetBySKUs
+}
+
+// ExpectCtxParam1 sets up expected param ctx for StocksStorage.GetBySKUs
+func (mmGetBySKUs *mStocksStorageMockGetBySKUs) ExpectCtxParam1(ctx context.Context) *mStocksStorageMockGetBySKUs {
+	if mmGetBySKUs.mock.funcGetBySKUs != nil {
+		mmGetBySKUs.mock.t.Fatalf("StocksStorageMock.GetBySKUs mock is already set by Set")
+	}
+
+	if mmGetBySKUs.defaultExpectation == nil {
+		mmGetBySKUs.defaultExpectation = &StocksStorageMockGetBySKUsExpectation{}
+	}
+
+	if mmGetBySKUs.defaultExpectation.params != nil {
+		mmGetBySKUs.mock.t.Fatalf("StocksStorageMock.GetBySKUs mock is already set by Expect")
+	}
+
+	if mmGetBySKUs.defaultExpectation.paramPtrs == nil {
+		mmGetBySKUs.defaultExpectation.paramPtrs = &StocksStorageMockGetBySKUsParamPtrs{}
+	}
+	mmGetBySKUs.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetBySKUs.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetBySKUs
+}
+
+// ExpectSkusParam2 sets up expected param skus for StocksStorage.GetBySKUs
+func (mmGetBySKUs *mStocksStorageMockGetBySKUs) ExpectSkusParam2(skus []uint32) *mStocksStorageMockGetBySKUs {
+	if mmGetBySKUs.mock.funcGetBySKUs != nil {
+		mmGetBySKUs.mock.t.Fatalf("StocksStorageMock.GetBySKUs mock is already set by Set")
+	}
+
+	if mmGetBySKUs.defaultExpectation == nil {
+		mmGetBySKUs.defaultExpectation = &StocksStorageMockGetBySKUsExpectation{}
+	}
+
+	if mmGetBySKUs.defaultExpectation.params != nil {
+		mmGetBySKUs.mock.t.Fatalf("StocksStorageMock.GetBySKUs mock is already set by Expect")
+	}
+
+	if mmGetBySKUs.defaultExpectation.paramPtrs == nil {
+		mmGetBySKUs.defaultExpectation.paramPtrs = &StocksStorageMockGetBySKUsParamPtrs{}
+	}
+	mmGetBySKUs.defaultExpectation.paramPtrs.skus = &skus
+	mmGetBySKUs.defaultExpectation.expectationOrigins.originSkus = minimock.CallerInfo(1)
+
+	return mmGetBySKUs
+}
+
+// Inspect accepts an inspector function that has same arguments as the StocksStorage.GetBySKUs
+func (mmGetBySKUs *mStocksStorageMockGetBySKUs) Inspect(f func(ctx context.Context, skus []uint32)) *mStocksStorageMockGetBySKUs {
+	if mmGetBySKUs.mock.inspectFuncGetBySKUs != nil {
+		mmGetBySKUs.mock.t.Fatalf("Inspect function is already set for StocksStorageMock.GetBySKUs")
+	}
+
+	mmGetBySKUs.mock.inspectFuncGetBySKUs = f
+
+	return mmGetBySKUs
+}
+
+// Return sets up results that will be returned by StocksStorage.GetBySKUs
+func (mmGetBySKUs *mStocksStorageMockGetBySKUs) Return(m1 map[uint32]domain.StocksItem, err error) *StocksStorageMock {
+	if mmGetBySKUs.mock.funcGetBySKUs != nil {
+		mmGetBySKUs.mock.t.Fatalf("StocksStorageMock.GetBySKUs mock is already set by Set")
+	}
+
+	if mmGetBySKUs.defaultExpectation == nil {
+		mmGetBySKUs.defaultExpectation = &StocksStorageMockGetBySKUsExpectation{mock: mmGetBySKUs.mock}
+	}
+	mmGetBySKUs.defaultExpectation.results = &StocksStorageMockGetBySKUsResults{m1, err}
+	mmGetBySKUs.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetBySKUs.mock
+}
+
+// Set uses given function f to mock the StocksStorage.GetBySKUs method
+func (mmGetBySKUs *mStocksStorageMockGetBySKUs) Set(f func(ctx context.Context, skus []uint32) (m1 map[uint32]domain.StocksItem, err error)) *StocksStorageMock {
+	if mmGetBySKUs.defaultExpectation != nil {
+		mmGetBySKUs.mock.t.Fatalf("Default expectation is already set for the StocksStorage.GetBySKUs method")
+	}
+
+	if len(mmGetBySKUs.expectations) > 0 {
+		mmGetBySKUs.mock.t.Fatalf("Some expectations are already set for the StocksStorage.GetBySKUs method")
+	}
+
+	mmGetBySKUs.mock.funcGetBySKUs = f
+	mmGetBySKUs.mock.funcGetBySKUsOrigin = minimock.CallerInfo(1)
+	return mmGetBySKUs.mock
+}
+
+// When sets expectation for the StocksStorage.GetBySKUs which will trigger the result defined by the following
+// Then helper
+func (mmGetBySKUs *mStocksStorageMockGetBySKUs) When(ctx context.Context, skus []uint32) *StocksStorageMockGetBySKUsExpectation {
+	if mmGetBySKUs.mock.funcGetBySKUs != nil {
+		mmGetBySKUs.mock.t.Fatalf("StocksStorageMock.GetBySKUs mock is already set by Set")
+	}
+
+	expectation := &StocksStorageMockGetBySKUsExpectation{
+		mock:               mmGetBySKUs.mock,
+		params:             &StocksStorageMockGetBySKUsParams{ctx, skus},
+		expectationOrigins: StocksStorageMockGetBySKUsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetBySKUs.expectations = append(mmGetBySKUs.expectations, expectation)
+	return expectation
+}
+
+// Then sets up StocksStorage.GetBySKUs return parameters for the expectation previously defined by the When method
+func (e *StocksStorageMockGetBySKUsExpectation) Then(m1 map[uint32]domain.StocksItem, err error) *StocksStorageMock {
+	e.results = &StocksStorageMockGetBySKUsResults{m1, err}
+	return e.mock
+}
+
+// Times sets number of times StocksStorage.GetBySKUs should be invoked
+func (mmGetBySKUs *mStocksStorageMockGetBySKUs) Times(n uint64) *mStocksStorageMockGetBySKUs {
+	if n == 0 {
+		mmGetBySKUs.mock.t.Fatalf("Times of StocksStorageMock.GetBySKUs mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetBySKUs.expectedInvocations, n)
+	mmGetBySKUs.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetBySKUs
+}
+
+func (mmGetBySKUs *mStocksStorageMockGetBySKUs) invocationsDone() bool {
+	if len(mmGetBySKUs.expectations) == 0 && mmGetBySKUs.defaultExpectation == nil && mmGetBySKUs.mock.funcGetBySKUs == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetBySKUs.mock.afterGetBySKUsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetBySKUs.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetBySKUs implements mm_loms.StocksStorage
+func (mmGetBySKUs *StocksStorageMock) GetBySKUs(ctx context.Context, skus []uint32) (m1 map[uint32]domain.StocksItem, err error) {
+	mm_atomic.AddUint64(&mmGetBySKUs.beforeGetBySKUsCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetBySKUs.afterGetBySKUsCounter, 1)
+
+	mmGetBySKUs.t.Helper()
+
+	if mmGetBySKUs.inspectFuncGetBySKUs != nil {
+		mmGetBySKUs.inspectFuncGetBySKUs(ctx, skus)
+	}
+
+	mm_params := StocksStorageMockGetBySKUsParams{ctx, skus}
+
+	// Record call args
+	mmGetBySKUs.GetBySKUsMock.mutex.Lock()
+	mmGetBySKUs.GetBySKUsMock.callArgs = append(mmGetBySKUs.GetBySKUsMock.callArgs, &mm_params)
+	mmGetBySKUs.GetBySKUsMock.mutex.Unlock()
+
+	for _, e := range mmGetBySKUs.GetBySKUsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.m1, e.results.err
+		}
+	}
+
+	if mmGetBySKUs.GetBySKUsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetBySKUs.GetBySKUsMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetBySKUs.GetBySKUsMock.defaultExpectation.params
+		mm_want_ptrs := mmGetBySKUs.GetBySKUsMock.defaultExpectation.paramPtrs
+
+		mm_got := StocksStorageMockGetBySKUsParams{ctx, skus}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetBySKUs.t.Errorf("StocksStorageMock.GetBySKUs got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetBySKUs.GetBySKUsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.skus != nil && !minimock.Equal(*mm_want_ptrs.skus, mm_got.skus) {
+				mmGetBySKUs.t.Errorf("StocksStorageMock.GetBySKUs got unexpected parameter skus, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetBySKUs.GetBySKUsMock.defaultExpectation.expectationOrigins.originSkus, *mm_want_ptrs.skus, mm_got.skus, minimock.Diff(*mm_want_ptrs.skus, mm_got.skus))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetBySKUs.t.Errorf("StocksStorageMock.GetBySKUs got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetBySKUs.GetBySKUsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetBySKUs.GetBySKUsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetBySKUs.t.Fatal("No results are set for the StocksStorageMock.GetBySKUs")
+		}
+		return (*mm_results).m1, (*mm_results).err
+	}
+	if mmGetBySKUs.funcGetBySKUs != nil {
+		return mmGetBySKUs.funcGetBySKUs(ctx, skus)
+	}
+	mmGetBySKUs.t.Fatalf("Unexpected call to StocksStorageMock.GetBySKUs. %v %v", ctx, skus)
+	return
+}
+
+// GetBySKUsAfterCounter returns a count of finished StocksStorageMock.GetBySKUs invocations
+func (mmGetBySKUs *StocksStorageMock) GetBySKUsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetBySKUs.afterGetBySKUsCounter)
+}
+
+// GetBySKUsBeforeCounter returns a count of StocksStorageMock.GetBySKUs invocations
+func (mmGetBySKUs *StocksStorageMock) GetBySKUsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetBySKUs.beforeGetBySKUsCounter)
+}
+
+// Calls returns a list of arguments used in each call to StocksStorageMock.GetBySKUs.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetBySKUs *mStocksStorageMockGetBySKUs) Calls() []*StocksStorageMockGetBySKUsParams {
+	mmGetBySKUs.mutex.RLock()
+
+	argCopy := make([]*StocksStorageMockGetBySKUsParams, len(mmGetBySKUs.callArgs))
+	copy(argCopy, mmGetBySKUs.callArgs)
+
+	mmGetBySKUs.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetBySKUsDone returns true if the count of the GetBySKUs invocations corresponds
+// the number of defined expectations
+func (m *StocksStorageMock) MinimockGetBySKUsDone() bool {
+	if m.GetBySKUsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetBySKUsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetBySKUsMock.invocationsDone()
+}
+
+// MinimockGetBySKUsInspect logs each unmet expectation
+func (m *StocksStorageMock) MinimockGetBySKUsInspect() {
+	for _, e := range m.GetBySKUsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StocksStorageMock.GetBySKUs at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetBySKUsCounter := mm_atomic.LoadUint64(&m.afterGetBySKUsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetBySKUsMock.defaultExpectation != nil && afterGetBySKUsCounter < 1 {
+		if m.GetBySKUsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StocksStorageMock.GetBySKUs at\n%s", m.GetBySKUsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StocksStorageMock.GetBySKUs at\n%s with params: %#v", m.GetBySKUsMock.defaultExpectation.expectationOrigins.origin, *m.GetBySKUsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetBySKUs != nil && afterGetBySKUsCounter < 1 {
+		m.t.Errorf("Expected call to StocksStorageMock.GetBySKUs at\n%s", m.funcGetBySKUsOrigin)
+	}
+
+	if !m.GetBySKUsMock.invocationsDone() && afterGetBySKUsCounter > 0 {
+		m.t.Errorf("Expected %d calls to StocksStorageMock.GetBySKUs at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetBySKUsMock.expectedInvocations), m.GetBySKUsMock.expectedInvocationsOrigin, afterGetBySKUsCounter)
+	}
+}
+
+type mStocksStorageMockGetWarehouseStocks struct {
+	optional           bool
+	mock               *StocksStorageMock
+	defaultExpectation *StocksStorageMockGetWarehouseStocksExpectation
+	expectations       []*StocksStorageMockGetWarehouseStocksExpectation
+
+	callArgs []*StocksStorageMockGetWarehouseStocksParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StocksStorageMockGetWarehouseStocksExpectation specifies expectation struct of the StocksStorage.GetWarehouseStocks
+type StocksStorageMockGetWarehouseStocksExpectation struct {
+	mock               *StocksStorageMock
+	params             *StocksStorageMockGetWarehouseStocksParams
+	paramPtrs          *StocksStorageMockGetWarehouseStocksParamPtrs
+	expectationOrigins StocksStorageMockGetWarehouseStocksExpectationOrigins
+	results            *StocksStorageMockGetWarehouseStocksResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StocksStorageMockGetWarehouseStocksParams contains parameters of the StocksStorage.GetWarehouseStocks
+type StocksStorageMockGetWarehouseStocksParams struct {
+	ctx context.Context
+	sku uint32
+}
+
+// StocksStorageMockGetWarehouseStocksParamPtrs contains pointers to parameters of the StocksStorage.GetWarehouseStocks
+type StocksStorageMockGetWarehouseStocksParamPtrs struct {
+	ctx *context.Context
+	sku *uint32
+}
+
+// StocksStorageMockGetWarehouseStocksResults contains results of the StocksStorage.GetWarehouseStocks
+type StocksStorageMockGetWarehouseStocksResults struct {
+	wa1 []domain.WarehouseStock
+	err error
+}
+
+// StocksStorageMockGetWarehouseStocksOrigins contains origins of expectations of the StocksStorage.GetWarehouseStocks
+type StocksStorageMockGetWarehouseStocksExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originSku string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetWarehouseStocks *mStocksStorageMockGetWarehouseStocks) Optional() *mStocksStorageMockGetWarehouseStocks {
+	mmGetWarehouseStocks.optional = true
+	return mmGetWarehouseStocks
+}
+
+// Expect sets up expected params for StocksStorage.GetWarehouseStocks
+func (mmGetWarehouseStocks *mStocksStorageMockGetWarehouseStocks) Expect(ctx context.Context, sku uint32) *mStocksStorageMockGetWarehouseStocks {
+	if mmGetWarehouseStocks.mock.funcGetWarehouseStocks != nil {
+		mmGetWarehouseStocks.mock.t.Fatalf("StocksStorageMock.GetWarehouseStocks mock is already set by Set")
+	}
+
+	if mmGetWarehouseStocks.defaultExpectation == nil {
+		mmGetWarehouseStocks.defaultExpectation = &StocksStorageMockGetWarehouseStocksExpectation{}
+	}
+
+	if mmGetWarehouseStocks.defaultExpectation.paramPtrs != nil {
+		mmGetWarehouseStocks.mock.t.Fatalf("StocksStorageMock.GetWarehouseStocks mock is already set by ExpectParams functions")
+	}
+
+	mmGetWarehouseStocks.defaultExpectation.params = &StocksStorageMockGetWarehouseStocksParams{ctx, sku}
+	mmGetWarehouseStocks.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetWarehouseStocks.expectations {
+		if minimock.Equal(e.params, mmGetWarehouseStocks.defaultExpectation.params) {
+			mmGetWarehouseStocks.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetWarehouseStocks.defaultExpectation.params)
+		}
+	}
+
+	return mmGetWarehouseStocks
+}
+
+// ExpectCtxParam1 sets up expected param ctx for StocksStorage.GetWarehouseStocks
+func (mmGetWarehouseStocks *mStocksStorageMockGetWarehouseStocks) ExpectCtxParam1(ctx context.Context) *mStocksStorageMockGetWarehouseStocks {
+	if mmGetWarehouseStocks.mock.funcGetWarehouseStocks != nil {
+		mmGetWarehouseStocks.mock.t.Fatalf("StocksStorageMock.GetWarehouseStocks mock is already set by Set")
+	}
+
+	if mmGetWarehouseStocks.defaultExpectation == nil {
+		mmGetWarehouseStocks.defaultExpectation = &StocksStorageMockGetWarehouseStocksExpectation{}
+	}
+
+	if mmGetWarehouseStocks.defaultExpectation.params != nil {
+		mmGetWarehouseStocks.mock.t.Fatalf("StocksStorageMock.GetWarehouseStocks mock is already set by Expect")
+	}
+
+	if mmGetWarehouseStocks.defaultExpectation.paramPtrs == nil {
+		mmGetWarehouseStocks.defaultExpectation.paramPtrs = &StocksStorageMockGetWarehouseStocksParamPtrs{}
+	}
+	mmGetWarehouseStocks.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetWarehouseStocks.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetWarehouseStocks
+}
+
+// ExpectSkuParam2 sets up expected param sku for StocksStorage.GetWarehouseStocks
+func (mmGetWarehouseStocks *mStocksStorageMockGetWarehouseStocks) ExpectSkuParam2(sku uint32) *mStocksStorageMockGetWarehouseStocks {
+	if mmGetWarehouseStocks.mock.funcGetWarehouseStocks != nil {
+		mmGetWarehouseStocks.mock.t.Fatalf("StocksStorageMock.GetWarehouseStocks mock is already set by Set")
+	}
+
+	if mmGetWarehouseStocks.defaultExpectation == nil {
+		mmGetWarehouseStocks.defaultExpectation = &StocksStorageMockGetWarehouseStocksExpectation{}
+	}
+
+	if mmGetWarehouseStocks.defaultExpectation.params != nil {
+		mmGetWarehouseStocks.mock.t.Fatalf("StocksStorageMock.GetWarehouseStocks mock is already set by Expect")
+	}
+
+	if mmGetWarehouseStocks.defaultExpectation.paramPtrs == nil {
+		mmGetWarehouseStocks.defaultExpectation.paramPtrs = &StocksStorageMockGetWarehouseStocksParamPtrs{}
+	}
+	mmGetWarehouseStocks.defaultExpectation.paramPtrs.sku = &sku
+	mmGetWarehouseStocks.defaultExpectation.expectationOrigins.originSku = minimock.CallerInfo(1)
+
+	return mmGetWarehouseStocks
+}
+
+// Inspect accepts an inspector function that has same arguments as the StocksStorage.GetWarehouseStocks
+func (mmGetWarehouseStocks *mStocksStorageMockGetWarehouseStocks) Inspect(f func(ctx context.Context, sku uint32)) *mStocksStorageMockGetWarehouseStocks {
+	if mmGetWarehouseStocks.mock.inspectFuncGetWarehouseStocks != nil {
+		mmGetWarehouseStocks.mock.t.Fatalf("Inspect function is already set for StocksStorageMock.GetWarehouseStocks")
+	}
+
+	mmGetWarehouseStocks.mock.inspectFuncGetWarehouseStocks = f
+
+	return mmGetWarehouseStocks
+}
+
+// Return sets up results that will be returned by StocksStorage.GetWarehouseStocks
+func (mmGetWarehouseStocks *mStocksStorageMockGetWarehouseStocks) Return(wa1 []domain.WarehouseStock, err error) *StocksStorageMock {
+	if mmGetWarehouseStocks.mock.funcGetWarehouseStocks != nil {
+		mmGetWarehouseStocks.mock.t.Fatalf("StocksStorageMock.GetWarehouseStocks mock is already set by Set")
+	}
+
+	if mmGetWarehouseStocks.defaultExpectation == nil {
+		mmGetWarehouseStocks.defaultExpectation = &StocksStorageMockGetWarehouseStocksExpectation{mock: mmGetWarehouseStocks.mock}
+	}
+	mmGetWarehouseStocks.defaultExpectation.results = &StocksStorageMockGetWarehouseStocksResults{wa1, err}
+	mmGetWarehouseStocks.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetWarehouseStocks.mock
+}
+
+// Set uses given function f to mock the StocksStorage.GetWarehouseStocks method
+func (mmGetWarehouseStocks *mStocksStorageMockGetWarehouseStocks) Set(f func(ctx context.Context, sku uint32) (wa1 []domain.WarehouseStock, err error)) *StocksStorageMock {
+	if mmGetWarehouseStocks.defaultExpectation != nil {
+		mmGetWarehouseStocks.mock.t.Fatalf("Default expectation is already set for the StocksStorage.GetWarehouseStocks method")
+	}
+
+	if len(mmGetWarehouseStocks.expectations) > 0 {
+		mmGetWarehouseStocks.mock.t.Fatalf("Some expectations are already set for the StocksStorage.GetWarehouseStocks method")
+	}
+
+	mmGetWarehouseStocks.mock.funcGetWarehouseStocks = f
+	mmGetWarehouseStocks.mock.funcGetWarehouseStocksOrigin = minimock.CallerInfo(1)
+	return mmGetWarehouseStocks.mock
+}
+
+// When sets expectation for the StocksStorage.GetWarehouseStocks which will trigger the result defined by the following
+// Then helper
+func (mmGetWarehouseStocks *mStocksStorageMockGetWarehouseStocks) When(ctx context.Context, sku uint32) *StocksStorageMockGetWarehouseStocksExpectation {
+	if mmGetWarehouseStocks.mock.funcGetWarehouseStocks != nil {
+		mmGetWarehouseStocks.mock.t.Fatalf("StocksStorageMock.GetWarehouseStocks mock is already set by Set")
+	}
+
+	expectation := &StocksStorageMockGetWarehouseStocksExpectation{
+		mock:               mmGetWarehouseStocks.mock,
+		params:             &StocksStorageMockGetWarehouseStocksParams{ctx, sku},
+		expectationOrigins: StocksStorageMockGetWarehouseStocksExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetWarehouseStocks.expectations = append(mmGetWarehouseStocks.expectations, expectation)
+	return expectation
+}
+
+// Then sets up StocksStorage.GetWarehouseStocks return parameters for the expectation previously defined by the When method
+func (e *StocksStorageMockGetWarehouseStocksExpectation) Then(wa1 []domain.WarehouseStock, err error) *StocksStorageMock {
+	e.results = &StocksStorageMockGetWarehouseStocksResults{wa1, err}
+	return e.mock
+}
+
+// Times sets number of times StocksStorage.GetWarehouseStocks should be invoked
+func (mmGetWarehouseStocks *mStocksStorageMockGetWarehouseStocks) Times(n uint64) *mStocksStorageMockGetWarehouseStocks {
+	if n == 0 {
+		mmGetWarehouseStocks.mock.t.Fatalf("Times of StocksStorageMock.GetWarehouseStocks mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetWarehouseStocks.expectedInvocations, n)
+	mmGetWarehouseStocks.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetWarehouseStocks
+}
+
+func (mmGetWarehouseStocks *mStocksStorageMockGetWarehouseStocks) invocationsDone() bool {
+	if len(mmGetWarehouseStocks.expectations) == 0 && mmGetWarehouseStocks.defaultExpectation == nil && mmGetWarehouseStocks.mock.funcGetWarehouseStocks == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetWarehouseStocks.mock.afterGetWarehouseStocksCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetWarehouseStocks.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetWarehouseStocks implements mm_loms.StocksStorage
+func (mmGetWarehouseStocks *StocksStorageMock) GetWarehouseStocks(ctx context.Context, sku uint32) (wa1 []domain.WarehouseStock, err error) {
+	mm_atomic.AddUint64(&mmGetWarehouseStocks.beforeGetWarehouseStocksCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetWarehouseStocks.afterGetWarehouseStocksCounter, 1)
+
+	mmGetWarehouseStocks.t.Helper()
+
+	if mmGetWarehouseStocks.inspectFuncGetWarehouseStocks != nil {
+		mmGetWarehouseStocks.inspectFuncGetWarehouseStocks(ctx, sku)
+	}
+
+	mm_params := StocksStorageMockGetWarehouseStocksParams{ctx, sku}
+
+	// Record call args
+	mmGetWarehouseStocks.GetWarehouseStocksMock.mutex.Lock()
+	mmGetWarehouseStocks.GetWarehouseStocksMock.callArgs = append(mmGetWarehouseStocks.GetWarehouseStocksMock.callArgs, &mm_params)
+	mmGetWarehouseStocks.GetWarehouseStocksMock.mutex.Unlock()
+
+	for _, e := range mmGetWarehouseStocks.GetWarehouseStocksMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.wa1, e.results.err
+		}
+	}
+
+	if mmGetWarehouseStocks.GetWarehouseStocksMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetWarehouseStocks.GetWarehouseStocksMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetWarehouseStocks.GetWarehouseStocksMock.defaultExpectation.params
+		mm_want_ptrs := mmGetWarehouseStocks.GetWarehouseStocksMock.defaultExpectation.paramPtrs
+
+		mm_got := StocksStorageMockGetWarehouseStocksParams{ctx, sku}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetWarehouseStocks.t.Errorf("StocksStorageMock.GetWarehouseStocks got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetWarehouseStocks.GetWarehouseStocksMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.sku != nil && !minimock.Equal(*mm_want_ptrs.sku, mm_got.sku) {
+				mmGetWarehouseStocks.t.Errorf("StocksStorageMock.GetWarehouseStocks got unexpected parameter sku, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetWarehouseStocks.GetWarehouseStocksMock.defaultExpectation.expectationOrigins.originSku, *mm_want_ptrs.sku, mm_got.sku, minimock.Diff(*mm_want_ptrs.sku, mm_got.sku))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetWarehouseStocks.t.Errorf("StocksStorageMock.GetWarehouseStocks got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetWarehouseStocks.GetWarehouseStocksMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetWarehouseStocks.GetWarehouseStocksMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetWarehouseStocks.t.Fatal("No results are set for the StocksStorageMock.GetWarehouseStocks")
+		}
+		return (*mm_results).wa1, (*mm_results).err
+	}
+	if mmGetWarehouseStocks.funcGetWarehouseStocks != nil {
+		return mmGetWarehouseStocks.funcGetWarehouseStocks(ctx, sku)
+	}
+	mmGetWarehouseStocks.t.Fatalf("Unexpected call to StocksStorageMock.GetWarehouseStocks. %v %v", ctx, sku)
+	return
+}
+
+// GetWarehouseStocksAfterCounter returns a count of finished StocksStorageMock.GetWarehouseStocks invocations
+func (mmGetWarehouseStocks *StocksStorageMock) GetWarehouseStocksAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetWarehouseStocks.afterGetWarehouseStocksCounter)
+}
+
+// GetWarehouseStocksBeforeCounter returns a count of StocksStorageMock.GetWarehouseStocks invocations
+func (mmGetWarehouseStocks *StocksStorageMock) GetWarehouseStocksBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetWarehouseStocks.beforeGetWarehouseStocksCounter)
+}
+
+// Calls returns a list of arguments used in each call to StocksStorageMock.GetWarehouseStocks.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetWarehouseStocks *mStocksStorageMockGetWarehouseStocks) Calls() []*StocksStorageMockGetWarehouseStocksParams {
+	mmGetWarehouseStocks.mutex.RLock()
+
+	argCopy := make([]*StocksStorageMockGetWarehouseStocksParams, len(mmGetWarehouseStocks.callArgs))
+	copy(argCopy, mmGetWarehouseStocks.callArgs)
+
+	mmGetWarehouseStocks.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetWarehouseStocksDone returns true if the count of the GetWarehouseStocks invocations corresponds
+// the number of defined expectations
+func (m *StocksStorageMock) MinimockGetWarehouseStocksDone() bool {
+	if m.GetWarehouseStocksMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetWarehouseStocksMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetWarehouseStocksMock.invocationsDone()
+}
+
+// MinimockGetWarehouseStocksInspect logs each unmet expectation
+func (m *StocksStorageMock) MinimockGetWarehouseStocksInspect() {
+	for _, e := range m.GetWarehouseStocksMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StocksStorageMock.GetWarehouseStocks at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
 
-	t.Cleanup(m.MinimockFinish)
+	afterGetWarehouseStocksCounter := mm_atomic.LoadUint64(&m.afterGetWarehouseStocksCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetWarehouseStocksMock.defaultExpectation != nil && afterGetWarehouseStocksCounter < 1 {
+		if m.GetWarehouseStocksMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StocksStorageMock.GetWarehouseStocks at\n%s", m.GetWarehouseStocksMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StocksStorageMock.GetWarehouseStocks at\n%s with params: %#v", m.GetWarehouseStocksMock.defaultExpectation.expectationOrigins.origin, *m.GetWarehouseStocksMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetWarehouseStocks != nil && afterGetWarehouseStocksCounter < 1 {
+		m.t.Errorf("Expected call to StocksStorageMock.GetWarehouseStocks at\n%s", m.funcGetWarehouseStocksOrigin)
+	}
 
-	return m
+	if !m.GetWarehouseStocksMock.invocationsDone() && afterGetWarehouseStocksCounter > 0 {
+		m.t.Errorf("Expected %d calls to StocksStorageMock.GetWarehouseStocks at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetWarehouseStocksMock.expectedInvocations), m.GetWarehouseStocksMock.expectedInvocationsOrigin, afterGetWarehouseStocksCounter)
+	}
 }
 
-type mStocksStorageMockGetBySKU struct {
+type mStocksStorageMockReplenish struct {
 	optional           bool
 	mock               *StocksStorageMock
-	defaultExpectation *StocksStorageMockGetBySKUExpectation
-	expectations       []*StocksStorageMockGetBySKUExpectation
+	defaultExpectation *StocksStorageMockReplenishExpectation
+	expectations       []*StocksStorageMockReplenishExpectation
 
-	callArgs []*StocksStorageMockGetBySKUParams
+	callArgs []*StocksStorageMockReplenishParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// StocksStorageMockGetBySKUExpectation specifies expectation struct of the StocksStorage.GetBySKU
-type StocksStorageMockGetBySKUExpectation struct {
+// StocksStorageMockReplenishExpectation specifies expectation struct of the StocksStorage.Replenish
+type StocksStorageMockReplenishExpectation struct {
 	mock               *StocksStorageMock
-	params             *StocksStorageMockGetBySKUParams
-	paramPtrs          *StocksStorageMockGetBySKUParamPtrs
-	expectationOrigins StocksStorageMockGetBySKUExpectationOrigins
-	results            *StocksStorageMockGetBySKUResults
+	params             *StocksStorageMockReplenishParams
+	paramPtrs          *StocksStorageMockReplenishParamPtrs
+	expectationOrigins StocksStorageMockReplenishExpectationOrigins
+	results            *StocksStorageMockReplenishResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// StocksStorageMockGetBySKUParams contains parameters of the StocksStorage.GetBySKU
-type StocksStorageMockGetBySKUParams struct {
-	ctx context.Context
-	sku uint32
+// StocksStorageMockReplenishParams contains parameters of the StocksStorage.Replenish
+type StocksStorageMockReplenishParams struct {
+	ctx   context.Context
+	key   domain.StockKey
+	count uint32
 }
 
-// StocksStorageMockGetBySKUParamPtrs contains pointers to parameters of the StocksStorage.GetBySKU
-type StocksStorageMockGetBySKUParamPtrs struct {
-	ctx *context.Context
-	sku *uint32
+// StocksStorageMockReplenishParamPtrs contains pointers to parameters of the StocksStorage.Replenish
+type StocksStorageMockReplenishParamPtrs struct {
+	ctx   *context.Context
+	key   *domain.StockKey
+	count *uint32
 }
 
-// StocksStorageMockGetBySKUResults contains results of the StocksStorage.GetBySKU
-type StocksStorageMockGetBySKUResults struct {
-	u1  uint32
+// StocksStorageMockReplenishResults contains results of the StocksStorage.Replenish
+type StocksStorageMockReplenishResults struct {
+	s1  domain.StocksItem
 	err error
 }
 
-// StocksStorageMockGetBySKUOrigins contains origins of expectations of the StocksStorage.GetBySKU
-type StocksStorageMockGetBySKUExpectationOrigins struct {
-	origin    string
-	originCtx string
-	originSku string
+// StocksStorageMockReplenishOrigins contains origins of expectations of the StocksStorage.Replenish
+type StocksStorageMockReplenishExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originKey   string
+	originCount string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -136,292 +1986,320 @@ type StocksStorageMockGetBySKUExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmGetBySKU *mStocksStorageMockGetBySKU) Optional() *mStocksStorageMockGetBySKU {
-	mmGetBySKU.optional = true
-	return mmGetBySKU
+func (mmReplenish *mStocksStorageMockReplenish) Optional() *mStocksStorageMockReplenish {
+	mmReplenish.optional = true
+	return mmReplenish
 }
 
-// Expect sets up expected params for StocksStorage.GetBySKU
-func (mmGetBySKU *mStocksStorageMockGetBySKU) Expect(ctx context.Context, sku uint32) *mStocksStorageMockGetBySKU {
-	if mmGetBySKU.mock.funcGetBySKU != nil {
-		mmGetBySKU.mock.t.Fatalf("StocksStorageMock.GetBySKU mock is already set by Set")
+// Expect sets up expected params for StocksStorage.Replenish
+func (mmReplenish *mStocksStorageMockReplenish) Expect(ctx context.Context, key domain.StockKey, count uint32) *mStocksStorageMockReplenish {
+	if mmReplenish.mock.funcReplenish != nil {
+		mmReplenish.mock.t.Fatalf("StocksStorageMock.Replenish mock is already set by Set")
 	}
 
-	if mmGetBySKU.defaultExpectation == nil {
-		mmGetBySKU.defaultExpectation = &StocksStorageMockGetBySKUExpectation{}
+	if mmReplenish.defaultExpectation == nil {
+		mmReplenish.defaultExpectation = &StocksStorageMockReplenishExpectation{}
 	}
 
-	if mmGetBySKU.defaultExpectation.paramPtrs != nil {
-		mmGetBySKU.mock.t.Fatalf("StocksStorageMock.GetBySKU mock is already set by ExpectParams functions")
+	if mmReplenish.defaultExpectation.paramPtrs != nil {
+		mmReplenish.mock.t.Fatalf("StocksStorageMock.Replenish mock is already set by ExpectParams functions")
 	}
 
-	mmGetBySKU.defaultExpectation.params = &StocksStorageMockGetBySKUParams{ctx, sku}
-	mmGetBySKU.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmGetBySKU.expectations {
-		if minimock.Equal(e.params, mmGetBySKU.defaultExpectation.params) {
-			mmGetBySKU.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetBySKU.defaultExpectation.params)
+	mmReplenish.defaultExpectation.params = &StocksStorageMockReplenishParams{ctx, key, count}
+	mmReplenish.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmReplenish.expectations {
+		if minimock.Equal(e.params, mmReplenish.defaultExpectation.params) {
+			mmReplenish.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmReplenish.defaultExpectation.params)
 		}
 	}
 
-	return mmGetBySKU
+	return mmReplenish
+}
+
+// ExpectCtxParam1 sets up expected param ctx for StocksStorage.Replenish
+func (mmReplenish *mStocksStorageMockReplenish) ExpectCtxParam1(ctx context.Context) *mStocksStorageMockReplenish {
+	if mmReplenish.mock.funcReplenish != nil {
+		mmReplenish.mock.t.Fatalf("StocksStorageMock.Replenish mock is already set by Set")
+	}
+
+	if mmReplenish.defaultExpectation == nil {
+		mmReplenish.defaultExpectation = &StocksStorageMockReplenishExpectation{}
+	}
+
+	if mmReplenish.defaultExpectation.params != nil {
+		mmReplenish.mock.t.Fatalf("StocksStorageMock.Replenish mock is already set by Expect")
+	}
+
+	if mmReplenish.defaultExpectation.paramPtrs == nil {
+		mmReplenish.defaultExpectation.paramPtrs = &StocksStorageMockReplenishParamPtrs{}
+	}
+	mmReplenish.defaultExpectation.paramPtrs.ctx = &ctx
+	mmReplenish.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmReplenish
 }
 
-// ExpectCtxParam1 sets up expected param ctx for StocksStorage.GetBySKU
-func (mmGetBySKU *mStocksStorageMockGetBySKU) ExpectCtxParam1(ctx context.Context) *mStocksStorageMockGetBySKU {
-	if mmGetBySKU.mock.funcGetBySKU != nil {
-		mmGetBySKU.mock.t.Fatalf("StocksStorageMock.GetBySKU mock is already set by Set")
+// ExpectKeyParam2 sets up expected param key for StocksStorage.Replenish
+func (mmReplenish *mStocksStorageMockReplenish) ExpectKeyParam2(key domain.StockKey) *mStocksStorageMockReplenish {
+	if mmReplenish.mock.funcReplenish != nil {
+		mmReplenish.mock.t.Fatalf("StocksStorageMock.Replenish mock is already set by Set")
 	}
 
-	if mmGetBySKU.defaultExpectation == nil {
-		mmGetBySKU.defaultExpectation = &StocksStorageMockGetBySKUExpectation{}
+	if mmReplenish.defaultExpectation == nil {
+		mmReplenish.defaultExpectation = &StocksStorageMockReplenishExpectation{}
 	}
 
-	if mmGetBySKU.defaultExpectation.params != nil {
-		mmGetBySKU.mock.t.Fatalf("StocksStorageMock.GetBySKU mock is already set by Expect")
+	if mmReplenish.defaultExpectation.params != nil {
+		mmReplenish.mock.t.Fatalf("StocksStorageMock.Replenish mock is already set by Expect")
 	}
 
-	if mmGetBySKU.defaultExpectation.paramPtrs == nil {
-		mmGetBySKU.defaultExpectation.paramPtrs = &StocksStorageMockGetBySKUParamPtrs{}
+	if mmReplenish.defaultExpectation.paramPtrs == nil {
+		mmReplenish.defaultExpectation.paramPtrs = &StocksStorageMockReplenishParamPtrs{}
 	}
-	mmGetBySKU.defaultExpectation.paramPtrs.ctx = &ctx
-	mmGetBySKU.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmReplenish.defaultExpectation.paramPtrs.key = &key
+	mmReplenish.defaultExpectation.expectationOrigins.originKey = minimock.CallerInfo(1)
 
-	return mmGetBySKU
+	return mmReplenish
 }
 
-// ExpectSkuParam2 sets up expected param sku for StocksStorage.GetBySKU
-func (mmGetBySKU *mStocksStorageMockGetBySKU) ExpectSkuParam2(sku uint32) *mStocksStorageMockGetBySKU {
-	if mmGetBySKU.mock.funcGetBySKU != nil {
-		mmGetBySKU.mock.t.Fatalf("StocksStorageMock.GetBySKU mock is already set by Set")
+// ExpectCountParam3 sets up expected param count for StocksStorage.Replenish
+func (mmReplenish *mStocksStorageMockReplenish) ExpectCountParam3(count uint32) *mStocksStorageMockReplenish {
+	if mmReplenish.mock.funcReplenish != nil {
+		mmReplenish.mock.t.Fatalf("StocksStorageMock.Replenish mock is already set by Set")
 	}
 
-	if mmGetBySKU.defaultExpectation == nil {
-		mmGetBySKU.defaultExpectation = &StocksStorageMockGetBySKUExpectation{}
+	if mmReplenish.defaultExpectation == nil {
+		mmReplenish.defaultExpectation = &StocksStorageMockReplenishExpectation{}
 	}
 
-	if mmGetBySKU.defaultExpectation.params != nil {
-		mmGetBySKU.mock.t.Fatalf("StocksStorageMock.GetBySKU mock is already set by Expect")
+	if mmReplenish.defaultExpectation.params != nil {
+		mmReplenish.mock.t.Fatalf("StocksStorageMock.Replenish mock is already set by Expect")
 	}
 
-	if mmGetBySKU.defaultExpectation.paramPtrs == nil {
-		mmGetBySKU.defaultExpectation.paramPtrs = &StocksStorageMockGetBySKUParamPtrs{}
+	if mmReplenish.defaultExpectation.paramPtrs == nil {
+		mmReplenish.defaultExpectation.paramPtrs = &StocksStorageMockReplenishParamPtrs{}
 	}
-	mmGetBySKU.defaultExpectation.paramPtrs.sku = &sku
-	mmGetBySKU.defaultExpectation.expectationOrigins.originSku = minimock.CallerInfo(1)
+	mmReplenish.defaultExpectation.paramPtrs.count = &count
+	mmReplenish.defaultExpectation.expectationOrigins.originCount = minimock.CallerInfo(1)
 
-	return mmGetBySKU
+	return mmReplenish
 }
 
-// Inspect accepts an inspector function that has same arguments as the StocksStorage.GetBySKU
-func (mmGetBySKU *mStocksStorageMockGetBySKU) Inspect(f func(ctx context.Context, sku uint32)) *mStocksStorageMockGetBySKU {
-	if mmGetBySKU.mock.inspectFuncGetBySKU != nil {
-		mmGetBySKU.mock.t.Fatalf("Inspect function is already set for StocksStorageMock.GetBySKU")
+// Inspect accepts an inspector function that has same arguments as the StocksStorage.Replenish
+func (mmReplenish *mStocksStorageMockReplenish) Inspect(f func(ctx context.Context, key domain.StockKey, count uint32)) *mStocksStorageMockReplenish {
+	if mmReplenish.mock.inspectFuncReplenish != nil {
+		mmReplenish.mock.t.Fatalf("Inspect function is already set for StocksStorageMock.Replenish")
 	}
 
-	mmGetBySKU.mock.inspectFuncGetBySKU = f
+	mmReplenish.mock.inspectFuncReplenish = f
 
-	return mmGetBySKU
+	return mmReplenish
 }
 
-// Return sets up results that will be returned by StocksStorage.GetBySKU
-func (mmGetBySKU *mStocksStorageMockGetBySKU) Return(u1 uint32, err error) *StocksStorageMock {
-	if mmGetBySKU.mock.funcGetBySKU != nil {
-		mmGetBySKU.mock.t.Fatalf("StocksStorageMock.GetBySKU mock is already set by Set")
+// Return sets up results that will be returned by StocksStorage.Replenish
+func (mmReplenish *mStocksStorageMockReplenish) Return(s1 domain.StocksItem, err error) *StocksStorageMock {
+	if mmReplenish.mock.funcReplenish != nil {
+		mmReplenish.mock.t.Fatalf("StocksStorageMock.Replenish mock is already set by Set")
 	}
 
-	if mmGetBySKU.defaultExpectation == nil {
-		mmGetBySKU.defaultExpectation = &StocksStorageMockGetBySKUExpectation{mock: mmGetBySKU.mock}
+	if mmReplenish.defaultExpectation == nil {
+		mmReplenish.defaultExpectation = &StocksStorageMockReplenishExpectation{mock: mmReplenish.mock}
 	}
-	mmGetBySKU.defaultExpectation.results = &StocksStorageMockGetBySKUResults{u1, err}
-	mmGetBySKU.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmGetBySKU.mock
+	mmReplenish.defaultExpectation.results = &StocksStorageMockReplenishResults{s1, err}
+	mmReplenish.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmReplenish.mock
 }
 
-// Set uses given function f to mock the StocksStorage.GetBySKU method
-func (mmGetBySKU *mStocksStorageMockGetBySKU) Set(f func(ctx context.Context, sku uint32) (u1 uint32, err error)) *StocksStorageMock {
-	if mmGetBySKU.defaultExpectation != nil {
-		mmGetBySKU.mock.t.Fatalf("Default expectation is already set for the StocksStorage.GetBySKU method")
+// Set uses given function f to mock the StocksStorage.Replenish method
+func (mmReplenish *mStocksStorageMockReplenish) Set(f func(ctx context.Context, key domain.StockKey, count uint32) (s1 domain.StocksItem, err error)) *StocksStorageMock {
+	if mmReplenish.defaultExpectation != nil {
+		mmReplenish.mock.t.Fatalf("Default expectation is already set for the StocksStorage.Replenish method")
 	}
 
-	if len(mmGetBySKU.expectations) > 0 {
-		mmGetBySKU.mock.t.Fatalf("Some expectations are already set for the StocksStorage.GetBySKU method")
+	if len(mmReplenish.expectations) > 0 {
+		mmReplenish.mock.t.Fatalf("Some expectations are already set for the StocksStorage.Replenish method")
 	}
 
-	mmGetBySKU.mock.funcGetBySKU = f
-	mmGetBySKU.mock.funcGetBySKUOrigin = minimock.CallerInfo(1)
-	return mmGetBySKU.mock
+	mmReplenish.mock.funcReplenish = f
+	mmReplenish.mock.funcReplenishOrigin = minimock.CallerInfo(1)
+	return mmReplenish.mock
 }
 
-// When sets expectation for the StocksStorage.GetBySKU which will trigger the result defined by the following
+// When sets expectation for the StocksStorage.Replenish which will trigger the result defined by the following
 // Then helper
-func (mmGetBySKU *mStocksStorageMockGetBySKU) When(ctx context.Context, sku uint32) *StocksStorageMockGetBySKUExpectation {
-	if mmGetBySKU.mock.funcGetBySKU != nil {
-		mmGetBySKU.mock.t.Fatalf("StocksStorageMock.GetBySKU mock is already set by Set")
+func (mmReplenish *mStocksStorageMockReplenish) When(ctx context.Context, key domain.StockKey, count uint32) *StocksStorageMockReplenishExpectation {
+	if mmReplenish.mock.funcReplenish != nil {
+		mmReplenish.mock.t.Fatalf("StocksStorageMock.Replenish mock is already set by Set")
 	}
 
-	expectation := &StocksStorageMockGetBySKUExpectation{
-		mock:               mmGetBySKU.mock,
-		params:             &StocksStorageMockGetBySKUParams{ctx, sku},
-		expectationOrigins: StocksStorageMockGetBySKUExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &StocksStorageMockReplenishExpectation{
+		mock:               mmReplenish.mock,
+		params:             &StocksStorageMockReplenishParams{ctx, key, count},
+		expectationOrigins: StocksStorageMockReplenishExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmGetBySKU.expectations = append(mmGetBySKU.expectations, expectation)
+	mmReplenish.expectations = append(mmReplenish.expectations, expectation)
 	return expectation
 }
 
-// Then sets up StocksStorage.GetBySKU return parameters for the expectation previously defined by the When method
-func (e *StocksStorageMockGetBySKUExpectation) Then(u1 uint32, err error) *StocksStorageMock {
-	e.results = &StocksStorageMockGetBySKUResults{u1, err}
+// Then sets up StocksStorage.Replenish return parameters for the expectation previously defined by the When method
+func (e *StocksStorageMockReplenishExpectation) Then(s1 domain.StocksItem, err error) *StocksStorageMock {
+	e.results = &StocksStorageMockReplenishResults{s1, err}
 	return e.mock
 }
 
-// Times sets number of times StocksStorage.GetBySKU should be invoked
-func (mmGetBySKU *mStocksStorageMockGetBySKU) Times(n uint64) *mStocksStorageMockGetBySKU {
+// Times sets number of times StocksStorage.Replenish should be invoked
+func (mmReplenish *mStocksStorageMockReplenish) Times(n uint64) *mStocksStorageMockReplenish {
 	if n == 0 {
-		mmGetBySKU.mock.t.Fatalf("Times of StocksStorageMock.GetBySKU mock can not be zero")
+		mmReplenish.mock.t.Fatalf("Times of StocksStorageMock.Replenish mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmGetBySKU.expectedInvocations, n)
-	mmGetBySKU.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmGetBySKU
+	mm_atomic.StoreUint64(&mmReplenish.expectedInvocations, n)
+	mmReplenish.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmReplenish
 }
 
-func (mmGetBySKU *mStocksStorageMockGetBySKU) invocationsDone() bool {
-	if len(mmGetBySKU.expectations) == 0 && mmGetBySKU.defaultExpectation == nil && mmGetBySKU.mock.funcGetBySKU == nil {
+func (mmReplenish *mStocksStorageMockReplenish) invocationsDone() bool {
+	if len(mmReplenish.expectations) == 0 && mmReplenish.defaultExpectation == nil && mmReplenish.mock.funcReplenish == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmGetBySKU.mock.afterGetBySKUCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmGetBySKU.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmReplenish.mock.afterReplenishCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmReplenish.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// GetBySKU implements mm_loms.StocksStorage
-func (mmGetBySKU *StocksStorageMock) GetBySKU(ctx context.Context, sku uint32) (u1 uint32, err error) {
-	mm_atomic.AddUint64(&mmGetBySKU.beforeGetBySKUCounter, 1)
-	defer mm_atomic.AddUint64(&mmGetBySKU.afterGetBySKUCounter, 1)
+// Replenish implements mm_loms.StocksStorage
+func (mmReplenish *StocksStorageMock) Replenish(ctx context.Context, key domain.StockKey, count uint32) (s1 domain.StocksItem, err error) {
+	mm_atomic.AddUint64(&mmReplenish.beforeReplenishCounter, 1)
+	defer mm_atomic.AddUint64(&mmReplenish.afterReplenishCounter, 1)
 
-	mmGetBySKU.t.Helper()
+	mmReplenish.t.Helper()
 
-	if mmGetBySKU.inspectFuncGetBySKU != nil {
-		mmGetBySKU.inspectFuncGetBySKU(ctx, sku)
+	if mmReplenish.inspectFuncReplenish != nil {
+		mmReplenish.inspectFuncReplenish(ctx, key, count)
 	}
 
-	mm_params := StocksStorageMockGetBySKUParams{ctx, sku}
+	mm_params := StocksStorageMockReplenishParams{ctx, key, count}
 
 	// Record call args
-	mmGetBySKU.GetBySKUMock.mutex.Lock()
-	mmGetBySKU.GetBySKUMock.callArgs = append(mmGetBySKU.GetBySKUMock.callArgs, &mm_params)
-	mmGetBySKU.GetBySKUMock.mutex.Unlock()
+	mmReplenish.ReplenishMock.mutex.Lock()
+	mmReplenish.ReplenishMock.callArgs = append(mmReplenish.ReplenishMock.callArgs, &mm_params)
+	mmReplenish.ReplenishMock.mutex.Unlock()
 
-	for _, e := range mmGetBySKU.GetBySKUMock.expectations {
+	for _, e := range mmReplenish.ReplenishMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.u1, e.results.err
+			return e.results.s1, e.results.err
 		}
 	}
 
-	if mmGetBySKU.GetBySKUMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmGetBySKU.GetBySKUMock.defaultExpectation.Counter, 1)
-		mm_want := mmGetBySKU.GetBySKUMock.defaultExpectation.params
-		mm_want_ptrs := mmGetBySKU.GetBySKUMock.defaultExpectation.paramPtrs
+	if mmReplenish.ReplenishMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmReplenish.ReplenishMock.defaultExpectation.Counter, 1)
+		mm_want := mmReplenish.ReplenishMock.defaultExpectation.params
+		mm_want_ptrs := mmReplenish.ReplenishMock.defaultExpectation.paramPtrs
 
-		mm_got := StocksStorageMockGetBySKUParams{ctx, sku}
+		mm_got := StocksStorageMockReplenishParams{ctx, key, count}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmGetBySKU.t.Errorf("StocksStorageMock.GetBySKU got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetBySKU.GetBySKUMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmReplenish.t.Errorf("StocksStorageMock.Replenish got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReplenish.ReplenishMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.sku != nil && !minimock.Equal(*mm_want_ptrs.sku, mm_got.sku) {
-				mmGetBySKU.t.Errorf("StocksStorageMock.GetBySKU got unexpected parameter sku, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetBySKU.GetBySKUMock.defaultExpectation.expectationOrigins.originSku, *mm_want_ptrs.sku, mm_got.sku, minimock.Diff(*mm_want_ptrs.sku, mm_got.sku))
+			if mm_want_ptrs.key != nil && !minimock.Equal(*mm_want_ptrs.key, mm_got.key) {
+				mmReplenish.t.Errorf("StocksStorageMock.Replenish got unexpected parameter key, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReplenish.ReplenishMock.defaultExpectation.expectationOrigins.originKey, *mm_want_ptrs.key, mm_got.key, minimock.Diff(*mm_want_ptrs.key, mm_got.key))
+			}
+
+			if mm_want_ptrs.count != nil && !minimock.Equal(*mm_want_ptrs.count, mm_got.count) {
+				mmReplenish.t.Errorf("StocksStorageMock.Replenish got unexpected parameter count, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReplenish.ReplenishMock.defaultExpectation.expectationOrigins.originCount, *mm_want_ptrs.count, mm_got.count, minimock.Diff(*mm_want_ptrs.count, mm_got.count))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmGetBySKU.t.Errorf("StocksStorageMock.GetBySKU got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmGetBySKU.GetBySKUMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmReplenish.t.Errorf("StocksStorageMock.Replenish got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmReplenish.ReplenishMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmGetBySKU.GetBySKUMock.defaultExpectation.results
+		mm_results := mmReplenish.ReplenishMock.defaultExpectation.results
 		if mm_results == nil {
-			mmGetBySKU.t.Fatal("No results are set for the StocksStorageMock.GetBySKU")
+			mmReplenish.t.Fatal("No results are set for the StocksStorageMock.Replenish")
 		}
-		return (*mm_results).u1, (*mm_results).err
+		return (*mm_results).s1, (*mm_results).err
 	}
-	if mmGetBySKU.funcGetBySKU != nil {
-		return mmGetBySKU.funcGetBySKU(ctx, sku)
+	if mmReplenish.funcReplenish != nil {
+		return mmReplenish.funcReplenish(ctx, key, count)
 	}
-	mmGetBySKU.t.Fatalf("Unexpected call to StocksStorageMock.GetBySKU. %v %v", ctx, sku)
+	mmReplenish.t.Fatalf("Unexpected call to StocksStorageMock.Replenish. %v %v %v", ctx, key, count)
 	return
 }
 
-// GetBySKUAfterCounter returns a count of finished StocksStorageMock.GetBySKU invocations
-func (mmGetBySKU *StocksStorageMock) GetBySKUAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetBySKU.afterGetBySKUCounter)
+// ReplenishAfterCounter returns a count of finished StocksStorageMock.Replenish invocations
+func (mmReplenish *StocksStorageMock) ReplenishAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReplenish.afterReplenishCounter)
 }
 
-// GetBySKUBeforeCounter returns a count of StocksStorageMock.GetBySKU invocations
-func (mmGetBySKU *StocksStorageMock) GetBySKUBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetBySKU.beforeGetBySKUCounter)
+// ReplenishBeforeCounter returns a count of StocksStorageMock.Replenish invocations
+func (mmReplenish *StocksStorageMock) ReplenishBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReplenish.beforeReplenishCounter)
 }
 
-// Calls returns a list of arguments used in each call to StocksStorageMock.GetBySKU.
+// Calls returns a list of arguments used in each call to StocksStorageMock.Replenish.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmGetBySKU *mStocksStorageMockGetBySKU) Calls() []*StocksStorageMockGetBySKUParams {
-	mmGetBySKU.mutex.RLock()
+func (mmReplenish *mStocksStorageMockReplenish) Calls() []*StocksStorageMockReplenishParams {
+	mmReplenish.mutex.RLock()
 
-	argCopy := make([]*StocksStorageMockGetBySKUParams, len(mmGetBySKU.callArgs))
-	copy(argCopy, mmGetBySKU.callArgs)
+	argCopy := make([]*StocksStorageMockReplenishParams, len(mmReplenish.callArgs))
+	copy(argCopy, mmReplenish.callArgs)
 
-	mmGetBySKU.mutex.RUnlock()
+	mmReplenish.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockGetBySKUDone returns true if the count of the GetBySKU invocations corresponds
+// MinimockReplenishDone returns true if the count of the Replenish invocations corresponds
 // the number of defined expectations
-func (m *StocksStorageMock) MinimockGetBySKUDone() bool {
-	if m.GetBySKUMock.optional {
+func (m *StocksStorageMock) MinimockReplenishDone() bool {
+	if m.ReplenishMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.GetBySKUMock.expectations {
+	for _, e := range m.ReplenishMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.GetBySKUMock.invocationsDone()
+	return m.ReplenishMock.invocationsDone()
 }
 
-// MinimockGetBySKUInspect logs each unmet expectation
-func (m *StocksStorageMock) MinimockGetBySKUInspect() {
-	for _, e := range m.GetBySKUMock.expectations {
+// MinimockReplenishInspect logs each unmet expectation
+func (m *StocksStorageMock) MinimockReplenishInspect() {
+	for _, e := range m.ReplenishMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to StocksStorageMock.GetBySKU at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to StocksStorageMock.Replenish at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterGetBySKUCounter := mm_atomic.LoadUint64(&m.afterGetBySKUCounter)
+	afterReplenishCounter := mm_atomic.LoadUint64(&m.afterReplenishCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.GetBySKUMock.defaultExpectation != nil && afterGetBySKUCounter < 1 {
-		if m.GetBySKUMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to StocksStorageMock.GetBySKU at\n%s", m.GetBySKUMock.defaultExpectation.returnOrigin)
+	if m.ReplenishMock.defaultExpectation != nil && afterReplenishCounter < 1 {
+		if m.ReplenishMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StocksStorageMock.Replenish at\n%s", m.ReplenishMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to StocksStorageMock.GetBySKU at\n%s with params: %#v", m.GetBySKUMock.defaultExpectation.expectationOrigins.origin, *m.GetBySKUMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to StocksStorageMock.Replenish at\n%s with params: %#v", m.ReplenishMock.defaultExpectation.expectationOrigins.origin, *m.ReplenishMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcGetBySKU != nil && afterGetBySKUCounter < 1 {
-		m.t.Errorf("Expected call to StocksStorageMock.GetBySKU at\n%s", m.funcGetBySKUOrigin)
+	if m.funcReplenish != nil && afterReplenishCounter < 1 {
+		m.t.Errorf("Expected call to StocksStorageMock.Replenish at\n%s", m.funcReplenishOrigin)
 	}
 
-	if !m.GetBySKUMock.invocationsDone() && afterGetBySKUCounter > 0 {
-		m.t.Errorf("Expected %d calls to StocksStorageMock.GetBySKU at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.GetBySKUMock.expectedInvocations), m.GetBySKUMock.expectedInvocationsOrigin, afterGetBySKUCounter)
+	if !m.ReplenishMock.invocationsDone() && afterReplenishCounter > 0 {
+		m.t.Errorf("Expected %d calls to StocksStorageMock.Replenish at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ReplenishMock.expectedInvocations), m.ReplenishMock.expectedInvocationsOrigin, afterReplenishCounter)
 	}
 }
 
@@ -452,14 +2330,14 @@ type StocksStorageMockReserveExpectation struct {
 // StocksStorageMockReserveParams contains parameters of the StocksStorage.Reserve
 type StocksStorageMockReserveParams struct {
 	ctx   context.Context
-	sku   uint32
+	key   domain.StockKey
 	count uint32
 }
 
 // StocksStorageMockReserveParamPtrs contains pointers to parameters of the StocksStorage.Reserve
 type StocksStorageMockReserveParamPtrs struct {
 	ctx   *context.Context
-	sku   *uint32
+	key   *domain.StockKey
 	count *uint32
 }
 
@@ -472,7 +2350,7 @@ type StocksStorageMockReserveResults struct {
 type StocksStorageMockReserveExpectationOrigins struct {
 	origin      string
 	originCtx   string
-	originSku   string
+	originKey   string
 	originCount string
 }
 
@@ -487,7 +2365,7 @@ func (mmReserve *mStocksStorageMockReserve) Optional() *mStocksStorageMockReserv
 }
 
 // Expect sets up expected params for StocksStorage.Reserve
-func (mmReserve *mStocksStorageMockReserve) Expect(ctx context.Context, sku uint32, count uint32) *mStocksStorageMockReserve {
+func (mmReserve *mStocksStorageMockReserve) Expect(ctx context.Context, key domain.StockKey, count uint32) *mStocksStorageMockReserve {
 	if mmReserve.mock.funcReserve != nil {
 		mmReserve.mock.t.Fatalf("StocksStorageMock.Reserve mock is already set by Set")
 	}
@@ -500,7 +2378,7 @@ func (mmReserve *mStocksStorageMockReserve) Expect(ctx context.Context, sku uint
 		mmReserve.mock.t.Fatalf("StocksStorageMock.Reserve mock is already set by ExpectParams functions")
 	}
 
-	mmReserve.defaultExpectation.params = &StocksStorageMockReserveParams{ctx, sku, count}
+	mmReserve.defaultExpectation.params = &StocksStorageMockReserveParams{ctx, key, count}
 	mmReserve.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
 	for _, e := range mmReserve.expectations {
 		if minimock.Equal(e.params, mmReserve.defaultExpectation.params) {
@@ -534,8 +2412,8 @@ func (mmReserve *mStocksStorageMockReserve) ExpectCtxParam1(ctx context.Context)
 	return mmReserve
 }
 
-// ExpectSkuParam2 sets up expected param sku for StocksStorage.Reserve
-func (mmReserve *mStocksStorageMockReserve) ExpectSkuParam2(sku uint32) *mStocksStorageMockReserve {
+// ExpectKeyParam2 sets up expected param key for StocksStorage.Reserve
+func (mmReserve *mStocksStorageMockReserve) ExpectKeyParam2(key domain.StockKey) *mStocksStorageMockReserve {
 	if mmReserve.mock.funcReserve != nil {
 		mmReserve.mock.t.Fatalf("StocksStorageMock.Reserve mock is already set by Set")
 	}
@@ -551,8 +2429,8 @@ func (mmReserve *mStocksStorageMockReserve) ExpectSkuParam2(sku uint32) *mStocks
 	if mmReserve.defaultExpectation.paramPtrs == nil {
 		mmReserve.defaultExpectation.paramPtrs = &StocksStorageMockReserveParamPtrs{}
 	}
-	mmReserve.defaultExpectation.paramPtrs.sku = &sku
-	mmReserve.defaultExpectation.expectationOrigins.originSku = minimock.CallerInfo(1)
+	mmReserve.defaultExpectation.paramPtrs.key = &key
+	mmReserve.defaultExpectation.expectationOrigins.originKey = minimock.CallerInfo(1)
 
 	return mmReserve
 }
@@ -581,7 +2459,7 @@ func (mmReserve *mStocksStorageMockReserve) ExpectCountParam3(count uint32) *mSt
 }
 
 // Inspect accepts an inspector function that has same arguments as the StocksStorage.Reserve
-func (mmReserve *mStocksStorageMockReserve) Inspect(f func(ctx context.Context, sku uint32, count uint32)) *mStocksStorageMockReserve {
+func (mmReserve *mStocksStorageMockReserve) Inspect(f func(ctx context.Context, key domain.StockKey, count uint32)) *mStocksStorageMockReserve {
 	if mmReserve.mock.inspectFuncReserve != nil {
 		mmReserve.mock.t.Fatalf("Inspect function is already set for StocksStorageMock.Reserve")
 	}
@@ -606,7 +2484,7 @@ func (mmReserve *mStocksStorageMockReserve) Return(err error) *StocksStorageMock
 }
 
 // Set uses given function f to mock the StocksStorage.Reserve method
-func (mmReserve *mStocksStorageMockReserve) Set(f func(ctx context.Context, sku uint32, count uint32) (err error)) *StocksStorageMock {
+func (mmReserve *mStocksStorageMockReserve) Set(f func(ctx context.Context, key domain.StockKey, count uint32) (err error)) *StocksStorageMock {
 	if mmReserve.defaultExpectation != nil {
 		mmReserve.mock.t.Fatalf("Default expectation is already set for the StocksStorage.Reserve method")
 	}
@@ -622,14 +2500,14 @@ func (mmReserve *mStocksStorageMockReserve) Set(f func(ctx context.Context, sku
 
 // When sets expectation for the StocksStorage.Reserve which will trigger the result defined by the following
 // Then helper
-func (mmReserve *mStocksStorageMockReserve) When(ctx context.Context, sku uint32, count uint32) *StocksStorageMockReserveExpectation {
+func (mmReserve *mStocksStorageMockReserve) When(ctx context.Context, key domain.StockKey, count uint32) *StocksStorageMockReserveExpectation {
 	if mmReserve.mock.funcReserve != nil {
 		mmReserve.mock.t.Fatalf("StocksStorageMock.Reserve mock is already set by Set")
 	}
 
 	expectation := &StocksStorageMockReserveExpectation{
 		mock:               mmReserve.mock,
-		params:             &StocksStorageMockReserveParams{ctx, sku, count},
+		params:             &StocksStorageMockReserveParams{ctx, key, count},
 		expectationOrigins: StocksStorageMockReserveExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
 	mmReserve.expectations = append(mmReserve.expectations, expectation)
@@ -664,17 +2542,17 @@ func (mmReserve *mStocksStorageMockReserve) invocationsDone() bool {
 }
 
 // Reserve implements mm_loms.StocksStorage
-func (mmReserve *StocksStorageMock) Reserve(ctx context.Context, sku uint32, count uint32) (err error) {
+func (mmReserve *StocksStorageMock) Reserve(ctx context.Context, key domain.StockKey, count uint32) (err error) {
 	mm_atomic.AddUint64(&mmReserve.beforeReserveCounter, 1)
 	defer mm_atomic.AddUint64(&mmReserve.afterReserveCounter, 1)
 
 	mmReserve.t.Helper()
 
 	if mmReserve.inspectFuncReserve != nil {
-		mmReserve.inspectFuncReserve(ctx, sku, count)
+		mmReserve.inspectFuncReserve(ctx, key, count)
 	}
 
-	mm_params := StocksStorageMockReserveParams{ctx, sku, count}
+	mm_params := StocksStorageMockReserveParams{ctx, key, count}
 
 	// Record call args
 	mmReserve.ReserveMock.mutex.Lock()
@@ -693,7 +2571,7 @@ func (mmReserve *StocksStorageMock) Reserve(ctx context.Context, sku uint32, cou
 		mm_want := mmReserve.ReserveMock.defaultExpectation.params
 		mm_want_ptrs := mmReserve.ReserveMock.defaultExpectation.paramPtrs
 
-		mm_got := StocksStorageMockReserveParams{ctx, sku, count}
+		mm_got := StocksStorageMockReserveParams{ctx, key, count}
 
 		if mm_want_ptrs != nil {
 
@@ -702,9 +2580,9 @@ func (mmReserve *StocksStorageMock) Reserve(ctx context.Context, sku uint32, cou
 					mmReserve.ReserveMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.sku != nil && !minimock.Equal(*mm_want_ptrs.sku, mm_got.sku) {
-				mmReserve.t.Errorf("StocksStorageMock.Reserve got unexpected parameter sku, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmReserve.ReserveMock.defaultExpectation.expectationOrigins.originSku, *mm_want_ptrs.sku, mm_got.sku, minimock.Diff(*mm_want_ptrs.sku, mm_got.sku))
+			if mm_want_ptrs.key != nil && !minimock.Equal(*mm_want_ptrs.key, mm_got.key) {
+				mmReserve.t.Errorf("StocksStorageMock.Reserve got unexpected parameter key, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReserve.ReserveMock.defaultExpectation.expectationOrigins.originKey, *mm_want_ptrs.key, mm_got.key, minimock.Diff(*mm_want_ptrs.key, mm_got.key))
 			}
 
 			if mm_want_ptrs.count != nil && !minimock.Equal(*mm_want_ptrs.count, mm_got.count) {
@@ -724,9 +2602,9 @@ func (mmReserve *StocksStorageMock) Reserve(ctx context.Context, sku uint32, cou
 		return (*mm_results).err
 	}
 	if mmReserve.funcReserve != nil {
-		return mmReserve.funcReserve(ctx, sku, count)
+		return mmReserve.funcReserve(ctx, key, count)
 	}
-	mmReserve.t.Fatalf("Unexpected call to StocksStorageMock.Reserve. %v %v %v", ctx, sku, count)
+	mmReserve.t.Fatalf("Unexpected call to StocksStorageMock.Reserve. %v %v %v", ctx, key, count)
 	return
 }
 
@@ -824,14 +2702,14 @@ type StocksStorageMockReserveCancelExpectation struct {
 
 // StocksStorageMockReserveCancelParams contains parameters of the StocksStorage.ReserveCancel
 type StocksStorageMockReserveCancelParams struct {
-	ctx  context.Context
-	skus map[uint32]uint32
+	ctx   context.Context
+	items map[domain.StockKey]uint32
 }
 
 // StocksStorageMockReserveCancelParamPtrs contains pointers to parameters of the StocksStorage.ReserveCancel
 type StocksStorageMockReserveCancelParamPtrs struct {
-	ctx  *context.Context
-	skus *map[uint32]uint32
+	ctx   *context.Context
+	items *map[domain.StockKey]uint32
 }
 
 // StocksStorageMockReserveCancelResults contains results of the StocksStorage.ReserveCancel
@@ -841,9 +2719,9 @@ type StocksStorageMockReserveCancelResults struct {
 
 // StocksStorageMockReserveCancelOrigins contains origins of expectations of the StocksStorage.ReserveCancel
 type StocksStorageMockReserveCancelExpectationOrigins struct {
-	origin     string
-	originCtx  string
-	originSkus string
+	origin      string
+	originCtx   string
+	originItems string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -857,7 +2735,7 @@ func (mmReserveCancel *mStocksStorageMockReserveCancel) Optional() *mStocksStora
 }
 
 // Expect sets up expected params for StocksStorage.ReserveCancel
-func (mmReserveCancel *mStocksStorageMockReserveCancel) Expect(ctx context.Context, skus map[uint32]uint32) *mStocksStorageMockReserveCancel {
+func (mmReserveCancel *mStocksStorageMockReserveCancel) Expect(ctx context.Context, items map[domain.StockKey]uint32) *mStocksStorageMockReserveCancel {
 	if mmReserveCancel.mock.funcReserveCancel != nil {
 		mmReserveCancel.mock.t.Fatalf("StocksStorageMock.ReserveCancel mock is already set by Set")
 	}
@@ -870,7 +2748,7 @@ func (mmReserveCancel *mStocksStorageMockReserveCancel) Expect(ctx context.Conte
 		mmReserveCancel.mock.t.Fatalf("StocksStorageMock.ReserveCancel mock is already set by ExpectParams functions")
 	}
 
-	mmReserveCancel.defaultExpectation.params = &StocksStorageMockReserveCancelParams{ctx, skus}
+	mmReserveCancel.defaultExpectation.params = &StocksStorageMockReserveCancelParams{ctx, items}
 	mmReserveCancel.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
 	for _, e := range mmReserveCancel.expectations {
 		if minimock.Equal(e.params, mmReserveCancel.defaultExpectation.params) {
@@ -904,8 +2782,8 @@ func (mmReserveCancel *mStocksStorageMockReserveCancel) ExpectCtxParam1(ctx cont
 	return mmReserveCancel
 }
 
-// ExpectSkusParam2 sets up expected param skus for StocksStorage.ReserveCancel
-func (mmReserveCancel *mStocksStorageMockReserveCancel) ExpectSkusParam2(skus map[uint32]uint32) *mStocksStorageMockReserveCancel {
+// ExpectItemsParam2 sets up expected param items for StocksStorage.ReserveCancel
+func (mmReserveCancel *mStocksStorageMockReserveCancel) ExpectItemsParam2(items map[domain.StockKey]uint32) *mStocksStorageMockReserveCancel {
 	if mmReserveCancel.mock.funcReserveCancel != nil {
 		mmReserveCancel.mock.t.Fatalf("StocksStorageMock.ReserveCancel mock is already set by Set")
 	}
@@ -921,14 +2799,14 @@ func (mmReserveCancel *mStocksStorageMockReserveCancel) ExpectSkusParam2(skus ma
 	if mmReserveCancel.defaultExpectation.paramPtrs == nil {
 		mmReserveCancel.defaultExpectation.paramPtrs = &StocksStorageMockReserveCancelParamPtrs{}
 	}
-	mmReserveCancel.defaultExpectation.paramPtrs.skus = &skus
-	mmReserveCancel.defaultExpectation.expectationOrigins.originSkus = minimock.CallerInfo(1)
+	mmReserveCancel.defaultExpectation.paramPtrs.items = &items
+	mmReserveCancel.defaultExpectation.expectationOrigins.originItems = minimock.CallerInfo(1)
 
 	return mmReserveCancel
 }
 
 // Inspect accepts an inspector function that has same arguments as the StocksStorage.ReserveCancel
-func (mmReserveCancel *mStocksStorageMockReserveCancel) Inspect(f func(ctx context.Context, skus map[uint32]uint32)) *mStocksStorageMockReserveCancel {
+func (mmReserveCancel *mStocksStorageMockReserveCancel) Inspect(f func(ctx context.Context, items map[domain.StockKey]uint32)) *mStocksStorageMockReserveCancel {
 	if mmReserveCancel.mock.inspectFuncReserveCancel != nil {
 		mmReserveCancel.mock.t.Fatalf("Inspect function is already set for StocksStorageMock.ReserveCancel")
 	}
@@ -953,7 +2831,7 @@ func (mmReserveCancel *mStocksStorageMockReserveCancel) Return(err error) *Stock
 }
 
 // Set uses given function f to mock the StocksStorage.ReserveCancel method
-func (mmReserveCancel *mStocksStorageMockReserveCancel) Set(f func(ctx context.Context, skus map[uint32]uint32) (err error)) *StocksStorageMock {
+func (mmReserveCancel *mStocksStorageMockReserveCancel) Set(f func(ctx context.Context, items map[domain.StockKey]uint32) (err error)) *StocksStorageMock {
 	if mmReserveCancel.defaultExpectation != nil {
 		mmReserveCancel.mock.t.Fatalf("Default expectation is already set for the StocksStorage.ReserveCancel method")
 	}
@@ -969,14 +2847,14 @@ func (mmReserveCancel *mStocksStorageMockReserveCancel) Set(f func(ctx context.C
 
 // When sets expectation for the StocksStorage.ReserveCancel which will trigger the result defined by the following
 // Then helper
-func (mmReserveCancel *mStocksStorageMockReserveCancel) When(ctx context.Context, skus map[uint32]uint32) *StocksStorageMockReserveCancelExpectation {
+func (mmReserveCancel *mStocksStorageMockReserveCancel) When(ctx context.Context, items map[domain.StockKey]uint32) *StocksStorageMockReserveCancelExpectation {
 	if mmReserveCancel.mock.funcReserveCancel != nil {
 		mmReserveCancel.mock.t.Fatalf("StocksStorageMock.ReserveCancel mock is already set by Set")
 	}
 
 	expectation := &StocksStorageMockReserveCancelExpectation{
 		mock:               mmReserveCancel.mock,
-		params:             &StocksStorageMockReserveCancelParams{ctx, skus},
+		params:             &StocksStorageMockReserveCancelParams{ctx, items},
 		expectationOrigins: StocksStorageMockReserveCancelExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
 	mmReserveCancel.expectations = append(mmReserveCancel.expectations, expectation)
@@ -1011,17 +2889,17 @@ func (mmReserveCancel *mStocksStorageMockReserveCancel) invocationsDone() bool {
 }
 
 // ReserveCancel implements mm_loms.StocksStorage
-func (mmReserveCancel *StocksStorageMock) ReserveCancel(ctx context.Context, skus map[uint32]uint32) (err error) {
+func (mmReserveCancel *StocksStorageMock) ReserveCancel(ctx context.Context, items map[domain.StockKey]uint32) (err error) {
 	mm_atomic.AddUint64(&mmReserveCancel.beforeReserveCancelCounter, 1)
 	defer mm_atomic.AddUint64(&mmReserveCancel.afterReserveCancelCounter, 1)
 
 	mmReserveCancel.t.Helper()
 
 	if mmReserveCancel.inspectFuncReserveCancel != nil {
-		mmReserveCancel.inspectFuncReserveCancel(ctx, skus)
+		mmReserveCancel.inspectFuncReserveCancel(ctx, items)
 	}
 
-	mm_params := StocksStorageMockReserveCancelParams{ctx, skus}
+	mm_params := StocksStorageMockReserveCancelParams{ctx, items}
 
 	// Record call args
 	mmReserveCancel.ReserveCancelMock.mutex.Lock()
@@ -1040,7 +2918,7 @@ func (mmReserveCancel *StocksStorageMock) ReserveCancel(ctx context.Context, sku
 		mm_want := mmReserveCancel.ReserveCancelMock.defaultExpectation.params
 		mm_want_ptrs := mmReserveCancel.ReserveCancelMock.defaultExpectation.paramPtrs
 
-		mm_got := StocksStorageMockReserveCancelParams{ctx, skus}
+		mm_got := StocksStorageMockReserveCancelParams{ctx, items}
 
 		if mm_want_ptrs != nil {
 
@@ -1049,9 +2927,9 @@ func (mmReserveCancel *StocksStorageMock) ReserveCancel(ctx context.Context, sku
 					mmReserveCancel.ReserveCancelMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.skus != nil && !minimock.Equal(*mm_want_ptrs.skus, mm_got.skus) {
-				mmReserveCancel.t.Errorf("StocksStorageMock.ReserveCancel got unexpected parameter skus, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmReserveCancel.ReserveCancelMock.defaultExpectation.expectationOrigins.originSkus, *mm_want_ptrs.skus, mm_got.skus, minimock.Diff(*mm_want_ptrs.skus, mm_got.skus))
+			if mm_want_ptrs.items != nil && !minimock.Equal(*mm_want_ptrs.items, mm_got.items) {
+				mmReserveCancel.t.Errorf("StocksStorageMock.ReserveCancel got unexpected parameter items, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReserveCancel.ReserveCancelMock.defaultExpectation.expectationOrigins.originItems, *mm_want_ptrs.items, mm_got.items, minimock.Diff(*mm_want_ptrs.items, mm_got.items))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
@@ -1066,9 +2944,9 @@ func (mmReserveCancel *StocksStorageMock) ReserveCancel(ctx context.Context, sku
 		return (*mm_results).err
 	}
 	if mmReserveCancel.funcReserveCancel != nil {
-		return mmReserveCancel.funcReserveCancel(ctx, skus)
+		return mmReserveCancel.funcReserveCancel(ctx, items)
 	}
-	mmReserveCancel.t.Fatalf("Unexpected call to StocksStorageMock.ReserveCancel. %v %v", ctx, skus)
+	mmReserveCancel.t.Fatalf("Unexpected call to StocksStorageMock.ReserveCancel. %v %v", ctx, items)
 	return
 }
 
@@ -1167,15 +3045,13 @@ type StocksStorageMockReserveRemoveExpectation struct {
 // StocksStorageMockReserveRemoveParams contains parameters of the StocksStorage.ReserveRemove
 type StocksStorageMockReserveRemoveParams struct {
 	ctx   context.Context
-	sku   uint32
-	count uint32
+	items map[domain.StockKey]uint32
 }
 
 // StocksStorageMockReserveRemoveParamPtrs contains pointers to parameters of the StocksStorage.ReserveRemove
 type StocksStorageMockReserveRemoveParamPtrs struct {
 	ctx   *context.Context
-	sku   *uint32
-	count *uint32
+	items *map[domain.StockKey]uint32
 }
 
 // StocksStorageMockReserveRemoveResults contains results of the StocksStorage.ReserveRemove
@@ -1187,8 +3063,7 @@ type StocksStorageMockReserveRemoveResults struct {
 type StocksStorageMockReserveRemoveExpectationOrigins struct {
 	origin      string
 	originCtx   string
-	originSku   string
-	originCount string
+	originItems string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -1202,7 +3077,7 @@ func (mmReserveRemove *mStocksStorageMockReserveRemove) Optional() *mStocksStora
 }
 
 // Expect sets up expected params for StocksStorage.ReserveRemove
-func (mmReserveRemove *mStocksStorageMockReserveRemove) Expect(ctx context.Context, sku uint32, count uint32) *mStocksStorageMockReserveRemove {
+func (mmReserveRemove *mStocksStorageMockReserveRemove) Expect(ctx context.Context, items map[domain.StockKey]uint32) *mStocksStorageMockReserveRemove {
 	if mmReserveRemove.mock.funcReserveRemove != nil {
 		mmReserveRemove.mock.t.Fatalf("StocksStorageMock.ReserveRemove mock is already set by Set")
 	}
@@ -1215,7 +3090,7 @@ func (mmReserveRemove *mStocksStorageMockReserveRemove) Expect(ctx context.Conte
 		mmReserveRemove.mock.t.Fatalf("StocksStorageMock.ReserveRemove mock is already set by ExpectParams functions")
 	}
 
-	mmReserveRemove.defaultExpectation.params = &StocksStorageMockReserveRemoveParams{ctx, sku, count}
+	mmReserveRemove.defaultExpectation.params = &StocksStorageMockReserveRemoveParams{ctx, items}
 	mmReserveRemove.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
 	for _, e := range mmReserveRemove.expectations {
 		if minimock.Equal(e.params, mmReserveRemove.defaultExpectation.params) {
@@ -1249,31 +3124,8 @@ func (mmReserveRemove *mStocksStorageMockReserveRemove) ExpectCtxParam1(ctx cont
 	return mmReserveRemove
 }
 
-// ExpectSkuParam2 sets up expected param sku for StocksStorage.ReserveRemove
-func (mmReserveRemove *mStocksStorageMockReserveRemove) ExpectSkuParam2(sku uint32) *mStocksStorageMockReserveRemove {
-	if mmReserveRemove.mock.funcReserveRemove != nil {
-		mmReserveRemove.mock.t.Fatalf("StocksStorageMock.ReserveRemove mock is already set by Set")
-	}
-
-	if mmReserveRemove.defaultExpectation == nil {
-		mmReserveRemove.defaultExpectation = &StocksStorageMockReserveRemoveExpectation{}
-	}
-
-	if mmReserveRemove.defaultExpectation.params != nil {
-		mmReserveRemove.mock.t.Fatalf("StocksStorageMock.ReserveRemove mock is already set by Expect")
-	}
-
-	if mmReserveRemove.defaultExpectation.paramPtrs == nil {
-		mmReserveRemove.defaultExpectation.paramPtrs = &StocksStorageMockReserveRemoveParamPtrs{}
-	}
-	mmReserveRemove.defaultExpectation.paramPtrs.sku = &sku
-	mmReserveRemove.defaultExpectation.expectationOrigins.originSku = minimock.CallerInfo(1)
-
-	return mmReserveRemove
-}
-
-// ExpectCountParam3 sets up expected param count for StocksStorage.ReserveRemove
-func (mmReserveRemove *mStocksStorageMockReserveRemove) ExpectCountParam3(count uint32) *mStocksStorageMockReserveRemove {
+// ExpectItemsParam2 sets up expected param items for StocksStorage.ReserveRemove
+func (mmReserveRemove *mStocksStorageMockReserveRemove) ExpectItemsParam2(items map[domain.StockKey]uint32) *mStocksStorageMockReserveRemove {
 	if mmReserveRemove.mock.funcReserveRemove != nil {
 		mmReserveRemove.mock.t.Fatalf("StocksStorageMock.ReserveRemove mock is already set by Set")
 	}
@@ -1289,14 +3141,14 @@ func (mmReserveRemove *mStocksStorageMockReserveRemove) ExpectCountParam3(count
 	if mmReserveRemove.defaultExpectation.paramPtrs == nil {
 		mmReserveRemove.defaultExpectation.paramPtrs = &StocksStorageMockReserveRemoveParamPtrs{}
 	}
-	mmReserveRemove.defaultExpectation.paramPtrs.count = &count
-	mmReserveRemove.defaultExpectation.expectationOrigins.originCount = minimock.CallerInfo(1)
+	mmReserveRemove.defaultExpectation.paramPtrs.items = &items
+	mmReserveRemove.defaultExpectation.expectationOrigins.originItems = minimock.CallerInfo(1)
 
 	return mmReserveRemove
 }
 
 // Inspect accepts an inspector function that has same arguments as the StocksStorage.ReserveRemove
-func (mmReserveRemove *mStocksStorageMockReserveRemove) Inspect(f func(ctx context.Context, sku uint32, count uint32)) *mStocksStorageMockReserveRemove {
+func (mmReserveRemove *mStocksStorageMockReserveRemove) Inspect(f func(ctx context.Context, items map[domain.StockKey]uint32)) *mStocksStorageMockReserveRemove {
 	if mmReserveRemove.mock.inspectFuncReserveRemove != nil {
 		mmReserveRemove.mock.t.Fatalf("Inspect function is already set for StocksStorageMock.ReserveRemove")
 	}
@@ -1321,7 +3173,7 @@ func (mmReserveRemove *mStocksStorageMockReserveRemove) Return(err error) *Stock
 }
 
 // Set uses given function f to mock the StocksStorage.ReserveRemove method
-func (mmReserveRemove *mStocksStorageMockReserveRemove) Set(f func(ctx context.Context, sku uint32, count uint32) (err error)) *StocksStorageMock {
+func (mmReserveRemove *mStocksStorageMockReserveRemove) Set(f func(ctx context.Context, items map[domain.StockKey]uint32) (err error)) *StocksStorageMock {
 	if mmReserveRemove.defaultExpectation != nil {
 		mmReserveRemove.mock.t.Fatalf("Default expectation is already set for the StocksStorage.ReserveRemove method")
 	}
@@ -1337,14 +3189,14 @@ func (mmReserveRemove *mStocksStorageMockReserveRemove) Set(f func(ctx context.C
 
 // When sets expectation for the StocksStorage.ReserveRemove which will trigger the result defined by the following
 // Then helper
-func (mmReserveRemove *mStocksStorageMockReserveRemove) When(ctx context.Context, sku uint32, count uint32) *StocksStorageMockReserveRemoveExpectation {
+func (mmReserveRemove *mStocksStorageMockReserveRemove) When(ctx context.Context, items map[domain.StockKey]uint32) *StocksStorageMockReserveRemoveExpectation {
 	if mmReserveRemove.mock.funcReserveRemove != nil {
 		mmReserveRemove.mock.t.Fatalf("StocksStorageMock.ReserveRemove mock is already set by Set")
 	}
 
 	expectation := &StocksStorageMockReserveRemoveExpectation{
 		mock:               mmReserveRemove.mock,
-		params:             &StocksStorageMockReserveRemoveParams{ctx, sku, count},
+		params:             &StocksStorageMockReserveRemoveParams{ctx, items},
 		expectationOrigins: StocksStorageMockReserveRemoveExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
 	mmReserveRemove.expectations = append(mmReserveRemove.expectations, expectation)
@@ -1379,17 +3231,17 @@ func (mmReserveRemove *mStocksStorageMockReserveRemove) invocationsDone() bool {
 }
 
 // ReserveRemove implements mm_loms.StocksStorage
-func (mmReserveRemove *StocksStorageMock) ReserveRemove(ctx context.Context, sku uint32, count uint32) (err error) {
+func (mmReserveRemove *StocksStorageMock) ReserveRemove(ctx context.Context, items map[domain.StockKey]uint32) (err error) {
 	mm_atomic.AddUint64(&mmReserveRemove.beforeReserveRemoveCounter, 1)
 	defer mm_atomic.AddUint64(&mmReserveRemove.afterReserveRemoveCounter, 1)
 
 	mmReserveRemove.t.Helper()
 
 	if mmReserveRemove.inspectFuncReserveRemove != nil {
-		mmReserveRemove.inspectFuncReserveRemove(ctx, sku, count)
+		mmReserveRemove.inspectFuncReserveRemove(ctx, items)
 	}
 
-	mm_params := StocksStorageMockReserveRemoveParams{ctx, sku, count}
+	mm_params := StocksStorageMockReserveRemoveParams{ctx, items}
 
 	// Record call args
 	mmReserveRemove.ReserveRemoveMock.mutex.Lock()
@@ -1408,7 +3260,7 @@ func (mmReserveRemove *StocksStorageMock) ReserveRemove(ctx context.Context, sku
 		mm_want := mmReserveRemove.ReserveRemoveMock.defaultExpectation.params
 		mm_want_ptrs := mmReserveRemove.ReserveRemoveMock.defaultExpectation.paramPtrs
 
-		mm_got := StocksStorageMockReserveRemoveParams{ctx, sku, count}
+		mm_got := StocksStorageMockReserveRemoveParams{ctx, items}
 
 		if mm_want_ptrs != nil {
 
@@ -1417,14 +3269,9 @@ func (mmReserveRemove *StocksStorageMock) ReserveRemove(ctx context.Context, sku
 					mmReserveRemove.ReserveRemoveMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.sku != nil && !minimock.Equal(*mm_want_ptrs.sku, mm_got.sku) {
-				mmReserveRemove.t.Errorf("StocksStorageMock.ReserveRemove got unexpected parameter sku, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmReserveRemove.ReserveRemoveMock.defaultExpectation.expectationOrigins.originSku, *mm_want_ptrs.sku, mm_got.sku, minimock.Diff(*mm_want_ptrs.sku, mm_got.sku))
-			}
-
-			if mm_want_ptrs.count != nil && !minimock.Equal(*mm_want_ptrs.count, mm_got.count) {
-				mmReserveRemove.t.Errorf("StocksStorageMock.ReserveRemove got unexpected parameter count, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmReserveRemove.ReserveRemoveMock.defaultExpectation.expectationOrigins.originCount, *mm_want_ptrs.count, mm_got.count, minimock.Diff(*mm_want_ptrs.count, mm_got.count))
+			if mm_want_ptrs.items != nil && !minimock.Equal(*mm_want_ptrs.items, mm_got.items) {
+				mmReserveRemove.t.Errorf("StocksStorageMock.ReserveRemove got unexpected parameter items, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReserveRemove.ReserveRemoveMock.defaultExpectation.expectationOrigins.originItems, *mm_want_ptrs.items, mm_got.items, minimock.Diff(*mm_want_ptrs.items, mm_got.items))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
@@ -1439,9 +3286,9 @@ func (mmReserveRemove *StocksStorageMock) ReserveRemove(ctx context.Context, sku
 		return (*mm_results).err
 	}
 	if mmReserveRemove.funcReserveRemove != nil {
-		return mmReserveRemove.funcReserveRemove(ctx, sku, count)
+		return mmReserveRemove.funcReserveRemove(ctx, items)
 	}
-	mmReserveRemove.t.Fatalf("Unexpected call to StocksStorageMock.ReserveRemove. %v %v %v", ctx, sku, count)
+	mmReserveRemove.t.Fatalf("Unexpected call to StocksStorageMock.ReserveRemove. %v %v", ctx, items)
 	return
 }
 
@@ -1513,361 +3360,27 @@ func (m *StocksStorageMock) MinimockReserveRemoveInspect() {
 	}
 }
 
-type mStocksStorageMockRollbackReserve struct {
-	optional           bool
-	mock               *StocksStorageMock
-	defaultExpectation *StocksStorageMockRollbackReserveExpectation
-	expectations       []*StocksStorageMockRollbackReserveExpectation
-
-	callArgs []*StocksStorageMockRollbackReserveParams
-	mutex    sync.RWMutex
-
-	expectedInvocations       uint64
-	expectedInvocationsOrigin string
-}
-
-// StocksStorageMockRollbackReserveExpectation specifies expectation struct of the StocksStorage.RollbackReserve
-type StocksStorageMockRollbackReserveExpectation struct {
-	mock               *StocksStorageMock
-	params             *StocksStorageMockRollbackReserveParams
-	paramPtrs          *StocksStorageMockRollbackReserveParamPtrs
-	expectationOrigins StocksStorageMockRollbackReserveExpectationOrigins
-	results            *StocksStorageMockRollbackReserveResults
-	returnOrigin       string
-	Counter            uint64
-}
-
-// StocksStorageMockRollbackReserveParams contains parameters of the StocksStorage.RollbackReserve
-type StocksStorageMockRollbackReserveParams struct {
-	ctx  context.Context
-	skus map[uint32]uint32
-}
-
-// StocksStorageMockRollbackReserveParamPtrs contains pointers to parameters of the StocksStorage.RollbackReserve
-type StocksStorageMockRollbackReserveParamPtrs struct {
-	ctx  *context.Context
-	skus *map[uint32]uint32
-}
-
-// StocksStorageMockRollbackReserveResults contains results of the StocksStorage.RollbackReserve
-type StocksStorageMockRollbackReserveResults struct {
-	err error
-}
-
-// StocksStorageMockRollbackReserveOrigins contains origins of expectations of the StocksStorage.RollbackReserve
-type StocksStorageMockRollbackReserveExpectationOrigins struct {
-	origin     string
-	originCtx  string
-	originSkus string
-}
-
-// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
-// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
-// Optional() makes method check to work in '0 or more' mode.
-// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
-// catch the problems when the expected method call is totally skipped during test run.
-func (mmRollbackReserve *mStocksStorageMockRollbackReserve) Optional() *mStocksStorageMockRollbackReserve {
-	mmRollbackReserve.optional = true
-	return mmRollbackReserve
-}
-
-// Expect sets up expected params for StocksStorage.RollbackReserve
-func (mmRollbackReserve *mStocksStorageMockRollbackReserve) Expect(ctx context.Context, skus map[uint32]uint32) *mStocksStorageMockRollbackReserve {
-	if mmRollbackReserve.mock.funcRollbackReserve != nil {
-		mmRollbackReserve.mock.t.Fatalf("StocksStorageMock.RollbackReserve mock is already set by Set")
-	}
-
-	if mmRollbackReserve.defaultExpectation == nil {
-		mmRollbackReserve.defaultExpectation = &StocksStorageMockRollbackReserveExpectation{}
-	}
-
-	if mmRollbackReserve.defaultExpectation.paramPtrs != nil {
-		mmRollbackReserve.mock.t.Fatalf("StocksStorageMock.RollbackReserve mock is already set by ExpectParams functions")
-	}
-
-	mmRollbackReserve.defaultExpectation.params = &StocksStorageMockRollbackReserveParams{ctx, skus}
-	mmRollbackReserve.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmRollbackReserve.expectations {
-		if minimock.Equal(e.params, mmRollbackReserve.defaultExpectation.params) {
-			mmRollbackReserve.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRollbackReserve.defaultExpectation.params)
-		}
-	}
-
-	return mmRollbackReserve
-}
-
-// ExpectCtxParam1 sets up expected param ctx for StocksStorage.RollbackReserve
-func (mmRollbackReserve *mStocksStorageMockRollbackReserve) ExpectCtxParam1(ctx context.Context) *mStocksStorageMockRollbackReserve {
-	if mmRollbackReserve.mock.funcRollbackReserve != nil {
-		mmRollbackReserve.mock.t.Fatalf("StocksStorageMock.RollbackReserve mock is already set by Set")
-	}
-
-	if mmRollbackReserve.defaultExpectation == nil {
-		mmRollbackReserve.defaultExpectation = &StocksStorageMockRollbackReserveExpectation{}
-	}
-
-	if mmRollbackReserve.defaultExpectation.params != nil {
-		mmRollbackReserve.mock.t.Fatalf("StocksStorageMock.RollbackReserve mock is already set by Expect")
-	}
-
-	if mmRollbackReserve.defaultExpectation.paramPtrs == nil {
-		mmRollbackReserve.defaultExpectation.paramPtrs = &StocksStorageMockRollbackReserveParamPtrs{}
-	}
-	mmRollbackReserve.defaultExpectation.paramPtrs.ctx = &ctx
-	mmRollbackReserve.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
-
-	return mmRollbackReserve
-}
-
-// ExpectSkusParam2 sets up expected param skus for StocksStorage.RollbackReserve
-func (mmRollbackReserve *mStocksStorageMockRollbackReserve) ExpectSkusParam2(skus map[uint32]uint32) *mStocksStorageMockRollbackReserve {
-	if mmRollbackReserve.mock.funcRollbackReserve != nil {
-		mmRollbackReserve.mock.t.Fatalf("StocksStorageMock.RollbackReserve mock is already set by Set")
-	}
-
-	if mmRollbackReserve.defaultExpectation == nil {
-		mmRollbackReserve.defaultExpectation = &StocksStorageMockRollbackReserveExpectation{}
-	}
-
-	if mmRollbackReserve.defaultExpectation.params != nil {
-		mmRollbackReserve.mock.t.Fatalf("StocksStorageMock.RollbackReserve mock is already set by Expect")
-	}
-
-	if mmRollbackReserve.defaultExpectation.paramPtrs == nil {
-		mmRollbackReserve.defaultExpectation.paramPtrs = &StocksStorageMockRollbackReserveParamPtrs{}
-	}
-	mmRollbackReserve.defaultExpectation.paramPtrs.skus = &skus
-	mmRollbackReserve.defaultExpectation.expectationOrigins.originSkus = minimock.CallerInfo(1)
-
-	return mmRollbackReserve
-}
-
-// Inspect accepts an inspector function that has same arguments as the StocksStorage.RollbackReserve
-func (mmRollbackReserve *mStocksStorageMockRollbackReserve) Inspect(f func(ctx context.Context, skus map[uint32]uint32)) *mStocksStorageMockRollbackReserve {
-	if mmRollbackReserve.mock.inspectFuncRollbackReserve != nil {
-		mmRollbackReserve.mock.t.Fatalf("Inspect function is already set for StocksStorageMock.RollbackReserve")
-	}
-
-	mmRollbackReserve.mock.inspectFuncRollbackReserve = f
-
-	return mmRollbackReserve
-}
-
-// Return sets up results that will be returned by StocksStorage.RollbackReserve
-func (mmRollbackReserve *mStocksStorageMockRollbackReserve) Return(err error) *StocksStorageMock {
-	if mmRollbackReserve.mock.funcRollbackReserve != nil {
-		mmRollbackReserve.mock.t.Fatalf("StocksStorageMock.RollbackReserve mock is already set by Set")
-	}
-
-	if mmRollbackReserve.defaultExpectation == nil {
-		mmRollbackReserve.defaultExpectation = &StocksStorageMockRollbackReserveExpectation{mock: mmRollbackReserve.mock}
-	}
-	mmRollbackReserve.defaultExpectation.results = &StocksStorageMockRollbackReserveResults{err}
-	mmRollbackReserve.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmRollbackReserve.mock
-}
-
-// Set uses given function f to mock the StocksStorage.RollbackReserve method
-func (mmRollbackReserve *mStocksStorageMockRollbackReserve) Set(f func(ctx context.Context, skus map[uint32]uint32) (err error)) *StocksStorageMock {
-	if mmRollbackReserve.defaultExpectation != nil {
-		mmRollbackReserve.mock.t.Fatalf("Default expectation is already set for the StocksStorage.RollbackReserve method")
-	}
-
-	if len(mmRollbackReserve.expectations) > 0 {
-		mmRollbackReserve.mock.t.Fatalf("Some expectations are already set for the StocksStorage.RollbackReserve method")
-	}
-
-	mmRollbackReserve.mock.funcRollbackReserve = f
-	mmRollbackReserve.mock.funcRollbackReserveOrigin = minimock.CallerInfo(1)
-	return mmRollbackReserve.mock
-}
-
-// When sets expectation for the StocksStorage.RollbackReserve which will trigger the result defined by the following
-// Then helper
-func (mmRollbackReserve *mStocksStorageMockRollbackReserve) When(ctx context.Context, skus map[uint32]uint32) *StocksStorageMockRollbackReserveExpectation {
-	if mmRollbackReserve.mock.funcRollbackReserve != nil {
-		mmRollbackReserve.mock.t.Fatalf("StocksStorageMock.RollbackReserve mock is already set by Set")
-	}
-
-	expectation := &StocksStorageMockRollbackReserveExpectation{
-		mock:               mmRollbackReserve.mock,
-		params:             &StocksStorageMockRollbackReserveParams{ctx, skus},
-		expectationOrigins: StocksStorageMockRollbackReserveExpectationOrigins{origin: minimock.CallerInfo(1)},
-	}
-	mmRollbackReserve.expectations = append(mmRollbackReserve.expectations, expectation)
-	return expectation
-}
-
-// Then sets up StocksStorage.RollbackReserve return parameters for the expectation previously defined by the When method
-func (e *StocksStorageMockRollbackReserveExpectation) Then(err error) *StocksStorageMock {
-	e.results = &StocksStorageMockRollbackReserveResults{err}
-	return e.mock
-}
-
-// Times sets number of times StocksStorage.RollbackReserve should be invoked
-func (mmRollbackReserve *mStocksStorageMockRollbackReserve) Times(n uint64) *mStocksStorageMockRollbackReserve {
-	if n == 0 {
-		mmRollbackReserve.mock.t.Fatalf("Times of StocksStorageMock.RollbackReserve mock can not be zero")
-	}
-	mm_atomic.StoreUint64(&mmRollbackReserve.expectedInvocations, n)
-	mmRollbackReserve.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmRollbackReserve
-}
-
-func (mmRollbackReserve *mStocksStorageMockRollbackReserve) invocationsDone() bool {
-	if len(mmRollbackReserve.expectations) == 0 && mmRollbackReserve.defaultExpectation == nil && mmRollbackReserve.mock.funcRollbackReserve == nil {
-		return true
-	}
-
-	totalInvocations := mm_atomic.LoadUint64(&mmRollbackReserve.mock.afterRollbackReserveCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmRollbackReserve.expectedInvocations)
-
-	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
-}
-
-// RollbackReserve implements mm_loms.StocksStorage
-func (mmRollbackReserve *StocksStorageMock) RollbackReserve(ctx context.Context, skus map[uint32]uint32) (err error) {
-	mm_atomic.AddUint64(&mmRollbackReserve.beforeRollbackReserveCounter, 1)
-	defer mm_atomic.AddUint64(&mmRollbackReserve.afterRollbackReserveCounter, 1)
-
-	mmRollbackReserve.t.Helper()
-
-	if mmRollbackReserve.inspectFuncRollbackReserve != nil {
-		mmRollbackReserve.inspectFuncRollbackReserve(ctx, skus)
-	}
-
-	mm_params := StocksStorageMockRollbackReserveParams{ctx, skus}
-
-	// Record call args
-	mmRollbackReserve.RollbackReserveMock.mutex.Lock()
-	mmRollbackReserve.RollbackReserveMock.callArgs = append(mmRollbackReserve.RollbackReserveMock.callArgs, &mm_params)
-	mmRollbackReserve.RollbackReserveMock.mutex.Unlock()
-
-	for _, e := range mmRollbackReserve.RollbackReserveMock.expectations {
-		if minimock.Equal(*e.params, mm_params) {
-			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.err
-		}
-	}
-
-	if mmRollbackReserve.RollbackReserveMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmRollbackReserve.RollbackReserveMock.defaultExpectation.Counter, 1)
-		mm_want := mmRollbackReserve.RollbackReserveMock.defaultExpectation.params
-		mm_want_ptrs := mmRollbackReserve.RollbackReserveMock.defaultExpectation.paramPtrs
-
-		mm_got := StocksStorageMockRollbackReserveParams{ctx, skus}
-
-		if mm_want_ptrs != nil {
-
-			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmRollbackReserve.t.Errorf("StocksStorageMock.RollbackReserve got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmRollbackReserve.RollbackReserveMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
-			}
-
-			if mm_want_ptrs.skus != nil && !minimock.Equal(*mm_want_ptrs.skus, mm_got.skus) {
-				mmRollbackReserve.t.Errorf("StocksStorageMock.RollbackReserve got unexpected parameter skus, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmRollbackReserve.RollbackReserveMock.defaultExpectation.expectationOrigins.originSkus, *mm_want_ptrs.skus, mm_got.skus, minimock.Diff(*mm_want_ptrs.skus, mm_got.skus))
-			}
-
-		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmRollbackReserve.t.Errorf("StocksStorageMock.RollbackReserve got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmRollbackReserve.RollbackReserveMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
-		}
-
-		mm_results := mmRollbackReserve.RollbackReserveMock.defaultExpectation.results
-		if mm_results == nil {
-			mmRollbackReserve.t.Fatal("No results are set for the StocksStorageMock.RollbackReserve")
-		}
-		return (*mm_results).err
-	}
-	if mmRollbackReserve.funcRollbackReserve != nil {
-		return mmRollbackReserve.funcRollbackReserve(ctx, skus)
-	}
-	mmRollbackReserve.t.Fatalf("Unexpected call to StocksStorageMock.RollbackReserve. %v %v", ctx, skus)
-	return
-}
-
-// RollbackReserveAfterCounter returns a count of finished StocksStorageMock.RollbackReserve invocations
-func (mmRollbackReserve *StocksStorageMock) RollbackReserveAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmRollbackReserve.afterRollbackReserveCounter)
-}
-
-// RollbackReserveBeforeCounter returns a count of StocksStorageMock.RollbackReserve invocations
-func (mmRollbackReserve *StocksStorageMock) RollbackReserveBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmRollbackReserve.beforeRollbackReserveCounter)
-}
-
-// Calls returns a list of arguments used in each call to StocksStorageMock.RollbackReserve.
-// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmRollbackReserve *mStocksStorageMockRollbackReserve) Calls() []*StocksStorageMockRollbackReserveParams {
-	mmRollbackReserve.mutex.RLock()
-
-	argCopy := make([]*StocksStorageMockRollbackReserveParams, len(mmRollbackReserve.callArgs))
-	copy(argCopy, mmRollbackReserve.callArgs)
-
-	mmRollbackReserve.mutex.RUnlock()
-
-	return argCopy
-}
-
-// MinimockRollbackReserveDone returns true if the count of the RollbackReserve invocations corresponds
-// the number of defined expectations
-func (m *StocksStorageMock) MinimockRollbackReserveDone() bool {
-	if m.RollbackReserveMock.optional {
-		// Optional methods provide '0 or more' call count restriction.
-		return true
-	}
-
-	for _, e := range m.RollbackReserveMock.expectations {
-		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			return false
-		}
-	}
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *StocksStorageMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockAdjustInspect()
 
-	return m.RollbackReserveMock.invocationsDone()
-}
+			m.MinimockCreateInspect()
 
-// MinimockRollbackReserveInspect logs each unmet expectation
-func (m *StocksStorageMock) MinimockRollbackReserveInspect() {
-	for _, e := range m.RollbackReserveMock.expectations {
-		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to StocksStorageMock.RollbackReserve at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
-		}
-	}
+			m.MinimockDeleteInspect()
 
-	afterRollbackReserveCounter := mm_atomic.LoadUint64(&m.afterRollbackReserveCounter)
-	// if default expectation was set then invocations count should be greater than zero
-	if m.RollbackReserveMock.defaultExpectation != nil && afterRollbackReserveCounter < 1 {
-		if m.RollbackReserveMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to StocksStorageMock.RollbackReserve at\n%s", m.RollbackReserveMock.defaultExpectation.returnOrigin)
-		} else {
-			m.t.Errorf("Expected call to StocksStorageMock.RollbackReserve at\n%s with params: %#v", m.RollbackReserveMock.defaultExpectation.expectationOrigins.origin, *m.RollbackReserveMock.defaultExpectation.params)
-		}
-	}
-	// if func was set then invocations count should be greater than zero
-	if m.funcRollbackReserve != nil && afterRollbackReserveCounter < 1 {
-		m.t.Errorf("Expected call to StocksStorageMock.RollbackReserve at\n%s", m.funcRollbackReserveOrigin)
-	}
+			m.MinimockGetBySKUsInspect()
 
-	if !m.RollbackReserveMock.invocationsDone() && afterRollbackReserveCounter > 0 {
-		m.t.Errorf("Expected %d calls to StocksStorageMock.RollbackReserve at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.RollbackReserveMock.expectedInvocations), m.RollbackReserveMock.expectedInvocationsOrigin, afterRollbackReserveCounter)
-	}
-}
+			m.MinimockGetWarehouseStocksInspect()
 
-// MinimockFinish checks that all mocked methods have been called the expected number of times
-func (m *StocksStorageMock) MinimockFinish() {
-	m.finishOnce.Do(func() {
-		if !m.minimockDone() {
-			m.MinimockGetBySKUInspect()
+			m.MinimockReplenishInspect()
 
 			m.MinimockReserveInspect()
 
 			m.MinimockReserveCancelInspect()
 
 			m.MinimockReserveRemoveInspect()
-
-			m.MinimockRollbackReserveInspect()
 		}
 	})
 }
@@ -1891,9 +3404,13 @@ func (m *StocksStorageMock) MinimockWait(timeout mm_time.Duration) {
 func (m *StocksStorageMock) minimockDone() bool {
 	done := true
 	return done &&
-		m.MinimockGetBySKUDone() &&
+		m.MinimockAdjustDone() &&
+		m.MinimockCreateDone() &&
+		m.MinimockDeleteDone() &&
+		m.MinimockGetBySKUsDone() &&
+		m.MinimockGetWarehouseStocksDone() &&
+		m.MinimockReplenishDone() &&
 		m.MinimockReserveDone() &&
 		m.MinimockReserveCancelDone() &&
-		m.MinimockReserveRemoveDone() &&
-		m.MinimockRollbackReserveDone()
+		m.MinimockReserveRemoveDone()
 }
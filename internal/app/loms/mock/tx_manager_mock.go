@@ -0,0 +1,416 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.5). DO NOT EDIT.
+
+package mock
+
+//go:generate minimock -i github.com/vestamart/loms/internal/app/loms.TxManager -o tx_manager_mock.go -n TxManagerMock -p mock
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// TxManagerMock implements mm_loms.TxManager
+type TxManagerMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcWithinTransaction          func(ctx context.Context, fn func(ctx context.Context) error) (err error)
+	funcWithinTransactionOrigin    string
+	inspectFuncWithinTransaction   func(ctx context.Context, fn func(ctx context.Context) error)
+	afterWithinTransactionCounter  uint64
+	beforeWithinTransactionCounter uint64
+	WithinTransactionMock          mTxManagerMockWithinTransaction
+}
+
+// NewTxManagerMock returns a mock for mm_loms.TxManager
+func NewTxManagerMock(t minimock.Tester) *TxManagerMock {
+	m := &TxManagerMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.WithinTransactionMock = mTxManagerMockWithinTransaction{mock: m}
+	m.WithinTransactionMock.callArgs = []*TxManagerMockWithinTransactionParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mTxManagerMockWithinTransaction struct {
+	optional           bool
+	mock               *TxManagerMock
+	defaultExpectation *TxManagerMockWithinTransactionExpectation
+	expectations       []*TxManagerMockWithinTransactionExpectation
+
+	callArgs []*TxManagerMockWithinTransactionParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// TxManagerMockWithinTransactionExpectation specifies expectation struct of the TxManager.WithinTransaction
+type TxManagerMockWithinTransactionExpectation struct {
+	mock               *TxManagerMock
+	params             *TxManagerMockWithinTransactionParams
+	paramPtrs          *TxManagerMockWithinTransactionParamPtrs
+	expectationOrigins TxManagerMockWithinTransactionExpectationOrigins
+	results            *TxManagerMockWithinTransactionResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// TxManagerMockWithinTransactionParams contains parameters of the TxManager.WithinTransaction
+type TxManagerMockWithinTransactionParams struct {
+	ctx context.Context
+	fn  func(ctx context.Context) error
+}
+
+// TxManagerMockWithinTransactionParamPtrs contains pointers to parameters of the TxManager.WithinTransaction
+type TxManagerMockWithinTransactionParamPtrs struct {
+	ctx *context.Context
+	fn  *func(ctx context.Context) error
+}
+
+// TxManagerMockWithinTransactionResults contains results of the TxManager.WithinTransaction
+type TxManagerMockWithinTransactionResults struct {
+	err error
+}
+
+// TxManagerMockWithinTransactionOrigins contains origins of expectations of the TxManager.WithinTransaction
+type TxManagerMockWithinTransactionExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originFn  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmWithinTransaction *mTxManagerMockWithinTransaction) Optional() *mTxManagerMockWithinTransaction {
+	mmWithinTransaction.optional = true
+	return mmWithinTransaction
+}
+
+// Expect sets up expected params for TxManager.WithinTransaction
+func (mmWithinTransaction *mTxManagerMockWithinTransaction) Expect(ctx context.Context, fn func(ctx context.Context) error) *mTxManagerMockWithinTransaction {
+	if mmWithinTransaction.mock.funcWithinTransaction != nil {
+		mmWithinTransaction.mock.t.Fatalf("TxManagerMock.WithinTransaction mock is already set by Set")
+	}
+
+	if mmWithinTransaction.defaultExpectation == nil {
+		mmWithinTransaction.defaultExpectation = &TxManagerMockWithinTransactionExpectation{}
+	}
+
+	if mmWithinTransaction.defaultExpectation.paramPtrs != nil {
+		mmWithinTransaction.mock.t.Fatalf("TxManagerMock.WithinTransaction mock is already set by ExpectParams functions")
+	}
+
+	mmWithinTransaction.defaultExpectation.params = &TxManagerMockWithinTransactionParams{ctx, fn}
+	mmWithinTransaction.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmWithinTransaction.expectations {
+		if minimock.Equal(e.params, mmWithinTransaction.defaultExpectation.params) {
+			mmWithinTransaction.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmWithinTransaction.defaultExpectation.params)
+		}
+	}
+
+	return mmWithinTransaction
+}
+
+// ExpectCtxParam1 sets up expected param ctx for TxManager.WithinTransaction
+func (mmWithinTransaction *mTxManagerMockWithinTransaction) ExpectCtxParam1(ctx context.Context) *mTxManagerMockWithinTransaction {
+	if mmWithinTransaction.mock.funcWithinTransaction != nil {
+		mmWithinTransaction.mock.t.Fatalf("TxManagerMock.WithinTransaction mock is already set by Set")
+	}
+
+	if mmWithinTransaction.defaultExpectation == nil {
+		mmWithinTransaction.defaultExpectation = &TxManagerMockWithinTransactionExpectation{}
+	}
+
+	if mmWithinTransaction.defaultExpectation.params != nil {
+		mmWithinTransaction.mock.t.Fatalf("TxManagerMock.WithinTransaction mock is already set by Expect")
+	}
+
+	if mmWithinTransaction.defaultExpectation.paramPtrs == nil {
+		mmWithinTransaction.defaultExpectation.paramPtrs = &TxManagerMockWithinTransactionParamPtrs{}
+	}
+	mmWithinTransaction.defaultExpectation.paramPtrs.ctx = &ctx
+	mmWithinTransaction.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmWithinTransaction
+}
+
+// ExpectFnParam2 sets up expected param fn for TxManager.WithinTransaction
+func (mmWithinTransaction *mTxManagerMockWithinTransaction) ExpectFnParam2(fn func(ctx context.Context) error) *mTxManagerMockWithinTransaction {
+	if mmWithinTransaction.mock.funcWithinTransaction != nil {
+		mmWithinTransaction.mock.t.Fatalf("TxManagerMock.WithinTransaction mock is already set by Set")
+	}
+
+	if mmWithinTransaction.defaultExpectation == nil {
+		mmWithinTransaction.defaultExpectation = &TxManagerMockWithinTransactionExpectation{}
+	}
+
+	if mmWithinTransaction.defaultExpectation.params != nil {
+		mmWithinTransaction.mock.t.Fatalf("TxManagerMock.WithinTransaction mock is already set by Expect")
+	}
+
+	if mmWithinTransaction.defaultExpectation.paramPtrs == nil {
+		mmWithinTransaction.defaultExpectation.paramPtrs = &TxManagerMockWithinTransactionParamPtrs{}
+	}
+	mmWithinTransaction.defaultExpectation.paramPtrs.fn = &fn
+	mmWithinTransaction.defaultExpectation.expectationOrigins.originFn = minimock.CallerInfo(1)
+
+	return mmWithinTransaction
+}
+
+// Inspect accepts an inspector function that has same arguments as the TxManager.WithinTransaction
+func (mmWithinTransaction *mTxManagerMockWithinTransaction) Inspect(f func(ctx context.Context, fn func(ctx context.Context) error)) *mTxManagerMockWithinTransaction {
+	if mmWithinTransaction.mock.inspectFuncWithinTransaction != nil {
+		mmWithinTransaction.mock.t.Fatalf("Inspect function is already set for TxManagerMock.WithinTransaction")
+	}
+
+	mmWithinTransaction.mock.inspectFuncWithinTransaction = f
+
+	return mmWithinTransaction
+}
+
+// Return sets up results that will be returned by TxManager.WithinTransaction
+func (mmWithinTransaction *mTxManagerMockWithinTransaction) Return(err error) *TxManagerMock {
+	if mmWithinTransaction.mock.funcWithinTransaction != nil {
+		mmWithinTransaction.mock.t.Fatalf("TxManagerMock.WithinTransaction mock is already set by Set")
+	}
+
+	if mmWithinTransaction.defaultExpectation == nil {
+		mmWithinTransaction.defaultExpectation = &TxManagerMockWithinTransactionExpectation{mock: mmWithinTransaction.mock}
+	}
+	mmWithinTransaction.defaultExpectation.results = &TxManagerMockWithinTransactionResults{err}
+	mmWithinTransaction.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmWithinTransaction.mock
+}
+
+// Set uses given function f to mock the TxManager.WithinTransaction method
+func (mmWithinTransaction *mTxManagerMockWithinTransaction) Set(f func(ctx context.Context, fn func(ctx context.Context) error) (err error)) *TxManagerMock {
+	if mmWithinTransaction.defaultExpectation != nil {
+		mmWithinTransaction.mock.t.Fatalf("Default expectation is already set for the TxManager.WithinTransaction method")
+	}
+
+	if len(mmWithinTransaction.expectations) > 0 {
+		mmWithinTransaction.mock.t.Fatalf("Some expectations are already set for the TxManager.WithinTransaction method")
+	}
+
+	mmWithinTransaction.mock.funcWithinTransaction = f
+	mmWithinTransaction.mock.funcWithinTransactionOrigin = minimock.CallerInfo(1)
+	return mmWithinTransaction.mock
+}
+
+// When sets expectation for the TxManager.WithinTransaction which will trigger the result defined by the following
+// Then helper
+func (mmWithinTransaction *mTxManagerMockWithinTransaction) When(ctx context.Context, fn func(ctx context.Context) error) *TxManagerMockWithinTransactionExpectation {
+	if mmWithinTransaction.mock.funcWithinTransaction != nil {
+		mmWithinTransaction.mock.t.Fatalf("TxManagerMock.WithinTransaction mock is already set by Set")
+	}
+
+	expectation := &TxManagerMockWithinTransactionExpectation{
+		mock:               mmWithinTransaction.mock,
+		params:             &TxManagerMockWithinTransactionParams{ctx, fn},
+		expectationOrigins: TxManagerMockWithinTransactionExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmWithinTransaction.expectations = append(mmWithinTransaction.expectations, expectation)
+	return expectation
+}
+
+// Then sets up TxManager.WithinTransaction return parameters for the expectation previously defined by the When method
+func (e *TxManagerMockWithinTransactionExpectation) Then(err error) *TxManagerMock {
+	e.results = &TxManagerMockWithinTransactionResults{err}
+	return e.mock
+}
+
+// Times sets number of times TxManager.WithinTransaction should be invoked
+func (mmWithinTransaction *mTxManagerMockWithinTransaction) Times(n uint64) *mTxManagerMockWithinTransaction {
+	if n == 0 {
+		mmWithinTransaction.mock.t.Fatalf("Times of TxManagerMock.WithinTransaction mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmWithinTransaction.expectedInvocations, n)
+	mmWithinTransaction.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmWithinTransaction
+}
+
+func (mmWithinTransaction *mTxManagerMockWithinTransaction) invocationsDone() bool {
+	if len(mmWithinTransaction.expectations) == 0 && mmWithinTransaction.defaultExpectation == nil && mmWithinTransaction.mock.funcWithinTransaction == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmWithinTransaction.mock.afterWithinTransactionCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmWithinTransaction.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// WithinTransaction implements mm_loms.TxManager
+func (mmWithinTransaction *TxManagerMock) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) (err error) {
+	mm_atomic.AddUint64(&mmWithinTransaction.beforeWithinTransactionCounter, 1)
+	defer mm_atomic.AddUint64(&mmWithinTransaction.afterWithinTransactionCounter, 1)
+
+	mmWithinTransaction.t.Helper()
+
+	if mmWithinTransaction.inspectFuncWithinTransaction != nil {
+		mmWithinTransaction.inspectFuncWithinTransaction(ctx, fn)
+	}
+
+	mm_params := TxManagerMockWithinTransactionParams{ctx, fn}
+
+	// Record call args
+	mmWithinTransaction.WithinTransactionMock.mutex.Lock()
+	mmWithinTransaction.WithinTransactionMock.callArgs = append(mmWithinTransaction.WithinTransactionMock.callArgs, &mm_params)
+	mmWithinTransaction.WithinTransactionMock.mutex.Unlock()
+
+	for _, e := range mmWithinTransaction.WithinTransactionMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmWithinTransaction.WithinTransactionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmWithinTransaction.WithinTransactionMock.defaultExpectation.Counter, 1)
+		mm_want := mmWithinTransaction.WithinTransactionMock.defaultExpectation.params
+		mm_want_ptrs := mmWithinTransaction.WithinTransactionMock.defaultExpectation.paramPtrs
+
+		mm_got := TxManagerMockWithinTransactionParams{ctx, fn}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmWithinTransaction.t.Errorf("TxManagerMock.WithinTransaction got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmWithinTransaction.WithinTransactionMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.fn != nil && !minimock.Equal(*mm_want_ptrs.fn, mm_got.fn) {
+				mmWithinTransaction.t.Errorf("TxManagerMock.WithinTransaction got unexpected parameter fn, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmWithinTransaction.WithinTransactionMock.defaultExpectation.expectationOrigins.originFn, *mm_want_ptrs.fn, mm_got.fn, minimock.Diff(*mm_want_ptrs.fn, mm_got.fn))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmWithinTransaction.t.Errorf("TxManagerMock.WithinTransaction got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmWithinTransaction.WithinTransactionMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmWithinTransaction.WithinTransactionMock.defaultExpectation.results
+		if mm_results == nil {
+			mmWithinTransaction.t.Fatal("No results are set for the TxManagerMock.WithinTransaction")
+		}
+		return (*mm_results).err
+	}
+	if mmWithinTransaction.funcWithinTransaction != nil {
+		return mmWithinTransaction.funcWithinTransaction(ctx, fn)
+	}
+	mmWithinTransaction.t.Fatalf("Unexpected call to TxManagerMock.WithinTransaction. %v %v", ctx, fn)
+	return
+}
+
+// WithinTransactionAfterCounter returns a count of finished TxManagerMock.WithinTransaction invocations
+func (mmWithinTransaction *TxManagerMock) WithinTransactionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmWithinTransaction.afterWithinTransactionCounter)
+}
+
+// WithinTransactionBeforeCounter returns a count of TxManagerMock.WithinTransaction invocations
+func (mmWithinTransaction *TxManagerMock) WithinTransactionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmWithinTransaction.beforeWithinTransactionCounter)
+}
+
+// Calls returns a list of arguments used in each call to TxManagerMock.WithinTransaction.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmWithinTransaction *mTxManagerMockWithinTransaction) Calls() []*TxManagerMockWithinTransactionParams {
+	mmWithinTransaction.mutex.RLock()
+
+	argCopy := make([]*TxManagerMockWithinTransactionParams, len(mmWithinTransaction.callArgs))
+	copy(argCopy, mmWithinTransaction.callArgs)
+
+	mmWithinTransaction.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockWithinTransactionDone returns true if the count of the WithinTransaction invocations corresponds
+// the number of defined expectations
+func (m *TxManagerMock) MinimockWithinTransactionDone() bool {
+	if m.WithinTransactionMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.WithinTransactionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.WithinTransactionMock.invocationsDone()
+}
+
+// MinimockWithinTransactionInspect logs each unmet expectation
+func (m *TxManagerMock) MinimockWithinTransactionInspect() {
+	for _, e := range m.WithinTransactionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to TxManagerMock.WithinTransaction at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterWithinTransactionCounter := mm_atomic.LoadUint64(&m.afterWithinTransactionCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.WithinTransactionMock.defaultExpectation != nil && afterWithinTransactionCounter < 1 {
+		if m.WithinTransactionMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to TxManagerMock.WithinTransaction at\n%s", m.WithinTransactionMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to TxManagerMock.WithinTransaction at\n%s with params: %#v", m.WithinTransactionMock.defaultExpectation.expectationOrigins.origin, *m.WithinTransactionMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcWithinTransaction != nil && afterWithinTransactionCounter < 1 {
+		m.t.Errorf("Expected call to TxManagerMock.WithinTransaction at\n%s", m.funcWithinTransactionOrigin)
+	}
+
+	if !m.WithinTransactionMock.invocationsDone() && afterWithinTransactionCounter > 0 {
+		m.t.Errorf("Expected %d calls to TxManagerMock.WithinTransaction at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.WithinTransactionMock.expectedInvocations), m.WithinTransactionMock.expectedInvocationsOrigin, afterWithinTransactionCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *TxManagerMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockWithinTransactionInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *TxManagerMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *TxManagerMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockWithinTransactionDone()
+}
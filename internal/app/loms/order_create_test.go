@@ -0,0 +1,51 @@
+package loms_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vestamart/loms/internal/app/loms"
+	"github.com/vestamart/loms/internal/domain"
+	"github.com/vestamart/loms/internal/localErr"
+	"github.com/vestamart/loms/internal/repository"
+	desc "github.com/vestamart/loms/pkg/api/loms/v1"
+)
+
+// newInMemoryService создает сервис поверх in-memory репозиториев со стоками из stock-data.json
+func newInMemoryService(t *testing.T) (*loms.Service, *repository.InMemoryOrderRepository, *repository.InMemoryStocksRepository) {
+	t.Helper()
+
+	stocks, err := repository.NewInMemoryStocksRepository("")
+	require.NoError(t, err)
+	outbox := repository.NewInMemoryOutboxRepository()
+	orders := repository.NewInMemoryOrderRepository(100, outbox)
+	svc := loms.NewService(orders, stocks, repository.NewInMemoryTxManager(), loms.NearestFirst)
+	return svc, orders, stocks
+}
+
+func TestOrderCreateRollsBackReservations(t *testing.T) {
+	svc, orders, stocks := newInMemoryService(t)
+	ctx := context.Background()
+
+	// 1002 и 1003 резервируются раньше 1005, которого не хватает
+	_, err := svc.OrderCreate(ctx, &desc.OrderCreateRequest{User: 7, Items: []*desc.Item{
+		{Sku: 1002, Count: 10},
+		{Sku: 1005, Count: 1000},
+		{Sku: 1003, Count: 10},
+	}})
+	require.ErrorIs(t, err, localErr.ItemNotEnoughErr)
+
+	got, err := stocks.GetBySKUs(ctx, []uint32{1002, 1003, 1005})
+	require.NoError(t, err)
+	assert.Equal(t, domain.StocksItem{TotalCount: 200, Reserved: 20}, got[1002])
+	assert.Equal(t, domain.StocksItem{TotalCount: 250, Reserved: 30}, got[1003])
+	assert.Equal(t, domain.StocksItem{TotalCount: 350, Reserved: 50}, got[1005])
+
+	list, err := orders.List(ctx, domain.OrderFilter{UserID: 7, Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	assert.Equal(t, domain.Failed, list[0].Status)
+	assert.Equal(t, []domain.Item{{Sku: 1002, Count: 10}, {Sku: 1005, Count: 1000}, {Sku: 1003, Count: 10}}, list[0].Items)
+}
@@ -0,0 +1,40 @@
+package loms
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/vestamart/loms/internal/domain"
+	"github.com/vestamart/loms/internal/localErr"
+)
+
+// encodePageToken кодирует позицию последнего заказа страницы в непрозрачный токен
+func encodePageToken(cursor domain.OrderCursor) string {
+	raw := fmt.Sprintf("%d:%d", cursor.CreatedAt.UnixNano(), cursor.ID)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func decodePageToken(token string) (*domain.OrderCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, localErr.InvalidPageTokenErr
+	}
+
+	createdAt, id, ok := strings.Cut(string(raw), ":")
+	if !ok {
+		return nil, localErr.InvalidPageTokenErr
+	}
+	nanos, err := strconv.ParseInt(createdAt, 10, 64)
+	if err != nil {
+		return nil, localErr.InvalidPageTokenErr
+	}
+	orderID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return nil, localErr.InvalidPageTokenErr
+	}
+
+	return &domain.OrderCursor{CreatedAt: time.Unix(0, nanos), ID: orderID}, nil
+}
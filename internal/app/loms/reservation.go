@@ -0,0 +1,72 @@
+package loms
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/vestamart/loms/internal/domain"
+	"github.com/vestamart/loms/internal/localErr"
+)
+
+// ReservationStrategy определяет, с каких складов резервируется товар
+type ReservationStrategy string
+
+const (
+	// NearestFirst резервирует с ближайших складов, разбивая позицию по складам при нехватке
+	NearestFirst ReservationStrategy = "nearest_first"
+	// SingleWarehouse резервирует всю позицию с ближайшего склада, на котором её хватает
+	SingleWarehouse ReservationStrategy = "single_warehouse"
+	// LargestStockFirst резервирует со складов с наибольшим свободным остатком
+	LargestStockFirst ReservationStrategy = "largest_stock_first"
+)
+
+func ParseReservationStrategy(s string) (ReservationStrategy, error) {
+	switch strategy := ReservationStrategy(s); strategy {
+	case NearestFirst, SingleWarehouse, LargestStockFirst:
+		return strategy, nil
+	case "":
+		return NearestFirst, nil
+	}
+	return "", fmt.Errorf("unknown reservation strategy %q", s)
+}
+
+// allocate распределяет count единиц товара sku по складам stocks.
+// stocks должны быть отсортированы по приоритету склада.
+func (r ReservationStrategy) allocate(sku, count uint32, stocks []domain.WarehouseStock) ([]domain.Item, error) {
+	if len(stocks) == 0 {
+		return nil, localErr.SKUNotExistErr
+	}
+
+	switch r {
+	case SingleWarehouse:
+		for _, v := range stocks {
+			if v.Available() >= count {
+				return []domain.Item{{Sku: sku, Count: count, WarehouseID: v.WarehouseID}}, nil
+			}
+		}
+		return nil, localErr.ItemNotEnoughErr
+	case LargestStockFirst:
+		stocks = slices.Clone(stocks)
+		slices.SortStableFunc(stocks, func(a, b domain.WarehouseStock) int {
+			return int(b.Available()) - int(a.Available())
+		})
+	}
+
+	items := make([]domain.Item, 0, 1)
+	for _, v := range stocks {
+		if count == 0 {
+			break
+		}
+		part := min(count, v.Available())
+		if part == 0 {
+			continue
+		}
+		items = append(items, domain.Item{Sku: sku, Count: part, WarehouseID: v.WarehouseID})
+		count -= part
+	}
+	if count > 0 {
+		return nil, localErr.ItemNotEnoughErr
+	}
+
+	return items, nil
+}
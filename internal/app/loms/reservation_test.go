@@ -0,0 +1,87 @@
+package loms
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vestamart/loms/internal/domain"
+	"github.com/vestamart/loms/internal/localErr"
+)
+
+func TestAllocate(t *testing.T) {
+	stocks := []domain.WarehouseStock{
+		{WarehouseID: 1, Priority: 1, StocksItem: domain.StocksItem{TotalCount: 10, Reserved: 5}},
+		{WarehouseID: 2, Priority: 2, StocksItem: domain.StocksItem{TotalCount: 20, Reserved: 0}},
+		{WarehouseID: 3, Priority: 3, StocksItem: domain.StocksItem{TotalCount: 8, Reserved: 0}},
+	}
+
+	tests := []struct {
+		name     string
+		strategy ReservationStrategy
+		count    uint32
+		stocks   []domain.WarehouseStock
+		want     []domain.Item
+		wantErr  error
+	}{
+		{
+			name:     "nearest first splits across warehouses",
+			strategy: NearestFirst,
+			count:    12,
+			stocks:   stocks,
+			want: []domain.Item{
+				{Sku: 1001, Count: 5, WarehouseID: 1},
+				{Sku: 1001, Count: 7, WarehouseID: 2},
+			},
+		},
+		{
+			name:     "single warehouse picks first that fits",
+			strategy: SingleWarehouse,
+			count:    12,
+			stocks:   stocks,
+			want:     []domain.Item{{Sku: 1001, Count: 12, WarehouseID: 2}},
+		},
+		{
+			name:     "single warehouse does not split",
+			strategy: SingleWarehouse,
+			count:    25,
+			stocks:   stocks,
+			wantErr:  localErr.ItemNotEnoughErr,
+		},
+		{
+			name:     "largest stock first",
+			strategy: LargestStockFirst,
+			count:    25,
+			stocks:   stocks,
+			want: []domain.Item{
+				{Sku: 1001, Count: 20, WarehouseID: 2},
+				{Sku: 1001, Count: 5, WarehouseID: 3},
+			},
+		},
+		{
+			name:     "not enough in total",
+			strategy: NearestFirst,
+			count:    34,
+			stocks:   stocks,
+			wantErr:  localErr.ItemNotEnoughErr,
+		},
+		{
+			name:     "unknown sku",
+			strategy: NearestFirst,
+			count:    1,
+			wantErr:  localErr.SKUNotExistErr,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.strategy.allocate(1001, tt.count, tt.stocks)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -4,10 +4,17 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log/slog"
+	"maps"
+	"slices"
+	"time"
 
 	"github.com/vestamart/loms/internal/domain"
 	"github.com/vestamart/loms/internal/localErr"
+	"github.com/vestamart/loms/internal/logger"
+	"github.com/vestamart/loms/internal/tracing"
 	desc "github.com/vestamart/loms/pkg/api/loms/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // OrdersRepository и StocksStorage интерфейсы для взаимодействия с репозиториями
@@ -15,29 +22,50 @@ import (
 //go:generate minimock -i github.com/vestamart/loms/internal/app/loms.OrdersRepository -o ./mock/orders_repository_mock.go -n OrdersRepositoryMock -p mock
 type OrdersRepository interface {
 	Create(_ context.Context, userID int64, items *[]domain.Item) (int64, error)
-	SetStatus(_ context.Context, orderID int64, status domain.OrderStatus) error
+	SetStatus(_ context.Context, orderID int64, expected, status domain.OrderStatus, reason string) error
 	GetByID(_ context.Context, orderID int64) (*domain.Order, error)
+	History(_ context.Context, orderID int64) ([]domain.StatusChange, error)
+	List(_ context.Context, filter domain.OrderFilter) ([]domain.Order, error)
+	ListExpired(_ context.Context, status domain.OrderStatus, updatedBefore time.Time, exclude []int64, limit int32) ([]int64, error)
 }
 
 //go:generate minimock -i github.com/vestamart/loms/internal/app/loms.StocksStorage -o ./mock/stock_repository_mock.go -n StocksStorageMock -p mock
 type StocksStorage interface {
-	Reserve(_ context.Context, sku uint32, count uint32) error
-	ReserveRemove(_ context.Context, skus map[uint32]uint32) error
-	ReserveCancel(_ context.Context, skus map[uint32]uint32) error
-	GetBySKU(_ context.Context, sku uint32) (uint32, uint32, error)
-	RollbackReserve(_ context.Context, skus map[uint32]uint32) error
+	Reserve(_ context.Context, key domain.StockKey, count uint32) error
+	ReserveRemove(_ context.Context, items map[domain.StockKey]uint32) error
+	ReserveCancel(_ context.Context, items map[domain.StockKey]uint32) error
+	GetWarehouseStocks(_ context.Context, sku uint32) ([]domain.WarehouseStock, error)
+	GetBySKUs(_ context.Context, skus []uint32) (map[uint32]domain.StocksItem, error)
+	Create(_ context.Context, key domain.StockKey, totalCount uint32) error
+	Replenish(_ context.Context, key domain.StockKey, count uint32) (domain.StocksItem, error)
+	Adjust(_ context.Context, key domain.StockKey, delta int32, reason string) (domain.StocksItem, error)
+	Delete(_ context.Context, key domain.StockKey) error
+}
+
+// TxManager выполняет fn в единой транзакции для всех репозиториев.
+// Вложенный вызов откатывает только свои изменения.
+//
+//go:generate minimock -i github.com/vestamart/loms/internal/app/loms.TxManager -o ./mock/tx_manager_mock.go -n TxManagerMock -p mock
+type TxManager interface {
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
 }
 
 type Service struct {
 	ordersRepository OrdersRepository
 	stocksRepository StocksStorage
+	txManager        TxManager
+	strategy         ReservationStrategy
 }
 
-func NewService(ordersRepository OrdersRepository, stocksRepository StocksStorage) *Service {
-	return &Service{ordersRepository: ordersRepository, stocksRepository: stocksRepository}
+func NewService(ordersRepository OrdersRepository, stocksRepository StocksStorage, txManager TxManager, strategy ReservationStrategy) *Service {
+	return &Service{ordersRepository: ordersRepository, stocksRepository: stocksRepository, txManager: txManager, strategy: strategy}
 }
 
-func (s Service) OrderCreate(ctx context.Context, request *desc.OrderCreateRequest) (*desc.OrderCreateResponse, error) {
+func (s Service) OrderCreate(ctx context.Context, request *desc.OrderCreateRequest) (_ *desc.OrderCreateResponse, err error) {
+	ctx, span := tracing.Tracer().Start(ctx, "Service.OrderCreate")
+	defer func() { tracing.End(span, err) }()
+	ctx = logger.WithUserID(ctx, request.User)
+
 	items := make([]domain.Item, 0, len(request.Items))
 	for _, v := range request.Items {
 		items = append(items, domain.Item{
@@ -46,31 +74,53 @@ func (s Service) OrderCreate(ctx context.Context, request *desc.OrderCreateReque
 		})
 	}
 
-	orderId, err := s.ordersRepository.Create(ctx, request.User, &items)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create order: %w", err)
-	}
+	var orderId int64
+	var reserveErr error
+	err = s.txManager.WithinTransaction(ctx, func(ctx context.Context) (err error) {
+		// При нехватке стоков откатываются все резервы заказа, а сам заказ сохраняется со статусом failed
+		var reserved []domain.Item
+		reserveErr = s.txManager.WithinTransaction(ctx, func(ctx context.Context) (err error) {
+			reserved, err = s.reserve(ctx, items)
+			return err
+		})
 
-	for _, v := range items {
-		err = s.stocksRepository.Reserve(ctx, v.Sku, v.Count)
-		if err != nil {
-			if errors.Is(err, localErr.ItemNotEnoughErr) {
-				if err = s.ordersRepository.SetStatus(ctx, orderId, domain.Failed); err != nil {
-					return nil, fmt.Errorf("failed to set status: %w", err)
-				}
-				return nil, fmt.Errorf("failed to reserve item: %w", err)
+		status, reason := domain.AwaitingPayment, "stocks reserved"
+		orderItems := reserved
+		if reserveErr != nil {
+			if !errors.Is(reserveErr, localErr.ItemNotEnoughErr) && !errors.Is(reserveErr, localErr.SKUNotExistErr) {
+				return reserveErr
 			}
-			return nil, fmt.Errorf("failed to reserve item: %w", err)
+			status, reason = domain.Failed, reserveErr.Error()
+			orderItems = items
 		}
+
+		orderId, err = s.ordersRepository.Create(ctx, request.User, &orderItems)
+		if err != nil {
+			return fmt.Errorf("failed to create order: %w", err)
+		}
+		ctx = logger.WithOrderID(ctx, orderId)
+
+		return s.changeStatus(ctx, orderId, domain.New, status, reason)
+	})
+	if err != nil {
+		return nil, err
 	}
-	if err = s.ordersRepository.SetStatus(ctx, orderId, domain.AwaitingPayment); err != nil {
-		return nil, fmt.Errorf("failed to set status: %w", err)
+
+	ctx = logger.WithOrderID(ctx, orderId)
+	if reserveErr != nil {
+		slog.WarnContext(ctx, "order failed: stocks not reserved", "error", reserveErr)
+		return nil, reserveErr
 	}
+	slog.InfoContext(ctx, "order created", "items", len(items))
 
 	return &desc.OrderCreateResponse{OrderId: orderId}, nil
 }
 
-func (s Service) OrderInfo(ctx context.Context, request *desc.OrderInfoRequest) (*desc.OrderInfoResponse, error) {
+func (s Service) OrderInfo(ctx context.Context, request *desc.OrderInfoRequest) (_ *desc.OrderInfoResponse, err error) {
+	ctx, span := tracing.Tracer().Start(ctx, "Service.OrderInfo")
+	defer func() { tracing.End(span, err) }()
+	ctx = logger.WithOrderID(ctx, request.OrderId)
+
 	rawResponse, err := s.ordersRepository.GetByID(ctx, request.OrderId)
 	if err != nil {
 		if errors.Is(err, localErr.OrderNotFoundErr) {
@@ -78,76 +128,446 @@ func (s Service) OrderInfo(ctx context.Context, request *desc.OrderInfoRequest)
 		}
 		return nil, err
 	}
-	items := make([]*desc.Item, 0, len(rawResponse.Items))
+	reservations := make([]*desc.Reservation, 0, len(rawResponse.Items))
 	for _, v := range rawResponse.Items {
-		items = append(items, &desc.Item{
-			Sku:   v.Sku,
-			Count: v.Count,
+		if v.WarehouseID == 0 {
+			continue
+		}
+		reservations = append(reservations, &desc.Reservation{
+			Sku:         v.Sku,
+			WarehouseId: v.WarehouseID,
+			Count:       v.Count,
 		})
 	}
 
 	response := &desc.OrderInfoResponse{
-		Status: desc.OrderStatus(rawResponse.Status),
-		User:   rawResponse.UserID,
-		Items:  items,
+		Status:       desc.OrderStatus(rawResponse.Status),
+		User:         rawResponse.UserID,
+		Items:        itemsToDesc(rawResponse.Items),
+		Reservations: reservations,
 	}
 
 	return response, nil
 }
 
-func (s Service) OrderPay(ctx context.Context, request *desc.OrderPayRequest) (*desc.OrderPayResponse, error) {
-	getByID, err := s.ordersRepository.GetByID(ctx, request.OrderID)
-	if err != nil {
-		if errors.Is(err, localErr.OrderNotFoundErr) {
-			return nil, fmt.Errorf("failed to get order %w", err)
+const (
+	defaultPageSize = 50
+	maxPageSize     = 500
+)
+
+func (s Service) ListOrders(ctx context.Context, request *desc.ListOrdersRequest) (_ *desc.ListOrdersResponse, err error) {
+	ctx, span := tracing.Tracer().Start(ctx, "Service.ListOrders")
+	defer func() { tracing.End(span, err) }()
+	ctx = logger.WithUserID(ctx, request.User)
+
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = defaultPageSize
+	}
+	pageSize = min(pageSize, maxPageSize)
+
+	filter := domain.OrderFilter{
+		UserID: request.User,
+		// Запрашиваем на один заказ больше, чтобы понять, есть ли следующая страница
+		Limit: int32(pageSize) + 1,
+	}
+	for _, v := range request.Statuses {
+		filter.Statuses = append(filter.Statuses, domain.OrderStatus(v))
+	}
+	if request.CreatedFrom != nil {
+		filter.CreatedFrom = request.CreatedFrom.AsTime()
+	}
+	if request.CreatedTo != nil {
+		filter.CreatedTo = request.CreatedTo.AsTime()
+	}
+	if request.PageToken != "" {
+		cursor, err := decodePageToken(request.PageToken)
+		if err != nil {
+			return nil, err
 		}
-		return nil, fmt.Errorf("failed to get order %w", err)
+		filter.After = cursor
+	}
+
+	orders, err := s.ordersRepository.List(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list orders: %w", err)
 	}
 
-	items := make(map[uint32]uint32)
-	for _, v := range getByID.Items {
-		items[v.Sku] = v.Count
+	response := &desc.ListOrdersResponse{}
+	if len(orders) > int(pageSize) {
+		orders = orders[:pageSize]
+		last := orders[len(orders)-1]
+		response.NextPageToken = encodePageToken(domain.OrderCursor{CreatedAt: last.CreatedAt, ID: last.ID})
 	}
 
-	if err = s.stocksRepository.ReserveRemove(ctx, items); err != nil {
-		return nil, fmt.Errorf("failed to reserve remove item: %w", err)
+	response.Orders = make([]*desc.Order, 0, len(orders))
+	for _, v := range orders {
+		response.Orders = append(response.Orders, &desc.Order{
+			OrderId:   v.ID,
+			Status:    desc.OrderStatus(v.Status),
+			User:      v.UserID,
+			Items:     itemsToDesc(v.Items),
+			CreatedAt: timestamppb.New(v.CreatedAt),
+			UpdatedAt: timestamppb.New(v.UpdatedAt),
+		})
 	}
 
-	err = s.ordersRepository.SetStatus(ctx, request.OrderID, domain.Payed)
+	return response, nil
+}
+
+func (s Service) OrderPay(ctx context.Context, request *desc.OrderPayRequest) (_ *desc.OrderPayResponse, err error) {
+	ctx, span := tracing.Tracer().Start(ctx, "Service.OrderPay")
+	defer func() { tracing.End(span, err) }()
+	ctx = logger.WithOrderID(ctx, request.OrderID)
+
+	err = s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		getByID, err := s.ordersRepository.GetByID(ctx, request.OrderID)
+		if err != nil {
+			return fmt.Errorf("failed to get order %w", err)
+		}
+		if !getByID.Status.CanTransitionTo(domain.Payed) {
+			return fmt.Errorf("failed to pay order in status %s: %w", getByID.Status, localErr.InvalidStatusTransitionErr)
+		}
+
+		if err = s.stocksRepository.ReserveRemove(ctx, reservedStocks(getByID.Items)); err != nil {
+			return fmt.Errorf("failed to reserve remove item: %w", err)
+		}
+
+		return s.changeStatus(ctx, request.OrderID, getByID.Status, domain.Payed, "paid by user")
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to set status: %w", err)
+		return nil, err
 	}
+	slog.InfoContext(ctx, "order paid")
+
 	return &desc.OrderPayResponse{}, nil
 }
 
-func (s Service) OrderCancel(ctx context.Context, request *desc.OrderCancelRequest) (*desc.OrderCancelResponse, error) {
-	getByID, err := s.ordersRepository.GetByID(ctx, request.OrderID)
+func (s Service) OrderCancel(ctx context.Context, request *desc.OrderCancelRequest) (_ *desc.OrderCancelResponse, err error) {
+	ctx, span := tracing.Tracer().Start(ctx, "Service.OrderCancel")
+	defer func() { tracing.End(span, err) }()
+
+	if err := s.cancel(ctx, request.OrderID, "cancelled by user"); err != nil {
+		return nil, err
+	}
+	return &desc.OrderCancelResponse{}, nil
+}
+
+// CancelExpired отменяет до limit заказов, ожидающих оплаты с момента before, кроме заказов из skip.
+// Возвращает отмененные заказы и заказы, которые отменить не удалось; ошибка отмены одного заказа
+// не мешает отменить остальные.
+func (s Service) CancelExpired(ctx context.Context, before time.Time, skip []int64, limit int32) (cancelled, failed []int64, err error) {
+	ctx, span := tracing.Tracer().Start(ctx, "Service.CancelExpired")
+	defer func() { tracing.End(span, err) }()
+
+	var errs []error
+	err = s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		ids, err := s.ordersRepository.ListExpired(ctx, domain.AwaitingPayment, before, skip, limit)
+		if err != nil {
+			return fmt.Errorf("failed to list expired orders: %w", err)
+		}
+
+		for _, id := range ids {
+			if err = s.cancel(ctx, id, "payment timeout expired"); err != nil {
+				errs = append(errs, fmt.Errorf("order %d: %w", id, err))
+				failed = append(failed, id)
+				continue
+			}
+			cancelled = append(cancelled, id)
+		}
+		return nil
+	})
 	if err != nil {
-		if errors.Is(err, localErr.OrderNotFoundErr) {
-			return nil, fmt.Errorf("failed to get order %w", err)
+		return nil, nil, err
+	}
+
+	return cancelled, failed, errors.Join(errs...)
+}
+
+func (s Service) cancel(ctx context.Context, orderID int64, reason string) error {
+	ctx = logger.WithOrderID(ctx, orderID)
+	err := s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		getByID, err := s.ordersRepository.GetByID(ctx, orderID)
+		if err != nil {
+			return fmt.Errorf("failed to get order %w", err)
 		}
-		return nil, fmt.Errorf("failed to get order %w", err)
+		if !getByID.Status.CanTransitionTo(domain.Cancelled) {
+			return fmt.Errorf("failed to cancel order in status %s: %w", getByID.Status, localErr.InvalidStatusTransitionErr)
+		}
+
+		if err = s.stocksRepository.ReserveCancel(ctx, reservedStocks(getByID.Items)); err != nil {
+			return fmt.Errorf("failed to reserve cancel item: %w", err)
+		}
+
+		return s.changeStatus(ctx, orderID, getByID.Status, domain.Cancelled, reason)
+	})
+	if err != nil {
+		return err
 	}
+	slog.InfoContext(ctx, "order cancelled", "reason", reason)
 
-	items := make(map[uint32]uint32)
-	for _, v := range getByID.Items {
-		items[v.Sku] = v.Count
+	return nil
+}
+
+func (s Service) OrderHistory(ctx context.Context, request *desc.OrderHistoryRequest) (_ *desc.OrderHistoryResponse, err error) {
+	ctx, span := tracing.Tracer().Start(ctx, "Service.OrderHistory")
+	defer func() { tracing.End(span, err) }()
+	ctx = logger.WithOrderID(ctx, request.OrderId)
+
+	history, err := s.ordersRepository.History(ctx, request.OrderId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get order history %w", err)
+	}
+	// Созданный заказ сразу меняет статус, поэтому пустая история означает, что заказа нет
+	if len(history) == 0 {
+		return nil, fmt.Errorf("failed to get order history %w", localErr.OrderNotFoundErr)
 	}
 
-	if err = s.stocksRepository.ReserveCancel(ctx, items); err != nil {
-		return nil, fmt.Errorf("failed to reserve remove item: %w", err)
+	response := &desc.OrderHistoryResponse{Changes: make([]*desc.StatusChange, 0, len(history))}
+	for _, v := range history {
+		response.Changes = append(response.Changes, &desc.StatusChange{
+			OldStatus: desc.OrderStatus(v.From),
+			NewStatus: desc.OrderStatus(v.To),
+			Reason:    v.Reason,
+			ChangedAt: timestamppb.New(v.ChangedAt),
+		})
+	}
+
+	return response, nil
+}
+
+func (s Service) StocksInfo(ctx context.Context, request *desc.StocksInfoRequest) (_ *desc.StocksInfoResponse, err error) {
+	ctx, span := tracing.Tracer().Start(ctx, "Service.StocksInfo")
+	defer func() { tracing.End(span, err) }()
+
+	stocks, err := s.stocksRepository.GetWarehouseStocks(ctx, request.Sku)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get stocks %w", err)
 	}
 
-	if err = s.ordersRepository.SetStatus(ctx, request.OrderID, domain.Cancelled); err != nil {
+	response := &desc.StocksInfoResponse{Warehouses: make([]*desc.WarehouseStock, 0, len(stocks))}
+	for _, v := range stocks {
+		if request.WarehouseId != 0 && v.WarehouseID != request.WarehouseId {
+			continue
+		}
+
+		response.Count += uint64(v.Available())
+		response.Warehouses = append(response.Warehouses, &desc.WarehouseStock{
+			WarehouseId: v.WarehouseID,
+			Count:       uint64(v.Available()),
+			TotalCount:  uint64(v.TotalCount),
+			Reserved:    uint64(v.Reserved),
+		})
 	}
-	return &desc.OrderCancelResponse{}, nil
+	if len(response.Warehouses) == 0 {
+		return nil, fmt.Errorf("failed to get stocks %w", localErr.SKUNotExistErr)
+	}
+
+	return response, nil
 }
 
-func (s Service) StocksInfo(ctx context.Context, request *desc.StocksInfoRequest) (*desc.StocksInfoResponse, error) {
-	total, reserved, err := s.stocksRepository.GetBySKU(ctx, request.Sku)
+func (s Service) StocksInfoBatch(ctx context.Context, request *desc.StocksInfoBatchRequest) (_ *desc.StocksInfoBatchResponse, err error) {
+	ctx, span := tracing.Tracer().Start(ctx, "Service.StocksInfoBatch")
+	defer func() { tracing.End(span, err) }()
+
+	// Повторяющиеся sku запрашиваем один раз, в ответе они повторяются в порядке запроса
+	skus := slices.Compact(slices.Sorted(slices.Values(request.Skus)))
+	stocks, err := s.stocksRepository.GetBySKUs(ctx, skus)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get stocks %w", err)
 	}
 
-	return &desc.StocksInfoResponse{Count: uint64(total - reserved)}, nil
+	response := &desc.StocksInfoBatchResponse{Stocks: make([]*desc.StockInfo, 0, len(request.Skus))}
+	for _, sku := range request.Skus {
+		v, ok := stocks[sku]
+		if !ok {
+			response.Stocks = append(response.Stocks, &desc.StockInfo{Sku: sku})
+			continue
+		}
+
+		response.Stocks = append(response.Stocks, &desc.StockInfo{
+			Sku:        sku,
+			Found:      true,
+			Count:      uint64(v.Available()),
+			TotalCount: uint64(v.TotalCount),
+			Reserved:   uint64(v.Reserved),
+		})
+	}
+
+	return response, nil
+}
+
+// StockCreate заводит сток. Методы администрирования стоков меняют их в транзакции, как и остальные
+// записи сервиса, чтобы откат параллельной транзакции in-memory не затирал изменение
+func (s Service) StockCreate(ctx context.Context, request *desc.StockCreateRequest) (_ *desc.StockCreateResponse, err error) {
+	ctx, span := tracing.Tracer().Start(ctx, "Service.StockCreate")
+	defer func() { tracing.End(span, err) }()
+
+	key := stockKey(request.Sku, request.WarehouseId)
+	err = s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		return s.stocksRepository.Create(ctx, key, request.TotalCount)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create stock: %w", err)
+	}
+	slog.InfoContext(ctx, "stock created", "sku", key.Sku, "warehouse_id", key.WarehouseID, "total_count", request.TotalCount)
+
+	return &desc.StockCreateResponse{
+		Stock: warehouseStockToDesc(key, domain.StocksItem{TotalCount: request.TotalCount}),
+	}, nil
+}
+
+func (s Service) StockReplenish(ctx context.Context, request *desc.StockReplenishRequest) (_ *desc.StockReplenishResponse, err error) {
+	ctx, span := tracing.Tracer().Start(ctx, "Service.StockReplenish")
+	defer func() { tracing.End(span, err) }()
+
+	key := stockKey(request.Sku, request.WarehouseId)
+	var stock domain.StocksItem
+	err = s.txManager.WithinTransaction(ctx, func(ctx context.Context) (err error) {
+		stock, err = s.stocksRepository.Replenish(ctx, key, request.Count)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to replenish stock: %w", err)
+	}
+	slog.InfoContext(ctx, "stock replenished", "sku", key.Sku, "warehouse_id", key.WarehouseID, "count", request.Count)
+
+	return &desc.StockReplenishResponse{Stock: warehouseStockToDesc(key, stock)}, nil
+}
+
+func (s Service) StockAdjust(ctx context.Context, request *desc.StockAdjustRequest) (_ *desc.StockAdjustResponse, err error) {
+	ctx, span := tracing.Tracer().Start(ctx, "Service.StockAdjust")
+	defer func() { tracing.End(span, err) }()
+
+	key := stockKey(request.Sku, request.WarehouseId)
+	var stock domain.StocksItem
+	err = s.txManager.WithinTransaction(ctx, func(ctx context.Context) (err error) {
+		stock, err = s.stocksRepository.Adjust(ctx, key, request.Delta, request.Reason)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to adjust stock: %w", err)
+	}
+	slog.InfoContext(ctx, "stock adjusted", "sku", key.Sku, "warehouse_id", key.WarehouseID, "delta", request.Delta, "reason", request.Reason)
+
+	return &desc.StockAdjustResponse{Stock: warehouseStockToDesc(key, stock)}, nil
+}
+
+func (s Service) StockDelete(ctx context.Context, request *desc.StockDeleteRequest) (_ *desc.StockDeleteResponse, err error) {
+	ctx, span := tracing.Tracer().Start(ctx, "Service.StockDelete")
+	defer func() { tracing.End(span, err) }()
+
+	key := stockKey(request.Sku, request.WarehouseId)
+	err = s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		return s.stocksRepository.Delete(ctx, key)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete stock: %w", err)
+	}
+	slog.InfoContext(ctx, "stock deleted", "sku", key.Sku, "warehouse_id", key.WarehouseID)
+
+	return &desc.StockDeleteResponse{}, nil
+}
+
+// changeStatus переводит заказ из статуса from в to. Если статус заказа уже изменился
+// в другой транзакции, репозиторий вернет localErr.InvalidStatusTransitionErr.
+func (s Service) changeStatus(ctx context.Context, orderID int64, from, to domain.OrderStatus, reason string) error {
+	if !from.CanTransitionTo(to) {
+		return fmt.Errorf("failed to change status %s -> %s: %w", from, to, localErr.InvalidStatusTransitionErr)
+	}
+
+	if err := s.ordersRepository.SetStatus(ctx, orderID, from, to, reason); err != nil {
+		return fmt.Errorf("failed to set status: %w", err)
+	}
+	return nil
+}
+
+// reserve распределяет товары по складам согласно стратегии резервирования и резервирует их.
+// Sku резервируются по возрастанию, чтобы параллельные заказы блокировали строки стоков в одном
+// порядке и не попадали в deadlock. Возвращает позиции заказа с указанием складов в порядке items.
+func (s Service) reserve(ctx context.Context, items []domain.Item) (_ []domain.Item, err error) {
+	ctx, span := tracing.Tracer().Start(ctx, "Service.reserve")
+	defer func() { tracing.End(span, err) }()
+
+	skus := make([]uint32, 0, len(items))
+	counts := make(map[uint32]uint32, len(items))
+	for _, v := range items {
+		if _, ok := counts[v.Sku]; !ok {
+			skus = append(skus, v.Sku)
+		}
+		counts[v.Sku] += v.Count
+	}
+
+	allocations := make(map[uint32][]domain.Item, len(skus))
+	for _, sku := range slices.Sorted(maps.Keys(counts)) {
+		stocks, err := s.stocksRepository.GetWarehouseStocks(ctx, sku)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get stocks: %w", err)
+		}
+
+		allocation, err := s.strategy.allocate(sku, counts[sku], stocks)
+		if err != nil {
+			return nil, fmt.Errorf("failed to reserve sku %d: %w", sku, err)
+		}
+
+		for _, v := range allocation {
+			if err = s.stocksRepository.Reserve(ctx, domain.StockKey{WarehouseID: v.WarehouseID, Sku: v.Sku}, v.Count); err != nil {
+				return nil, fmt.Errorf("failed to reserve item: %w", err)
+			}
+		}
+		allocations[sku] = allocation
+	}
+
+	reserved := make([]domain.Item, 0, len(skus))
+	for _, sku := range skus {
+		reserved = append(reserved, allocations[sku]...)
+	}
+
+	return reserved, nil
+}
+
+// reservedStocks возвращает зарезервированное под позиции количество по складам
+func reservedStocks(items []domain.Item) map[domain.StockKey]uint32 {
+	result := make(map[domain.StockKey]uint32, len(items))
+	for _, v := range items {
+		if v.WarehouseID == 0 {
+			continue
+		}
+		result[domain.StockKey{WarehouseID: v.WarehouseID, Sku: v.Sku}] += v.Count
+	}
+	return result
+}
+
+// itemsToDesc суммирует позиции заказа по sku независимо от склада
+func itemsToDesc(items []domain.Item) []*desc.Item {
+	result := make([]*desc.Item, 0, len(items))
+	bySKU := make(map[uint32]*desc.Item, len(items))
+	for _, v := range items {
+		if item, ok := bySKU[v.Sku]; ok {
+			item.Count += v.Count
+			continue
+		}
+
+		item := &desc.Item{Sku: v.Sku, Count: v.Count}
+		bySKU[v.Sku] = item
+		result = append(result, item)
+	}
+	return result
+}
+
+// stockKey возвращает ключ стока, склад 0 означает основной склад
+func stockKey(sku, warehouseID uint32) domain.StockKey {
+	if warehouseID == 0 {
+		warehouseID = domain.DefaultWarehouseID
+	}
+	return domain.StockKey{WarehouseID: warehouseID, Sku: sku}
+}
+
+func warehouseStockToDesc(key domain.StockKey, stock domain.StocksItem) *desc.WarehouseStock {
+	return &desc.WarehouseStock{
+		WarehouseId: key.WarehouseID,
+		Count:       uint64(stock.Available()),
+		TotalCount:  uint64(stock.TotalCount),
+		Reserved:    uint64(stock.Reserved),
+	}
 }
@@ -1,290 +1,309 @@
 package loms_test
 
-//
-//import (
-//	"context"
-//	"errors"
-//	"github.com/vestamart/loms/internal/app/loms"
-//	mock2 "github.com/vestamart/loms/internal/app/loms/mock"
-//	"github.com/vestamart/loms/internal/domain"
-//	"github.com/vestamart/loms/internal/repository"
-//	desc "github.com/vestamart/loms/pkg/api/loms/v1"
-//	"google.golang.org/grpc/codes"
-//	"google.golang.org/grpc/status"
-//	"testing"
-//
-//	"github.com/gojuno/minimock/v3"
-//	"github.com/stretchr/testify/assert"
-//)
-//
-//func TestOrderCreate(t *testing.T) {
-//	mc := minimock.NewController(t)
-//
-//	ordersRepoMock := mock2.NewOrdersRepositoryMock(mc)
-//	stocksRepoMock := mock2.NewStocksStorageMock(mc)
-//
-//	svc := loms.NewServer(ordersRepoMock, stocksRepoMock)
-//
-//	tests := []struct {
-//		name    string
-//		request *desc.OrderCreateRequest
-//		setup   func()
-//		expect  codes.Code
-//	}{
-//		{
-//			name:    "invalid request",
-//			request: &desc.OrderCreateRequest{},
-//			expect:  codes.InvalidArgument,
-//		},
-//		{
-//			name: "success",
-//			request: &desc.OrderCreateRequest{
-//				User:  1,
-//				Items: []*desc.Item{{Sku: 1, Count: 2}},
-//			},
-//			setup: func() {
-//				ordersRepoMock.CreateMock.Return(1, nil)
-//				stocksRepoMock.ReserveMock.Return(nil)
-//				ordersRepoMock.SetStatusMock.Return(nil)
-//			},
-//			expect: codes.OK,
-//		},
-//	}
-//
-//	for _, tt := range tests {
-//		t.Run(tt.name, func(t *testing.T) {
-//			if tt.setup != nil {
-//				tt.setup()
-//			}
-//			_, err := svc.OrderCreate(context.Background(), tt.request)
-//			assert.Equal(t, tt.expect, status.Code(err))
-//		})
-//	}
-//}
-//
-//func TestOrderInfo(t *testing.T) {
-//	mc := minimock.NewController(t)
-//
-//	ordersRepoMock := mock2.NewOrdersRepositoryMock(mc)
-//	svc := loms.NewServer(ordersRepoMock, nil)
-//
-//	tests := []struct {
-//		name    string
-//		request *desc.OrderInfoRequest
-//		setup   func()
-//		expect  codes.Code
-//	}{
-//		{
-//			name:    "order not found",
-//			request: &desc.OrderInfoRequest{OrderId: 1},
-//			setup: func() {
-//				ordersRepoMock.GetByIDMock.Return(nil, repository.OrderNotExistErr)
-//			},
-//			expect: codes.NotFound,
-//		},
-//		{
-//			name:    "success",
-//			request: &desc.OrderInfoRequest{OrderId: 1},
-//			setup: func() {
-//				ordersRepoMock.GetByIDMock.Return(&domain.Order{
-//					UserID: 1,
-//					Status: 1,
-//					Items:  []domain.Item{{Sku: 1, Count: 2}},
-//				}, nil)
-//			},
-//			expect: codes.OK,
-//		},
-//	}
-//
-//	for _, tt := range tests {
-//		t.Run(tt.name, func(t *testing.T) {
-//			if tt.setup != nil {
-//				tt.setup()
-//			}
-//			_, err := svc.OrderInfo(context.Background(), tt.request)
-//			assert.Equal(t, tt.expect, status.Code(err))
-//		})
-//	}
-//}
-//
-//func TestOrderPay(t *testing.T) {
-//	mc := minimock.NewController(t)
-//
-//	ordersRepoMock := mock2.NewOrdersRepositoryMock(mc)
-//	stocksRepoMock := mock2.NewStocksStorageMock(mc)
-//	svc := loms.NewServer(ordersRepoMock, stocksRepoMock)
-//
-//	tests := []struct {
-//		name    string
-//		request *desc.OrderPayRequest
-//		setup   func()
-//		expect  codes.Code
-//	}{
-//		{
-//			name:    "order not found",
-//			request: &desc.OrderPayRequest{OrderID: 1},
-//			setup: func() {
-//				ordersRepoMock.GetByIDMock.Return(nil, repository.OrderNotExistErr)
-//			},
-//			expect: codes.NotFound,
-//		},
-//		{
-//			name:    "reserve remove error",
-//			request: &desc.OrderPayRequest{OrderID: 1},
-//			setup: func() {
-//				ordersRepoMock.GetByIDMock.Return(&domain.Order{
-//					Items: []domain.Item{{Sku: 1, Count: 2}},
-//				}, nil)
-//				stocksRepoMock.ReserveRemoveMock.Return(errors.New("reserve remove error"))
-//			},
-//			expect: codes.Internal,
-//		},
-//		{
-//			name:    "set status error",
-//			request: &desc.OrderPayRequest{OrderID: 1},
-//			setup: func() {
-//				ordersRepoMock.GetByIDMock.Return(&domain.Order{
-//					Items: []domain.Item{{Sku: 1, Count: 2}},
-//				}, nil)
-//				stocksRepoMock.ReserveRemoveMock.Return(nil)
-//				ordersRepoMock.SetStatusMock.Return(errors.New("set status error"))
-//			},
-//			expect: codes.Internal,
-//		},
-//		{
-//			name:    "success",
-//			request: &desc.OrderPayRequest{OrderID: 1},
-//			setup: func() {
-//				ordersRepoMock.GetByIDMock.Return(&domain.Order{
-//					Items: []domain.Item{{Sku: 1, Count: 2}},
-//				}, nil)
-//				stocksRepoMock.ReserveRemoveMock.Return(nil)
-//				ordersRepoMock.SetStatusMock.Return(nil)
-//			},
-//			expect: codes.OK,
-//		},
-//	}
-//
-//	for _, tt := range tests {
-//		t.Run(tt.name, func(t *testing.T) {
-//			if tt.setup != nil {
-//				tt.setup()
-//			}
-//			_, err := svc.OrderPay(context.Background(), tt.request)
-//			assert.Equal(t, tt.expect, status.Code(err))
-//		})
-//	}
-//}
-//
-//func TestOrderCancel(t *testing.T) {
-//	mc := minimock.NewController(t)
-//
-//	ordersRepoMock := mock2.NewOrdersRepositoryMock(mc)
-//	stocksRepoMock := mock2.NewStocksStorageMock(mc)
-//	svc := loms.NewServer(ordersRepoMock, stocksRepoMock)
-//
-//	tests := []struct {
-//		name    string
-//		request *desc.OrderCancelRequest
-//		setup   func()
-//		expect  codes.Code
-//	}{
-//		{
-//			name:    "order not found",
-//			request: &desc.OrderCancelRequest{OrderID: 1},
-//			setup: func() {
-//				ordersRepoMock.GetByIDMock.Return(nil, repository.OrderNotExistErr)
-//			},
-//			expect: codes.NotFound,
-//		},
-//		{
-//			name:    "reserve cancel error",
-//			request: &desc.OrderCancelRequest{OrderID: 1},
-//			setup: func() {
-//				ordersRepoMock.GetByIDMock.Return(&domain.Order{
-//					Items: []domain.Item{{Sku: 1, Count: 2}},
-//				}, nil)
-//				stocksRepoMock.ReserveCancelMock.Return(errors.New("reserve cancel error"))
-//			},
-//			expect: codes.Internal,
-//		},
-//		{
-//			name:    "success (ignoring set status error)",
-//			request: &desc.OrderCancelRequest{OrderID: 1},
-//			setup: func() {
-//				ordersRepoMock.GetByIDMock.Return(&domain.Order{
-//					Items: []domain.Item{{Sku: 1, Count: 2}},
-//				}, nil)
-//				stocksRepoMock.ReserveCancelMock.Return(nil)
-//				ordersRepoMock.SetStatusMock.Return(errors.New("set status error"))
-//			},
-//			expect: codes.OK,
-//		},
-//		{
-//			name:    "success",
-//			request: &desc.OrderCancelRequest{OrderID: 1},
-//			setup: func() {
-//				ordersRepoMock.GetByIDMock.Return(&domain.Order{
-//					Items: []domain.Item{{Sku: 1, Count: 2}},
-//				}, nil)
-//				stocksRepoMock.ReserveCancelMock.Return(nil)
-//				ordersRepoMock.SetStatusMock.Return(nil)
-//			},
-//			expect: codes.OK,
-//		},
-//	}
-//
-//	for _, tt := range tests {
-//		t.Run(tt.name, func(t *testing.T) {
-//			if tt.setup != nil {
-//				tt.setup()
-//			}
-//			_, err := svc.OrderCancel(context.Background(), tt.request)
-//			assert.Equal(t, tt.expect, status.Code(err))
-//		})
-//	}
-//}
-//
-//func TestStocksInfo(t *testing.T) {
-//	mc := minimock.NewController(t)
-//	defer mc.Finish()
-//
-//	stocksRepoMock := mock2.NewStocksStorageMock(mc)
-//	svc := loms.NewServer(nil, stocksRepoMock)
-//
-//	tests := []struct {
-//		name    string
-//		request *desc.StocksInfoRequest
-//		setup   func()
-//		expect  codes.Code
-//		count   uint64
-//	}{{
-//		name:    "error on get by SKU",
-//		request: &desc.StocksInfoRequest{Sku: 1},
-//		setup: func() {
-//			stocksRepoMock.GetBySKUMock.Return(uint32(0), errors.New("get error"))
-//		},
-//		expect: codes.NotFound,
-//	}, {
-//		name:    "success",
-//		request: &desc.StocksInfoRequest{Sku: 1},
-//		setup: func() {
-//			stocksRepoMock.GetBySKUMock.Return(10, nil)
-//		},
-//		expect: codes.OK,
-//		count:  10,
-//	}}
-//
-//	for _, tt := range tests {
-//		t.Run(tt.name, func(t *testing.T) {
-//			if tt.setup != nil {
-//				tt.setup()
-//			}
-//			resp, err := svc.StocksInfo(context.Background(), tt.request)
-//			assert.Equal(t, tt.expect, status.Code(err))
-//			if err == nil {
-//				assert.Equal(t, tt.count, resp.Count)
-//			}
-//		})
-//	}
-//}
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vestamart/loms/internal/app/loms"
+	"github.com/vestamart/loms/internal/app/loms/mock"
+	"github.com/vestamart/loms/internal/domain"
+	"github.com/vestamart/loms/internal/localErr"
+	desc "github.com/vestamart/loms/pkg/api/loms/v1"
+)
+
+var errStorage = errors.New("storage error")
+
+// newMockService создает сервис на моках репозиториев, транзакции выполняют fn напрямую
+func newMockService(t *testing.T) (*loms.Service, *mock.OrdersRepositoryMock, *mock.StocksStorageMock) {
+	t.Helper()
+
+	mc := minimock.NewController(t)
+	orders := mock.NewOrdersRepositoryMock(mc)
+	stocks := mock.NewStocksStorageMock(mc)
+	// Методы чтения работают без транзакции
+	txManager := mock.NewTxManagerMock(mc)
+	txManager.WithinTransactionMock.Optional().Set(func(ctx context.Context, fn func(ctx context.Context) error) error {
+		return fn(ctx)
+	})
+	return loms.NewService(orders, stocks, txManager, loms.NearestFirst), orders, stocks
+}
+
+func awaitingOrder() *domain.Order {
+	return &domain.Order{
+		ID:     1,
+		UserID: 1,
+		Status: domain.AwaitingPayment,
+		Items:  []domain.Item{{Sku: 1, Count: 2, WarehouseID: domain.DefaultWarehouseID}},
+	}
+}
+
+func TestOrderCreate(t *testing.T) {
+	warehouse := []domain.WarehouseStock{{WarehouseID: domain.DefaultWarehouseID, StocksItem: domain.StocksItem{TotalCount: 10}}}
+
+	tests := []struct {
+		name    string
+		setup   func(orders *mock.OrdersRepositoryMock, stocks *mock.StocksStorageMock)
+		wantErr error
+	}{
+		{
+			name: "success",
+			setup: func(orders *mock.OrdersRepositoryMock, stocks *mock.StocksStorageMock) {
+				stocks.GetWarehouseStocksMock.Return(warehouse, nil)
+				stocks.ReserveMock.Expect(minimock.AnyContext, domain.StockKey{WarehouseID: domain.DefaultWarehouseID, Sku: 1}, 2).Return(nil)
+				orders.CreateMock.Return(1, nil)
+				orders.SetStatusMock.Expect(minimock.AnyContext, 1, domain.New, domain.AwaitingPayment, "stocks reserved").Return(nil)
+			},
+		},
+		{
+			name: "item not enough saves failed order",
+			setup: func(orders *mock.OrdersRepositoryMock, stocks *mock.StocksStorageMock) {
+				stocks.GetWarehouseStocksMock.Return(warehouse, nil)
+				stocks.ReserveMock.Return(localErr.ItemNotEnoughErr)
+				orders.CreateMock.Return(1, nil)
+				orders.SetStatusMock.Set(func(_ context.Context, orderID int64, expected, status domain.OrderStatus, _ string) error {
+					assert.Equal(t, domain.Failed, status)
+					return nil
+				})
+			},
+			wantErr: localErr.ItemNotEnoughErr,
+		},
+		{
+			name: "storage error",
+			setup: func(orders *mock.OrdersRepositoryMock, stocks *mock.StocksStorageMock) {
+				stocks.GetWarehouseStocksMock.Return(nil, errStorage)
+			},
+			wantErr: errStorage,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, orders, stocks := newMockService(t)
+			tt.setup(orders, stocks)
+
+			resp, err := svc.OrderCreate(context.Background(), &desc.OrderCreateRequest{User: 1, Items: []*desc.Item{{Sku: 1, Count: 2}}})
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.EqualValues(t, 1, resp.OrderId)
+		})
+	}
+}
+
+func TestOrderInfo(t *testing.T) {
+	tests := []struct {
+		name    string
+		setup   func(orders *mock.OrdersRepositoryMock)
+		wantErr error
+	}{
+		{
+			name: "order not found",
+			setup: func(orders *mock.OrdersRepositoryMock) {
+				orders.GetByIDMock.Return(nil, localErr.OrderNotFoundErr)
+			},
+			wantErr: localErr.OrderNotFoundErr,
+		},
+		{
+			name: "success",
+			setup: func(orders *mock.OrdersRepositoryMock) {
+				orders.GetByIDMock.Expect(minimock.AnyContext, 1).Return(awaitingOrder(), nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, orders, _ := newMockService(t)
+			tt.setup(orders)
+
+			resp, err := svc.OrderInfo(context.Background(), &desc.OrderInfoRequest{OrderId: 1})
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, desc.OrderStatus_AWAITING_PAYMENT, resp.Status)
+			assert.EqualValues(t, 1, resp.User)
+			require.Len(t, resp.Reservations, 1)
+			assert.EqualValues(t, domain.DefaultWarehouseID, resp.Reservations[0].WarehouseId)
+		})
+	}
+}
+
+func TestOrderPay(t *testing.T) {
+	reserved := map[domain.StockKey]uint32{{WarehouseID: domain.DefaultWarehouseID, Sku: 1}: 2}
+
+	tests := []struct {
+		name    string
+		setup   func(orders *mock.OrdersRepositoryMock, stocks *mock.StocksStorageMock)
+		wantErr error
+	}{
+		{
+			name: "order not found",
+			setup: func(orders *mock.OrdersRepositoryMock, stocks *mock.StocksStorageMock) {
+				orders.GetByIDMock.Return(nil, localErr.OrderNotFoundErr)
+			},
+			wantErr: localErr.OrderNotFoundErr,
+		},
+		{
+			name: "invalid status",
+			setup: func(orders *mock.OrdersRepositoryMock, stocks *mock.StocksStorageMock) {
+				order := awaitingOrder()
+				order.Status = domain.Cancelled
+				orders.GetByIDMock.Return(order, nil)
+			},
+			wantErr: localErr.InvalidStatusTransitionErr,
+		},
+		{
+			name: "reserve remove error",
+			setup: func(orders *mock.OrdersRepositoryMock, stocks *mock.StocksStorageMock) {
+				orders.GetByIDMock.Return(awaitingOrder(), nil)
+				stocks.ReserveRemoveMock.Return(errStorage)
+			},
+			wantErr: errStorage,
+		},
+		{
+			name: "set status error",
+			setup: func(orders *mock.OrdersRepositoryMock, stocks *mock.StocksStorageMock) {
+				orders.GetByIDMock.Return(awaitingOrder(), nil)
+				stocks.ReserveRemoveMock.Return(nil)
+				orders.SetStatusMock.Return(errStorage)
+			},
+			wantErr: errStorage,
+		},
+		{
+			name: "success",
+			setup: func(orders *mock.OrdersRepositoryMock, stocks *mock.StocksStorageMock) {
+				orders.GetByIDMock.Return(awaitingOrder(), nil)
+				stocks.ReserveRemoveMock.Expect(minimock.AnyContext, reserved).Return(nil)
+				orders.SetStatusMock.Expect(minimock.AnyContext, 1, domain.AwaitingPayment, domain.Payed, "paid by user").Return(nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, orders, stocks := newMockService(t)
+			tt.setup(orders, stocks)
+
+			_, err := svc.OrderPay(context.Background(), &desc.OrderPayRequest{OrderID: 1})
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestOrderCancel(t *testing.T) {
+	reserved := map[domain.StockKey]uint32{{WarehouseID: domain.DefaultWarehouseID, Sku: 1}: 2}
+
+	tests := []struct {
+		name    string
+		setup   func(orders *mock.OrdersRepositoryMock, stocks *mock.StocksStorageMock)
+		wantErr error
+	}{
+		{
+			name: "order not found",
+			setup: func(orders *mock.OrdersRepositoryMock, stocks *mock.StocksStorageMock) {
+				orders.GetByIDMock.Return(nil, localErr.OrderNotFoundErr)
+			},
+			wantErr: localErr.OrderNotFoundErr,
+		},
+		{
+			name: "reserve cancel error",
+			setup: func(orders *mock.OrdersRepositoryMock, stocks *mock.StocksStorageMock) {
+				orders.GetByIDMock.Return(awaitingOrder(), nil)
+				stocks.ReserveCancelMock.Return(errStorage)
+			},
+			wantErr: errStorage,
+		},
+		{
+			name: "set status error",
+			setup: func(orders *mock.OrdersRepositoryMock, stocks *mock.StocksStorageMock) {
+				orders.GetByIDMock.Return(awaitingOrder(), nil)
+				stocks.ReserveCancelMock.Return(nil)
+				orders.SetStatusMock.Return(errStorage)
+			},
+			wantErr: errStorage,
+		},
+		{
+			name: "success",
+			setup: func(orders *mock.OrdersRepositoryMock, stocks *mock.StocksStorageMock) {
+				orders.GetByIDMock.Return(awaitingOrder(), nil)
+				stocks.ReserveCancelMock.Expect(minimock.AnyContext, reserved).Return(nil)
+				orders.SetStatusMock.Expect(minimock.AnyContext, 1, domain.AwaitingPayment, domain.Cancelled, "cancelled by user").Return(nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, orders, stocks := newMockService(t)
+			tt.setup(orders, stocks)
+
+			_, err := svc.OrderCancel(context.Background(), &desc.OrderCancelRequest{OrderID: 1})
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestStocksInfo(t *testing.T) {
+	tests := []struct {
+		name    string
+		setup   func(stocks *mock.StocksStorageMock)
+		wantErr error
+		count   uint64
+	}{
+		{
+			name: "storage error",
+			setup: func(stocks *mock.StocksStorageMock) {
+				stocks.GetWarehouseStocksMock.Return(nil, errStorage)
+			},
+			wantErr: errStorage,
+		},
+		{
+			name: "sku not exist",
+			setup: func(stocks *mock.StocksStorageMock) {
+				stocks.GetWarehouseStocksMock.Return(nil, nil)
+			},
+			wantErr: localErr.SKUNotExistErr,
+		},
+		{
+			name: "success",
+			setup: func(stocks *mock.StocksStorageMock) {
+				stocks.GetWarehouseStocksMock.Expect(minimock.AnyContext, 1).Return([]domain.WarehouseStock{
+					{WarehouseID: 1, StocksItem: domain.StocksItem{TotalCount: 10, Reserved: 3}},
+					{WarehouseID: 2, StocksItem: domain.StocksItem{TotalCount: 5}},
+				}, nil)
+			},
+			count: 12,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, _, stocks := newMockService(t)
+			tt.setup(stocks)
+
+			resp, err := svc.StocksInfo(context.Background(), &desc.StocksInfoRequest{Sku: 1})
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.count, resp.Count)
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package loms_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vestamart/loms/internal/app/loms"
+	"github.com/vestamart/loms/internal/app/loms/mock"
+	"github.com/vestamart/loms/internal/domain"
+	"github.com/vestamart/loms/internal/localErr"
+	desc "github.com/vestamart/loms/pkg/api/loms/v1"
+)
+
+func newTxManager(mc *minimock.Controller) *mock.TxManagerMock {
+	return mock.NewTxManagerMock(mc).WithinTransactionMock.Set(func(ctx context.Context, fn func(ctx context.Context) error) error {
+		return fn(ctx)
+	})
+}
+
+func TestStockAdjust(t *testing.T) {
+	mc := minimock.NewController(t)
+	stocks := mock.NewStocksStorageMock(mc)
+	svc := loms.NewService(nil, stocks, newTxManager(mc), loms.NearestFirst)
+
+	stocks.AdjustMock.
+		Expect(minimock.AnyContext, domain.StockKey{WarehouseID: domain.DefaultWarehouseID, Sku: 1001}, -3, "damaged").
+		Return(domain.StocksItem{TotalCount: 7, Reserved: 2}, nil)
+
+	resp, err := svc.StockAdjust(context.Background(), &desc.StockAdjustRequest{Sku: 1001, Delta: -3, Reason: "damaged"})
+	require.NoError(t, err)
+	assert.Equal(t, &desc.WarehouseStock{WarehouseId: domain.DefaultWarehouseID, Count: 5, TotalCount: 7, Reserved: 2}, resp.Stock)
+}
+
+func TestStockAdminErrors(t *testing.T) {
+	mc := minimock.NewController(t)
+	stocks := mock.NewStocksStorageMock(mc)
+	svc := loms.NewService(nil, stocks, newTxManager(mc), loms.NearestFirst)
+
+	stocks.AdjustMock.Return(domain.StocksItem{}, localErr.StockBelowReservedErr)
+	_, err := svc.StockAdjust(context.Background(), &desc.StockAdjustRequest{Sku: 1001, WarehouseId: 2, Delta: -100, Reason: "inventory"})
+	assert.ErrorIs(t, err, localErr.StockBelowReservedErr)
+
+	stocks.DeleteMock.Expect(minimock.AnyContext, domain.StockKey{WarehouseID: 2, Sku: 1001}).Return(localErr.StockReservedErr)
+	_, err = svc.StockDelete(context.Background(), &desc.StockDeleteRequest{Sku: 1001, WarehouseId: 2})
+	assert.ErrorIs(t, err, localErr.StockReservedErr)
+
+	stocks.CreateMock.Return(localErr.StockAlreadyExistErr)
+	_, err = svc.StockCreate(context.Background(), &desc.StockCreateRequest{Sku: 1001, TotalCount: 10})
+	assert.ErrorIs(t, err, localErr.StockAlreadyExistErr)
+}
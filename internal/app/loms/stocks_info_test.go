@@ -0,0 +1,20 @@
+package loms_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	desc "github.com/vestamart/loms/pkg/api/loms/v1"
+)
+
+func TestStocksInfoBatchDuplicateSKUs(t *testing.T) {
+	svc, _, _ := newInMemoryService(t)
+
+	resp, err := svc.StocksInfoBatch(context.Background(), &desc.StocksInfoBatchRequest{Skus: []uint32{1002, 1, 1002}})
+	require.NoError(t, err)
+
+	stock := &desc.StockInfo{Sku: 1002, Found: true, Count: 180, TotalCount: 200, Reserved: 20}
+	assert.Equal(t, []*desc.StockInfo{stock, {Sku: 1}, stock}, resp.Stocks)
+}
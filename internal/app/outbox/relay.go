@@ -0,0 +1,156 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"github.com/vestamart/loms/internal/domain"
+)
+
+// Storage хранилище outbox, из которого relay забирает события.
+// FetchPending забирает события на lockTimeout, чтобы их не отправила другая реплика
+type Storage interface {
+	FetchPending(ctx context.Context, limit int32, lockTimeout time.Duration) ([]domain.OutboxMessage, error)
+	MarkSent(ctx context.Context, ids []int64) error
+	MarkFailed(ctx context.Context, id int64, reason string) error
+	// MarkDead исключает событие из отправки после исчерпания попыток
+	MarkDead(ctx context.Context, id int64, reason string) error
+	Release(ctx context.Context, ids []int64) error
+}
+
+// Producer отправляет сообщение в брокер с ключом key
+type Producer interface {
+	Send(ctx context.Context, key string, payload []byte) error
+}
+
+type TxManager interface {
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+type Config struct {
+	Interval   time.Duration
+	MaxBackoff time.Duration
+	BatchSize  int32
+	// LockTimeout время, на которое забирается пачка. Если relay упал, не отметив события,
+	// после него их отправит любая реплика
+	LockTimeout time.Duration
+	// MaxAttempts число неудачных отправок, после которого событие исключается из отправки
+	// и больше не задерживает следующие события
+	MaxAttempts int32
+}
+
+type failedMessage struct {
+	message domain.OutboxMessage
+	reason  string
+}
+
+// Relay периодически публикует неотправленные события outbox.
+// Доставка at-least-once: событие помечается отправленным только после подтверждения брокера.
+type Relay struct {
+	storage   Storage
+	producer  Producer
+	txManager TxManager
+	cfg       Config
+}
+
+func NewRelay(storage Storage, producer Producer, txManager TxManager, cfg Config) *Relay {
+	return &Relay{storage: storage, producer: producer, txManager: txManager, cfg: cfg}
+}
+
+// Run публикует события до отмены ctx. После ошибки интервал удваивается до MaxBackoff.
+func (r *Relay) Run(ctx context.Context) {
+	wait := r.cfg.Interval
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+		}
+
+		if _, err := r.Flush(ctx); err != nil {
+			wait = min(wait*2, r.cfg.MaxBackoff)
+			slog.ErrorContext(ctx, "outbox relay: flush", "error", err, "retry_in", wait)
+		} else {
+			wait = r.cfg.Interval
+		}
+		timer.Reset(wait)
+	}
+}
+
+// Flush отправляет одну пачку событий и возвращает количество отправленных.
+// На первой ошибке отправка останавливается, чтобы не нарушить порядок событий заказа. Событие,
+// исчерпавшее MaxAttempts, исключается из отправки, и relay переходит к следующим.
+// Брокер вызывается вне транзакции: медленный брокер не держит блокировки хранилища.
+func (r *Relay) Flush(ctx context.Context) (int, error) {
+	var messages []domain.OutboxMessage
+	err := r.txManager.WithinTransaction(ctx, func(ctx context.Context) (err error) {
+		messages, err = r.storage.FetchPending(ctx, r.cfg.BatchSize, r.cfg.LockTimeout)
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	sent := make([]int64, 0, len(messages))
+	var dead []failedMessage
+	var sendErr error
+	unsent := messages[:0:0]
+	for i, m := range messages {
+		err := r.producer.Send(ctx, strconv.FormatInt(m.OrderID, 10), m.Payload)
+		if err == nil {
+			sent = append(sent, m.ID)
+			continue
+		}
+		if m.Attempts+1 >= r.cfg.MaxAttempts {
+			slog.ErrorContext(ctx, "outbox relay: giving up on event",
+				"id", m.ID, "order_id", m.OrderID, "attempts", m.Attempts+1, "error", err)
+			dead = append(dead, failedMessage{message: m, reason: err.Error()})
+			continue
+		}
+		sendErr = err
+		unsent = messages[i:]
+		break
+	}
+
+	err = r.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if len(sent) > 0 {
+			if err := r.storage.MarkSent(ctx, sent); err != nil {
+				return err
+			}
+		}
+		for _, d := range dead {
+			if err := r.storage.MarkDead(ctx, d.message.ID, d.reason); err != nil {
+				return err
+			}
+		}
+		if sendErr == nil {
+			return nil
+		}
+
+		if err := r.storage.MarkFailed(ctx, unsent[0].ID, sendErr.Error()); err != nil {
+			return err
+		}
+		released := make([]int64, 0, len(unsent)-1)
+		for _, m := range unsent[1:] {
+			released = append(released, m.ID)
+		}
+		if len(released) == 0 {
+			return nil
+		}
+		return r.storage.Release(ctx, released)
+	})
+	if err != nil {
+		return 0, err
+	}
+	if sendErr != nil {
+		return len(sent), fmt.Errorf("failed to send event: %w", sendErr)
+	}
+
+	return len(sent), nil
+}
@@ -0,0 +1,238 @@
+package outbox_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vestamart/loms/internal/app/outbox"
+	"github.com/vestamart/loms/internal/domain"
+	"github.com/vestamart/loms/internal/repository"
+)
+
+type sentMessage struct {
+	key   string
+	event domain.OrderEvent
+}
+
+type fakeProducer struct {
+	mu       sync.Mutex
+	sent     []sentMessage
+	failNext int
+	// rejectKey сообщения с этим ключом брокер отклоняет всегда
+	rejectKey string
+}
+
+func (p *fakeProducer) Send(_ context.Context, key string, payload []byte) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.failNext > 0 {
+		p.failNext--
+		return errors.New("broker unavailable")
+	}
+	if key == p.rejectKey {
+		return errors.New("message rejected")
+	}
+
+	var event domain.OrderEvent
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return err
+	}
+	p.sent = append(p.sent, sentMessage{key: key, event: event})
+	return nil
+}
+
+func (p *fakeProducer) messages() []sentMessage {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]sentMessage(nil), p.sent...)
+}
+
+func newRelay(t *testing.T, producer outbox.Producer) (*outbox.Relay, *repository.InMemoryOutboxRepository) {
+	t.Helper()
+
+	storage := repository.NewInMemoryOutboxRepository()
+	txManager := repository.NewInMemoryTxManager()
+	relay := outbox.NewRelay(storage, producer, txManager, outbox.Config{
+		Interval:    time.Millisecond,
+		MaxBackoff:  10 * time.Millisecond,
+		BatchSize:   10,
+		LockTimeout: time.Minute,
+		MaxAttempts: 3,
+	})
+	return relay, storage
+}
+
+func TestRelayFlush(t *testing.T) {
+	producer := &fakeProducer{}
+	relay, storage := newRelay(t, producer)
+
+	require.NoError(t, storage.Add(context.Background(), domain.NewOrderEvent(1, domain.New, "")))
+	require.NoError(t, storage.Add(context.Background(), domain.NewOrderEvent(1, domain.AwaitingPayment, "")))
+	require.NoError(t, storage.Add(context.Background(), domain.NewOrderEvent(2, domain.New, "")))
+
+	sent, err := relay.Flush(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 3, sent)
+
+	messages := producer.messages()
+	require.Len(t, messages, 3)
+	assert.Equal(t, "1", messages[0].key)
+	assert.Equal(t, string(domain.OrderCreated), messages[0].event.EventType)
+	assert.Equal(t, "1", messages[1].key)
+	assert.Equal(t, string(domain.OrderAwaitingPayment), messages[1].event.EventType)
+	assert.Equal(t, "2", messages[2].key)
+	assert.Equal(t, int64(2), messages[2].event.OrderID)
+
+	sent, err = relay.Flush(context.Background())
+	require.NoError(t, err)
+	assert.Zero(t, sent, "sent events must not be published again")
+}
+
+func TestRelayFlushRetriesFailedEvents(t *testing.T) {
+	producer := &fakeProducer{failNext: 1}
+	relay, storage := newRelay(t, producer)
+
+	require.NoError(t, storage.Add(context.Background(), domain.NewOrderEvent(1, domain.New, "")))
+	require.NoError(t, storage.Add(context.Background(), domain.NewOrderEvent(1, domain.Cancelled, "")))
+
+	sent, err := relay.Flush(context.Background())
+	require.Error(t, err)
+	assert.Zero(t, sent)
+	assert.Empty(t, producer.messages())
+
+	// Нулевой lockTimeout не забирает события, relay увидит их снова
+	pending, err := storage.FetchPending(context.Background(), 10, 0)
+	require.NoError(t, err)
+	assert.Len(t, pending, 2)
+
+	sent, err = relay.Flush(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 2, sent)
+
+	messages := producer.messages()
+	require.Len(t, messages, 2)
+	assert.Equal(t, string(domain.OrderCreated), messages[0].event.EventType)
+	assert.Equal(t, string(domain.OrderCancelled), messages[1].event.EventType)
+}
+
+func TestRelaySkipsDeadEvents(t *testing.T) {
+	producer := &fakeProducer{rejectKey: "1"}
+	relay, storage := newRelay(t, producer)
+
+	require.NoError(t, storage.Add(context.Background(), domain.NewOrderEvent(1, domain.New, "")))
+	require.NoError(t, storage.Add(context.Background(), domain.NewOrderEvent(2, domain.New, "")))
+
+	// Пока попытки не исчерпаны, отклоненное событие задерживает следующие
+	for range 2 {
+		sent, err := relay.Flush(context.Background())
+		require.Error(t, err)
+		assert.Zero(t, sent)
+	}
+	assert.Empty(t, producer.messages())
+
+	sent, err := relay.Flush(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, sent)
+	messages := producer.messages()
+	require.Len(t, messages, 1)
+	assert.Equal(t, "2", messages[0].key)
+
+	// Исключенное событие больше не выбирается
+	pending, err := storage.FetchPending(context.Background(), 10, 0)
+	require.NoError(t, err)
+	assert.Empty(t, pending)
+}
+
+func TestRelaySkipsClaimedEvents(t *testing.T) {
+	producer := &fakeProducer{}
+	relay, storage := newRelay(t, producer)
+	ctx := context.Background()
+
+	require.NoError(t, storage.Add(context.Background(), domain.NewOrderEvent(1, domain.New, "")))
+	claimed, err := storage.FetchPending(ctx, 10, time.Minute)
+	require.NoError(t, err)
+	require.Len(t, claimed, 1)
+
+	sent, err := relay.Flush(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, sent, "event claimed by another relay must be skipped")
+
+	require.NoError(t, storage.Release(ctx, []int64{claimed[0].ID}))
+	sent, err = relay.Flush(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, sent)
+}
+
+// blockingProducer держит Send до закрытия release
+type blockingProducer struct {
+	started chan struct{}
+	release chan struct{}
+}
+
+func (p *blockingProducer) Send(context.Context, string, []byte) error {
+	close(p.started)
+	<-p.release
+	return nil
+}
+
+func TestRelaySendsOutsideTransaction(t *testing.T) {
+	producer := &blockingProducer{started: make(chan struct{}), release: make(chan struct{})}
+	storage := repository.NewInMemoryOutboxRepository()
+	txManager := repository.NewInMemoryTxManager()
+	relay := outbox.NewRelay(storage, producer, txManager, outbox.Config{BatchSize: 10, LockTimeout: time.Minute})
+	require.NoError(t, storage.Add(context.Background(), domain.NewOrderEvent(1, domain.New, "")))
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := relay.Flush(context.Background())
+		done <- err
+	}()
+	<-producer.started
+
+	// Пока брокер отвечает, транзакции сервиса не должны ждать relay
+	txDone := make(chan error, 1)
+	go func() {
+		txDone <- txManager.WithinTransaction(context.Background(), func(context.Context) error { return nil })
+	}()
+	select {
+	case err := <-txDone:
+		require.NoError(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("transaction is blocked by a slow broker")
+	}
+
+	close(producer.release)
+	require.NoError(t, <-done)
+	pending, err := storage.FetchPending(context.Background(), 10, 0)
+	require.NoError(t, err)
+	assert.Empty(t, pending)
+}
+
+func TestRelayRun(t *testing.T) {
+	producer := &fakeProducer{failNext: 2}
+	relay, storage := newRelay(t, producer)
+
+	require.NoError(t, storage.Add(context.Background(), domain.NewOrderEvent(7, domain.Payed, "")))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		relay.Run(ctx)
+		close(done)
+	}()
+
+	assert.Eventually(t, func() bool {
+		return len(producer.messages()) == 1
+	}, time.Second, time.Millisecond)
+
+	cancel()
+	<-done
+	assert.Equal(t, "7", producer.messages()[0].key)
+}
@@ -0,0 +1,93 @@
+package sweeper
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"time"
+)
+
+// Canceller отменяет заказы, ожидающие оплаты с момента before, кроме заказов из skip
+type Canceller interface {
+	CancelExpired(ctx context.Context, before time.Time, skip []int64, limit int32) (cancelled, failed []int64, err error)
+}
+
+type Config struct {
+	Interval       time.Duration
+	PaymentTimeout time.Duration
+	BatchSize      int32
+	// MaxAttempts - число неудачных отмен заказа, после которого sweeper перестает его выбирать
+	MaxAttempts int32
+}
+
+// Sweeper периодически отменяет неоплаченные заказы и освобождает их резервы.
+// Заказы блокируются с SKIP LOCKED, поэтому sweeper можно запускать на нескольких репликах.
+// Заказ, который не удалось отменить, пропускается до конца прохода, а после MaxAttempts
+// неудач - до перезапуска, чтобы такие заказы не занимали всю пачку.
+type Sweeper struct {
+	canceller Canceller
+	cfg       Config
+	// failures число неудачных отмен по заказам. Sweep не вызывается конкурентно, поэтому без мьютекса
+	failures map[int64]int32
+}
+
+func NewSweeper(canceller Canceller, cfg Config) *Sweeper {
+	return &Sweeper{canceller: canceller, cfg: cfg, failures: make(map[int64]int32)}
+}
+
+// Run отменяет просроченные заказы до отмены ctx
+func (s *Sweeper) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.Sweep(ctx)
+		}
+	}
+}
+
+// Sweep отменяет пачками все заказы, просроченные на момент вызова
+func (s *Sweeper) Sweep(ctx context.Context) {
+	before := time.Now().Add(-s.cfg.PaymentTimeout)
+	skip := s.exhausted()
+	for ctx.Err() == nil {
+		cancelled, failed, err := s.canceller.CancelExpired(ctx, before, skip, s.cfg.BatchSize)
+		if err != nil {
+			slog.ErrorContext(ctx, "sweeper: cancel expired orders", "error", err)
+		}
+		if len(cancelled) > 0 {
+			slog.InfoContext(ctx, "sweeper: cancelled unpaid orders", "count", len(cancelled))
+		}
+		for _, id := range cancelled {
+			delete(s.failures, id)
+		}
+		for _, id := range failed {
+			s.failures[id]++
+			if s.failures[id] == s.cfg.MaxAttempts {
+				slog.ErrorContext(ctx, "sweeper: giving up on order", "order_id", id, "attempts", s.cfg.MaxAttempts)
+			}
+		}
+		skip = append(skip, failed...)
+
+		processed := len(cancelled) + len(failed)
+		if processed == 0 || processed < int(s.cfg.BatchSize) {
+			return
+		}
+	}
+}
+
+// exhausted возвращает заказы, попытки отмены которых исчерпаны
+func (s *Sweeper) exhausted() []int64 {
+	ids := make([]int64, 0)
+	for id, n := range s.failures {
+		if n >= s.cfg.MaxAttempts {
+			ids = append(ids, id)
+		}
+	}
+	slices.Sort(ids)
+	return ids
+}
@@ -0,0 +1,77 @@
+package sweeper_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vestamart/loms/internal/app/sweeper"
+)
+
+// fakeCanceller хранит просроченные заказы по возрастанию updated_at.
+// Заказы из broken отменить не удается, остальные отменяются и пропадают из очереди.
+type fakeCanceller struct {
+	expired []int64
+	broken  map[int64]bool
+	skips   [][]int64
+}
+
+func (f *fakeCanceller) CancelExpired(_ context.Context, _ time.Time, skip []int64, limit int32) (cancelled, failed []int64, err error) {
+	f.skips = append(f.skips, slices.Clone(skip))
+
+	var errs []error
+	for _, id := range f.expired {
+		if len(cancelled)+len(failed) == int(limit) {
+			break
+		}
+		if slices.Contains(skip, id) {
+			continue
+		}
+		if f.broken[id] {
+			failed = append(failed, id)
+			errs = append(errs, errors.New("cancel failed"))
+			continue
+		}
+		cancelled = append(cancelled, id)
+	}
+	f.expired = slices.DeleteFunc(f.expired, func(id int64) bool { return slices.Contains(cancelled, id) })
+	return cancelled, failed, errors.Join(errs...)
+}
+
+func TestSweepSkipsFailingOrders(t *testing.T) {
+	// Самые старые заказы не отменяются и занимают целую пачку
+	canceller := &fakeCanceller{expired: []int64{1, 2, 3, 4, 5}, broken: map[int64]bool{1: true, 2: true}}
+	s := sweeper.NewSweeper(canceller, sweeper.Config{BatchSize: 2, MaxAttempts: 2})
+
+	s.Sweep(context.Background())
+	assert.Equal(t, []int64{1, 2}, canceller.expired, "healthy orders behind failing ones must be cancelled")
+
+	// Вторая неудача исчерпывает попытки, после этого заказы не выбираются
+	canceller.skips = nil
+	s.Sweep(context.Background())
+	assert.Empty(t, canceller.skips[0])
+
+	canceller.skips = nil
+	s.Sweep(context.Background())
+	assert.Equal(t, [][]int64{{1, 2}}, canceller.skips)
+}
+
+func TestSweepForgetsRecoveredOrders(t *testing.T) {
+	canceller := &fakeCanceller{expired: []int64{1}, broken: map[int64]bool{1: true}}
+	s := sweeper.NewSweeper(canceller, sweeper.Config{BatchSize: 10, MaxAttempts: 2})
+
+	s.Sweep(context.Background())
+	canceller.broken[1] = false
+	s.Sweep(context.Background())
+	assert.Empty(t, canceller.expired)
+
+	// Счетчик неудач сброшен: новая неудача не исчерпывает попытки
+	canceller.expired, canceller.broken[1] = []int64{1}, true
+	s.Sweep(context.Background())
+	canceller.skips = nil
+	s.Sweep(context.Background())
+	assert.Empty(t, canceller.skips[0])
+}
@@ -1,8 +1,15 @@
 package config
 
 import (
+	"errors"
+	"fmt"
 	"gopkg.in/yaml.v3"
+	"io"
+	"net"
+	"net/url"
 	"os"
+	"strings"
+	"time"
 )
 
 type ClientConfig struct {
@@ -11,38 +18,206 @@ type ClientConfig struct {
 }
 
 type gRPCServerConfig struct {
-	Port string `yaml:"gRPCport"`
+	Port       string `yaml:"gRPCport" env:"LOMS_GRPC_PORT"`
+	Reflection bool   `yaml:"reflection" env:"LOMS_GRPC_REFLECTION"`
 }
 
 type HTTPServerConfig struct {
-	Port string `yaml:"port"`
+	Port string `yaml:"port" env:"LOMS_HTTP_PORT"`
+}
+
+type AdminServerConfig struct {
+	Port            string        `yaml:"port" env:"LOMS_ADMIN_PORT"`
+	CollectTimeout  time.Duration `yaml:"collect_timeout" env:"LOMS_ADMIN_COLLECT_TIMEOUT"`
+	CollectInterval time.Duration `yaml:"collect_interval" env:"LOMS_ADMIN_COLLECT_INTERVAL"`
 }
 
 type DatabaseConfig struct {
-	Host     string `yaml:"host"`
-	Port     string `yaml:"port"`
-	User     string `yaml:"user"`
-	Password string `yaml:"password"`
-	DBName   string `yaml:"dbname"`
-	SSLMode  string `yaml:"sslmode"`
+	Host     string `yaml:"host" env:"LOMS_DATABASE_HOST,POSTGRES_HOST"`
+	Port     string `yaml:"port" env:"LOMS_DATABASE_PORT,POSTGRES_PORT"`
+	User     string `yaml:"user" env:"LOMS_DATABASE_USER,POSTGRES_USER"`
+	Password string `yaml:"password" env:"LOMS_DATABASE_PASSWORD,POSTGRES_PASSWORD"`
+	DBName   string `yaml:"dbname" env:"LOMS_DATABASE_NAME,POSTGRES_DB"`
+	SSLMode  string `yaml:"sslmode" env:"LOMS_DATABASE_SSLMODE"`
+	// PasswordFile путь к файлу с паролем, например docker secret. Имеет приоритет над Password
+	PasswordFile string `yaml:"password_file" env:"LOMS_DATABASE_PASSWORD_FILE,POSTGRES_PASSWORD_FILE"`
+
+	MaxConns          int32         `yaml:"max_conns" env:"LOMS_DATABASE_MAX_CONNS"`
+	MinConns          int32         `yaml:"min_conns" env:"LOMS_DATABASE_MIN_CONNS"`
+	MaxConnIdleTime   time.Duration `yaml:"max_conn_idle_time" env:"LOMS_DATABASE_MAX_CONN_IDLE_TIME"`
+	MaxConnLifetime   time.Duration `yaml:"max_conn_lifetime" env:"LOMS_DATABASE_MAX_CONN_LIFETIME"`
+	HealthCheckPeriod time.Duration `yaml:"health_check_period" env:"LOMS_DATABASE_HEALTH_CHECK_PERIOD"`
+	ConnectAttempts   int           `yaml:"connect_attempts" env:"LOMS_DATABASE_CONNECT_ATTEMPTS"`
+	ConnectDelay      time.Duration `yaml:"connect_delay" env:"LOMS_DATABASE_CONNECT_DELAY"`
+	ConnectMaxDelay   time.Duration `yaml:"connect_max_delay" env:"LOMS_DATABASE_CONNECT_MAX_DELAY"`
+	StatsInterval     time.Duration `yaml:"stats_interval" env:"LOMS_DATABASE_STATS_INTERVAL"`
+}
+
+func (c DatabaseConfig) DSN() string {
+	dsn := url.URL{
+		Scheme:   "postgres",
+		User:     url.UserPassword(c.User, c.Password),
+		Host:     net.JoinHostPort(c.Host, c.Port),
+		Path:     c.DBName,
+		RawQuery: url.Values{"sslmode": {c.SSLMode}}.Encode(),
+	}
+	return dsn.String()
+}
+
+// KafkaConfig настройки публикации событий. Для storage.backend=memory Kafka не используется,
+// события пишутся в лог
+type KafkaConfig struct {
+	Brokers []string `yaml:"brokers" env:"LOMS_KAFKA_BROKERS"`
+	Topic   string   `yaml:"topic" env:"LOMS_KAFKA_TOPIC"`
+}
+
+type OutboxConfig struct {
+	RelayInterval time.Duration `yaml:"relay_interval" env:"LOMS_OUTBOX_RELAY_INTERVAL"`
+	MaxBackoff    time.Duration `yaml:"max_backoff" env:"LOMS_OUTBOX_MAX_BACKOFF"`
+	BatchSize     int32         `yaml:"batch_size" env:"LOMS_OUTBOX_BATCH_SIZE"`
+	LockTimeout   time.Duration `yaml:"lock_timeout" env:"LOMS_OUTBOX_LOCK_TIMEOUT"`
+	MaxAttempts   int32         `yaml:"max_attempts" env:"LOMS_OUTBOX_MAX_ATTEMPTS"`
+}
+
+type OrdersConfig struct {
+	PaymentTimeout   time.Duration `yaml:"payment_timeout" env:"LOMS_ORDERS_PAYMENT_TIMEOUT"`
+	SweepInterval    time.Duration `yaml:"sweep_interval" env:"LOMS_ORDERS_SWEEP_INTERVAL"`
+	SweepBatchSize   int32         `yaml:"sweep_batch_size" env:"LOMS_ORDERS_SWEEP_BATCH_SIZE"`
+	SweepMaxAttempts int32         `yaml:"sweep_max_attempts" env:"LOMS_ORDERS_SWEEP_MAX_ATTEMPTS"`
+}
+
+type IdempotencyConfig struct {
+	TTL         time.Duration `yaml:"ttl" env:"LOMS_IDEMPOTENCY_TTL"`
+	LockTimeout time.Duration `yaml:"lock_timeout" env:"LOMS_IDEMPOTENCY_LOCK_TIMEOUT"`
+}
+
+type HealthConfig struct {
+	CheckInterval time.Duration `yaml:"check_interval" env:"LOMS_HEALTH_CHECK_INTERVAL"`
+	CheckTimeout  time.Duration `yaml:"check_timeout" env:"LOMS_HEALTH_CHECK_TIMEOUT"`
+}
+
+// ShutdownConfig Timeout - общий дедлайн остановки: ожидание текущих запросов, воркеров и закрытие ресурсов.
+// CloseTimeout - отдельное время на закрытие каждого ресурса, которым пользуются воркеры, если общий дедлайн истек
+type ShutdownConfig struct {
+	Timeout      time.Duration `yaml:"timeout" env:"LOMS_SHUTDOWN_TIMEOUT"`
+	CloseTimeout time.Duration `yaml:"close_timeout" env:"LOMS_SHUTDOWN_CLOSE_TIMEOUT"`
+}
+
+// StorageConfig Backend: postgres или memory. StocksSeedFile - json со стоками для memory,
+// по умолчанию используются встроенные данные
+type StorageConfig struct {
+	Backend        string `yaml:"backend" env:"LOMS_STORAGE_BACKEND"`
+	StocksSeedFile string `yaml:"stocks_seed_file" env:"LOMS_STORAGE_STOCKS_SEED_FILE"`
+}
+
+const (
+	StorageBackendPostgres = "postgres"
+	StorageBackendMemory   = "memory"
+)
+
+type StocksConfig struct {
+	ReservationStrategy string `yaml:"reservation_strategy" env:"LOMS_STOCKS_RESERVATION_STRATEGY"`
+}
+
+// LoggerConfig настройки логирования. Level: debug, info, warn или error; Format: json или text.
+// RedactFields - имена полей proto, значения которых скрываются при логировании запросов и ответов.
+type LoggerConfig struct {
+	Level        string   `yaml:"level" env:"LOMS_LOG_LEVEL"`
+	Format       string   `yaml:"format" env:"LOMS_LOG_FORMAT"`
+	LogPayloads  bool     `yaml:"log_payloads" env:"LOMS_LOG_PAYLOADS"`
+	RedactFields []string `yaml:"redact_fields" env:"LOMS_LOG_REDACT_FIELDS"`
+}
+
+// TracingConfig настройки экспорта трейсов. Exporter: none, stdout или otlp.
+type TracingConfig struct {
+	Exporter    string  `yaml:"exporter" env:"LOMS_TRACING_EXPORTER"`
+	Endpoint    string  `yaml:"endpoint" env:"LOMS_TRACING_ENDPOINT"`
+	ServiceName string  `yaml:"service_name" env:"LOMS_TRACING_SERVICE_NAME"`
+	SampleRatio float64 `yaml:"sample_ratio" env:"LOMS_TRACING_SAMPLE_RATIO"`
 }
 
 type Config struct {
-	LOMSServer gRPCServerConfig `yaml:"loms_server"`
-	Database   DatabaseConfig   `yaml:"database"`
+	LOMSServer  gRPCServerConfig  `yaml:"loms_server"`
+	HTTPServer  HTTPServerConfig  `yaml:"http_server"`
+	AdminServer AdminServerConfig `yaml:"admin_server"`
+	Storage     StorageConfig     `yaml:"storage"`
+	Database    DatabaseConfig    `yaml:"database"`
+	Kafka       KafkaConfig       `yaml:"kafka"`
+	Outbox      OutboxConfig      `yaml:"outbox"`
+	Orders      OrdersConfig      `yaml:"orders"`
+	Idempotency IdempotencyConfig `yaml:"idempotency"`
+	Stocks      StocksConfig      `yaml:"stocks"`
+	Tracing     TracingConfig     `yaml:"tracing"`
+	Logger      LoggerConfig      `yaml:"logger"`
+	Health      HealthConfig      `yaml:"health"`
+	Shutdown    ShutdownConfig    `yaml:"shutdown"`
+}
+
+// Default возвращает конфигурацию по умолчанию, поверх которой применяются файл и переменные окружения
+func Default() Config {
+	return Config{
+		LOMSServer:  gRPCServerConfig{Port: "50051"},
+		HTTPServer:  HTTPServerConfig{Port: "8080"},
+		AdminServer: AdminServerConfig{Port: "8081", CollectTimeout: 5 * time.Second, CollectInterval: 15 * time.Second},
+		Storage:     StorageConfig{Backend: StorageBackendPostgres},
+		Database: DatabaseConfig{
+			Host:            "localhost",
+			Port:            "5432",
+			SSLMode:         "disable",
+			ConnectAttempts: 10,
+			ConnectDelay:    time.Second,
+			ConnectMaxDelay: 10 * time.Second,
+			StatsInterval:   time.Minute,
+		},
+		Kafka:       KafkaConfig{Topic: "loms.order-events"},
+		Outbox:      OutboxConfig{RelayInterval: time.Second, MaxBackoff: 30 * time.Second, BatchSize: 100, LockTimeout: time.Minute, MaxAttempts: 20},
+		Orders:      OrdersConfig{PaymentTimeout: 15 * time.Minute, SweepInterval: time.Minute, SweepBatchSize: 100, SweepMaxAttempts: 3},
+		Idempotency: IdempotencyConfig{TTL: 24 * time.Hour, LockTimeout: 30 * time.Second},
+		Stocks:      StocksConfig{ReservationStrategy: "nearest_first"},
+		Tracing:     TracingConfig{Exporter: "none", ServiceName: "loms", SampleRatio: 1},
+		Logger:      LoggerConfig{Level: "info", Format: "json"},
+		Health:      HealthConfig{CheckInterval: 5 * time.Second, CheckTimeout: time.Second},
+		Shutdown:    ShutdownConfig{Timeout: 15 * time.Second, CloseTimeout: 5 * time.Second},
+	}
 }
 
+// LoadConfig читает конфигурацию из файла path и переменных окружения процесса
 func LoadConfig(path string) (*Config, error) {
-	file, err := os.Open(path)
-	if err != nil {
-		return nil, err
+	return Load(path, os.LookupEnv)
+}
+
+// Load собирает конфигурацию: значения по умолчанию, затем файл path (если задан), затем переменные
+// окружения из тега env, затем пароль из файла. Результат проверяется Validate
+func Load(path string, lookupEnv func(string) (string, bool)) (*Config, error) {
+	cfg := Default()
+
+	if path != "" {
+		file, err := os.Open(path)
+		if err != nil {
+			return nil, fmt.Errorf("open config: %w", err)
+		}
+		defer file.Close()
+
+		if err = yaml.NewDecoder(file).Decode(&cfg); err != nil && !errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("parse config %s: %w", path, err)
+		}
 	}
 
-	var cfg Config
-	err = yaml.NewDecoder(file).Decode(&cfg)
-	if err != nil {
+	if err := applyEnv(&cfg, lookupEnv); err != nil {
 		return nil, err
 	}
 
+	if cfg.Database.PasswordFile != "" {
+		password, err := os.ReadFile(cfg.Database.PasswordFile)
+		if err != nil {
+			return nil, fmt.Errorf("read database password file: %w", err)
+		}
+		cfg.Database.Password = strings.TrimRight(string(password), "\r\n")
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid config: %w", err)
+	}
+
 	return &cfg, nil
 }
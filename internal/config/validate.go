@@ -0,0 +1,128 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+)
+
+// Validate проверяет конфигурацию и возвращает все найденные ошибки сразу
+func (c *Config) Validate() error {
+	v := &validator{}
+
+	v.port("loms_server.gRPCport", c.LOMSServer.Port)
+	v.port("http_server.port", c.HTTPServer.Port)
+	v.port("admin_server.port", c.AdminServer.Port)
+	v.positive("admin_server.collect_timeout", c.AdminServer.CollectTimeout)
+	v.positive("admin_server.collect_interval", c.AdminServer.CollectInterval)
+
+	v.oneOf("storage.backend", c.Storage.Backend, StorageBackendPostgres, StorageBackendMemory)
+	if c.Storage.Backend == StorageBackendPostgres {
+		v.required("database.host", c.Database.Host)
+		v.port("database.port", c.Database.Port)
+		v.required("database.user", c.Database.User)
+		v.required("database.dbname", c.Database.DBName)
+		v.oneOf("database.sslmode", c.Database.SSLMode, "disable", "allow", "prefer", "require", "verify-ca", "verify-full")
+		if c.Database.MaxConns < 0 || c.Database.MinConns < 0 {
+			v.fail("database.max_conns", "must not be negative")
+		}
+		if c.Database.MaxConns > 0 && c.Database.MinConns > c.Database.MaxConns {
+			v.fail("database.min_conns", "must not exceed max_conns")
+		}
+		if c.Database.ConnectAttempts < 1 {
+			v.fail("database.connect_attempts", "must be at least 1")
+		}
+		v.positive("database.connect_delay", c.Database.ConnectDelay)
+		v.positive("database.connect_max_delay", c.Database.ConnectMaxDelay)
+		if c.Database.ConnectDelay > c.Database.ConnectMaxDelay {
+			v.fail("database.connect_delay", "must not exceed connect_max_delay")
+		}
+		v.positive("database.stats_interval", c.Database.StatsInterval)
+	}
+
+	if c.Storage.Backend == StorageBackendPostgres {
+		if len(c.Kafka.Brokers) == 0 {
+			v.fail("kafka.brokers", "must not be empty")
+		}
+		v.required("kafka.topic", c.Kafka.Topic)
+	}
+
+	v.positive("outbox.relay_interval", c.Outbox.RelayInterval)
+	v.positive("outbox.max_backoff", c.Outbox.MaxBackoff)
+	v.positiveInt("outbox.batch_size", c.Outbox.BatchSize)
+	v.positive("outbox.lock_timeout", c.Outbox.LockTimeout)
+	v.positiveInt("outbox.max_attempts", c.Outbox.MaxAttempts)
+
+	v.positive("orders.payment_timeout", c.Orders.PaymentTimeout)
+	v.positive("orders.sweep_interval", c.Orders.SweepInterval)
+	v.positiveInt("orders.sweep_batch_size", c.Orders.SweepBatchSize)
+	v.positiveInt("orders.sweep_max_attempts", c.Orders.SweepMaxAttempts)
+
+	v.positive("idempotency.ttl", c.Idempotency.TTL)
+	v.positive("idempotency.lock_timeout", c.Idempotency.LockTimeout)
+	if c.Idempotency.LockTimeout > c.Idempotency.TTL {
+		v.fail("idempotency.lock_timeout", "must not exceed ttl")
+	}
+
+	v.oneOf("tracing.exporter", c.Tracing.Exporter, "none", "stdout", "otlp")
+	if c.Tracing.Exporter == "otlp" {
+		v.required("tracing.endpoint", c.Tracing.Endpoint)
+	}
+	if c.Tracing.SampleRatio < 0 || c.Tracing.SampleRatio > 1 {
+		v.fail("tracing.sample_ratio", "must be between 0 and 1")
+	}
+
+	v.oneOf("logger.level", c.Logger.Level, "debug", "info", "warn", "error")
+	v.oneOf("logger.format", c.Logger.Format, "json", "text")
+
+	v.positive("health.check_interval", c.Health.CheckInterval)
+	v.positive("health.check_timeout", c.Health.CheckTimeout)
+	v.positive("shutdown.timeout", c.Shutdown.Timeout)
+	v.positive("shutdown.close_timeout", c.Shutdown.CloseTimeout)
+
+	return errors.Join(v.errs...)
+}
+
+type validator struct {
+	errs []error
+}
+
+func (v *validator) fail(field, msg string) {
+	v.errs = append(v.errs, fmt.Errorf("%s: %s", field, msg))
+}
+
+func (v *validator) required(field, value string) {
+	if value == "" {
+		v.fail(field, "must not be empty")
+	}
+}
+
+func (v *validator) port(field, value string) {
+	if value == "" {
+		v.fail(field, "must not be empty")
+		return
+	}
+	if n, err := strconv.Atoi(value); err != nil || n < 1 || n > 65535 {
+		v.fail(field, fmt.Sprintf("invalid port %q", value))
+	}
+}
+
+func (v *validator) positive(field string, value time.Duration) {
+	if value <= 0 {
+		v.fail(field, "must be a positive duration")
+	}
+}
+
+func (v *validator) positiveInt(field string, value int32) {
+	if value <= 0 {
+		v.fail(field, "must be positive")
+	}
+}
+
+func (v *validator) oneOf(field, value string, allowed ...string) {
+	if !slices.Contains(allowed, value) {
+		v.fail(field, fmt.Sprintf("unknown value %q, expected one of %v", value, allowed))
+	}
+}
@@ -20,48 +20,16 @@ func NewServer(service loms.Service) *Server {
 	return &Server{Service: service}
 }
 
-func validateOrderCreateRequest(req *desc.OrderCreateRequest) error {
-	if req.User <= 0 {
-		return errors.New("user id must be positive")
-	}
-	if len(req.Items) == 0 {
-		return errors.New("items must not be empty")
-	}
-	for _, item := range req.Items {
-		if item.Sku == 0 {
-			return errors.New("item sku must be positive")
-		}
-		if item.Count == 0 {
-			return errors.New("item count must be positive")
-		}
-	}
-	return nil
-}
-
-func validateOrderId(id int64) error {
-	if id <= 0 {
-		return errors.New("order id must be positive")
-	}
-	return nil
-}
-
-func validateSku(sku uint32) error {
-	if sku == 0 {
-		return errors.New("sku must be positive")
+// validateListOrdersRequest проверяет условия, которые не выражаются аннотациями validate в proto
+func validateListOrdersRequest(req *desc.ListOrdersRequest) error {
+	if req.CreatedFrom != nil && req.CreatedTo != nil && !req.CreatedFrom.AsTime().Before(req.CreatedTo.AsTime()) {
+		return errors.New("created from must be before created to")
 	}
 	return nil
 }
 
 func (s Server) OrderCreate(ctx context.Context, request *desc.OrderCreateRequest) (*desc.OrderCreateResponse, error) {
 	ops := "Server OrderCreate"
-	if request == nil || (request.User == 0 || len(request.Items) == 0) {
-		return nil, status.Errorf(codes.InvalidArgument, "%s empty order create request", ops)
-	}
-
-	err := validateOrderCreateRequest(request)
-	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "%s: %v", ops, err)
-	}
 
 	resp, err := s.Service.OrderCreate(ctx, request)
 	if err != nil {
@@ -80,10 +48,6 @@ func (s Server) OrderCreate(ctx context.Context, request *desc.OrderCreateReques
 func (s Server) OrderInfo(ctx context.Context, request *desc.OrderInfoRequest) (*desc.OrderInfoResponse, error) {
 	ops := "Server OrderInfo"
 
-	if err := validateOrderId(request.OrderId); err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "%s: %v", ops, err)
-	}
-
 	resp, err := s.Service.OrderInfo(ctx, request)
 	if err != nil {
 		if errors.Is(err, localErr.OrderNotFoundErr) {
@@ -98,15 +62,17 @@ func (s Server) OrderInfo(ctx context.Context, request *desc.OrderInfoRequest) (
 func (s Server) OrderPay(ctx context.Context, request *desc.OrderPayRequest) (*desc.OrderPayResponse, error) {
 	ops := "Server OrderPay"
 
-	if err := validateOrderId(request.OrderID); err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "%s: %v", ops, err)
-	}
-
 	resp, err := s.Service.OrderPay(ctx, request)
 	if err != nil {
 		if errors.Is(err, localErr.OrderNotFoundErr) {
 			return nil, status.Errorf(codes.NotFound, "%s: %v", ops, err)
 		}
+		if errors.Is(err, localErr.InvalidStatusTransitionErr) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%s: %v", ops, err)
+		}
+		if errors.Is(err, localErr.ReservedNotEnoughErr) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%s: %v", ops, err)
+		}
 		return nil, status.Errorf(codes.Internal, "%s: %v", ops, err)
 	}
 
@@ -116,16 +82,18 @@ func (s Server) OrderPay(ctx context.Context, request *desc.OrderPayRequest) (*d
 func (s Server) OrderCancel(ctx context.Context, request *desc.OrderCancelRequest) (*desc.OrderCancelResponse, error) {
 	ops := "Server OrderCancel"
 
-	if err := validateOrderId(request.OrderID); err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "%s: %v", ops, err)
-	}
-
 	resp, err := s.Service.OrderCancel(ctx, request)
 	if err != nil {
 		if errors.Is(err, localErr.OrderNotFoundErr) {
 			return nil, status.Errorf(codes.NotFound, "%s: %v ", ops, err)
 		}
-		return nil, status.Errorf(codes.Internal, "%s: %w", ops, err)
+		if errors.Is(err, localErr.InvalidStatusTransitionErr) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%s: %v", ops, err)
+		}
+		if errors.Is(err, localErr.ReservedNotEnoughErr) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%s: %v", ops, err)
+		}
+		return nil, status.Errorf(codes.Internal, "%s: %v", ops, err)
 	}
 
 	return resp, status.Error(codes.OK, "")
@@ -134,10 +102,6 @@ func (s Server) OrderCancel(ctx context.Context, request *desc.OrderCancelReques
 func (s Server) StocksInfo(ctx context.Context, request *desc.StocksInfoRequest) (*desc.StocksInfoResponse, error) {
 	ops := "Server StocksInfo"
 
-	if err := validateSku(request.Sku); err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "%s: %v", ops, err)
-	}
-
 	resp, err := s.Service.StocksInfo(ctx, request)
 	if err != nil {
 		return nil, status.Errorf(codes.NotFound, "%s: %v", ops, err)
@@ -145,3 +109,97 @@ func (s Server) StocksInfo(ctx context.Context, request *desc.StocksInfoRequest)
 
 	return resp, status.Error(codes.OK, "")
 }
+
+func (s Server) ListOrders(ctx context.Context, request *desc.ListOrdersRequest) (*desc.ListOrdersResponse, error) {
+	ops := "Server ListOrders"
+
+	if err := validateListOrdersRequest(request); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s: %v", ops, err)
+	}
+
+	resp, err := s.Service.ListOrders(ctx, request)
+	if err != nil {
+		if errors.Is(err, localErr.InvalidPageTokenErr) {
+			return nil, status.Errorf(codes.InvalidArgument, "%s: %v", ops, err)
+		}
+		return nil, status.Errorf(codes.Internal, "%s: %v", ops, err)
+	}
+
+	return resp, nil
+}
+
+func (s Server) StocksInfoBatch(ctx context.Context, request *desc.StocksInfoBatchRequest) (*desc.StocksInfoBatchResponse, error) {
+	ops := "Server StocksInfoBatch"
+
+	resp, err := s.Service.StocksInfoBatch(ctx, request)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%s: %v", ops, err)
+	}
+
+	return resp, nil
+}
+
+func (s Server) OrderHistory(ctx context.Context, request *desc.OrderHistoryRequest) (*desc.OrderHistoryResponse, error) {
+	ops := "Server OrderHistory"
+
+	resp, err := s.Service.OrderHistory(ctx, request)
+	if err != nil {
+		if errors.Is(err, localErr.OrderNotFoundErr) {
+			return nil, status.Errorf(codes.NotFound, "%s: %v", ops, err)
+		}
+		return nil, status.Errorf(codes.Internal, "%s: %v", ops, err)
+	}
+
+	return resp, nil
+}
+
+// stockAdminError переводит ошибки администрирования стоков в коды gRPC
+func stockAdminError(ops string, err error) error {
+	switch {
+	case errors.Is(err, localErr.SKUNotExistErr), errors.Is(err, localErr.WarehouseNotExistErr):
+		return status.Errorf(codes.NotFound, "%s: %v", ops, err)
+	case errors.Is(err, localErr.StockAlreadyExistErr):
+		return status.Errorf(codes.AlreadyExists, "%s: %v", ops, err)
+	case errors.Is(err, localErr.StockBelowReservedErr), errors.Is(err, localErr.StockReservedErr):
+		return status.Errorf(codes.FailedPrecondition, "%s: %v", ops, err)
+	case errors.Is(err, localErr.StockOverflowErr):
+		return status.Errorf(codes.OutOfRange, "%s: %v", ops, err)
+	}
+	return status.Errorf(codes.Internal, "%s: %v", ops, err)
+}
+
+func (s Server) StockCreate(ctx context.Context, request *desc.StockCreateRequest) (*desc.StockCreateResponse, error) {
+	resp, err := s.Service.StockCreate(ctx, request)
+	if err != nil {
+		return nil, stockAdminError("Server StockCreate", err)
+	}
+
+	return resp, nil
+}
+
+func (s Server) StockReplenish(ctx context.Context, request *desc.StockReplenishRequest) (*desc.StockReplenishResponse, error) {
+	resp, err := s.Service.StockReplenish(ctx, request)
+	if err != nil {
+		return nil, stockAdminError("Server StockReplenish", err)
+	}
+
+	return resp, nil
+}
+
+func (s Server) StockAdjust(ctx context.Context, request *desc.StockAdjustRequest) (*desc.StockAdjustResponse, error) {
+	resp, err := s.Service.StockAdjust(ctx, request)
+	if err != nil {
+		return nil, stockAdminError("Server StockAdjust", err)
+	}
+
+	return resp, nil
+}
+
+func (s Server) StockDelete(ctx context.Context, request *desc.StockDeleteRequest) (*desc.StockDeleteResponse, error) {
+	resp, err := s.Service.StockDelete(ctx, request)
+	if err != nil {
+		return nil, stockAdminError("Server StockDelete", err)
+	}
+
+	return resp, nil
+}
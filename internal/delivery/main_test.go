@@ -0,0 +1,96 @@
+package delivery_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vestamart/loms/internal/app/loms"
+	"github.com/vestamart/loms/internal/delivery"
+	"github.com/vestamart/loms/internal/domain"
+	"github.com/vestamart/loms/internal/localErr"
+	"github.com/vestamart/loms/internal/repository"
+	desc "github.com/vestamart/loms/pkg/api/loms/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func newServer(t *testing.T) (*delivery.Server, *repository.InMemoryStocksRepository) {
+	t.Helper()
+
+	stocks, err := repository.NewInMemoryStocksRepository("")
+	require.NoError(t, err)
+	outbox := repository.NewInMemoryOutboxRepository()
+	orders := repository.NewInMemoryOrderRepository(10, outbox)
+	svc := loms.NewService(orders, stocks, repository.NewInMemoryTxManager(), loms.NearestFirst)
+	return delivery.NewServer(*svc), stocks
+}
+
+func createOrder(t *testing.T, server *delivery.Server) int64 {
+	t.Helper()
+
+	resp, err := server.OrderCreate(context.Background(), &desc.OrderCreateRequest{User: 1, Items: []*desc.Item{{Sku: 1002, Count: 5}}})
+	require.NoError(t, err)
+	return resp.OrderId
+}
+
+func TestInvalidStatusTransitions(t *testing.T) {
+	pay := func(server *delivery.Server, id int64) error {
+		_, err := server.OrderPay(context.Background(), &desc.OrderPayRequest{OrderID: id})
+		return err
+	}
+	cancel := func(server *delivery.Server, id int64) error {
+		_, err := server.OrderCancel(context.Background(), &desc.OrderCancelRequest{OrderID: id})
+		return err
+	}
+
+	tests := []struct {
+		name      string
+		first     func(*delivery.Server, int64) error
+		second    func(*delivery.Server, int64) error
+		wantStock domain.StocksItem
+	}{
+		{name: "pay after cancel", first: cancel, second: pay, wantStock: domain.StocksItem{TotalCount: 200, Reserved: 20}},
+		{name: "cancel after pay", first: pay, second: cancel, wantStock: domain.StocksItem{TotalCount: 195, Reserved: 20}},
+		{name: "double cancel", first: cancel, second: cancel, wantStock: domain.StocksItem{TotalCount: 200, Reserved: 20}},
+		{name: "double pay", first: pay, second: pay, wantStock: domain.StocksItem{TotalCount: 195, Reserved: 20}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, stocks := newServer(t)
+			id := createOrder(t, server)
+			require.NoError(t, tt.first(server, id))
+
+			err := tt.second(server, id)
+			assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+			assert.Contains(t, err.Error(), localErr.InvalidStatusTransitionErr.Error())
+
+			_, err = server.Service.OrderCancel(context.Background(), &desc.OrderCancelRequest{OrderID: id})
+			assert.ErrorIs(t, err, localErr.InvalidStatusTransitionErr)
+			_, err = server.Service.OrderPay(context.Background(), &desc.OrderPayRequest{OrderID: id})
+			assert.ErrorIs(t, err, localErr.InvalidStatusTransitionErr)
+
+			got, err := stocks.GetBySKUs(context.Background(), []uint32{1002})
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantStock, got[1002], "rejected transition must not touch stocks")
+		})
+	}
+}
+
+func TestOrderNotFound(t *testing.T) {
+	server, _ := newServer(t)
+
+	_, err := server.OrderPay(context.Background(), &desc.OrderPayRequest{OrderID: 404})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = server.OrderCancel(context.Background(), &desc.OrderCancelRequest{OrderID: 404})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestListOrdersInvalidPageToken(t *testing.T) {
+	server, _ := newServer(t)
+
+	_, err := server.ListOrders(context.Background(), &desc.ListOrdersRequest{User: 1, PageToken: "not base64!"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
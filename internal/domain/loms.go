@@ -1,6 +1,9 @@
 package domain
 
 import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
 	"time"
 )
 
@@ -24,20 +27,72 @@ const (
 	OrderCancelled       EventType = "cancelled"
 )
 
+var statusEvents = map[OrderStatus]EventType{
+	New:             OrderCreated,
+	AwaitingPayment: OrderAwaitingPayment,
+	Failed:          OrderFailed,
+	Payed:           OrderPayed,
+	Cancelled:       OrderCancelled,
+}
+
+// transitions допустимые переходы между статусами, остальные статусы терминальные
+var transitions = map[OrderStatus][]OrderStatus{
+	New:             {AwaitingPayment, Failed},
+	AwaitingPayment: {Payed, Cancelled},
+}
+
+// CanTransitionTo сообщает, может ли заказ перейти из статуса s в next
+func (s OrderStatus) CanTransitionTo(next OrderStatus) bool {
+	for _, v := range transitions[s] {
+		if v == next {
+			return true
+		}
+	}
+	return false
+}
+
+func (s OrderStatus) String() string {
+	if v, ok := statusEvents[s]; ok {
+		return string(v)
+	}
+	return fmt.Sprintf("unknown(%d)", int(s))
+}
+
+// EventType возвращает тип события, которое публикуется при переходе заказа в статус s
+func (s OrderStatus) EventType() EventType {
+	return statusEvents[s]
+}
+
 type Order struct {
-	UserID int64
-	Status OrderStatus
-	Items  []Item
+	ID        int64
+	UserID    int64
+	Status    OrderStatus
+	Items     []Item
+	CreatedAt time.Time
+	UpdatedAt time.Time
 }
 
-type Item struct {
-	Sku   uint32 `json:"sku"`
-	Count uint32 `json:"count"`
+// OrderFilter условия выборки заказов пользователя. Нулевые границы дат не ограничивают выборку.
+type OrderFilter struct {
+	UserID      int64
+	Statuses    []OrderStatus
+	CreatedFrom time.Time
+	CreatedTo   time.Time
+	After       *OrderCursor
+	Limit       int32
+}
+
+// OrderCursor позиция в выдаче заказов, отсортированных по (CreatedAt, ID) по убыванию
+type OrderCursor struct {
+	CreatedAt time.Time
+	ID        int64
 }
 
-type StocksItem struct {
-	TotalCount uint32 `json:"total_count"`
-	Reserved   uint32 `json:"reserved"`
+// Item позиция заказа. WarehouseID - склад, с которого зарезервирован товар, 0 если резерва нет.
+type Item struct {
+	Sku         uint32 `json:"sku"`
+	Count       uint32 `json:"count"`
+	WarehouseID uint32 `json:"warehouse_id"`
 }
 
 type OrderEvent struct {
@@ -46,3 +101,53 @@ type OrderEvent struct {
 	Timestamp time.Time `json:"timestamp"`
 	Info      string    `json:"info"`
 }
+
+// NewOrderEvent создает событие о переходе заказа в статус status по причине reason.
+// Пустая причина заменяется стандартным описанием перехода.
+func NewOrderEvent(orderID int64, status OrderStatus, reason string) OrderEvent {
+	if reason == "" {
+		reason = fmt.Sprintf("order status changed to %s", status)
+	}
+	return OrderEvent{
+		OrderID:   orderID,
+		EventType: string(status.EventType()),
+		Timestamp: time.Now().UTC(),
+		Info:      reason,
+	}
+}
+
+// StatusChange запись истории статусов заказа. Reason совпадает с OrderEvent.Info.
+type StatusChange struct {
+	From      OrderStatus
+	To        OrderStatus
+	Reason    string
+	ChangedAt time.Time
+}
+
+// OutboxMessage событие из outbox, ожидающее отправки в kafka
+type OutboxMessage struct {
+	ID      int64
+	OrderID int64
+	Payload []byte
+	// Attempts число неудачных попыток отправки
+	Attempts int32
+}
+
+// IdempotencyRecord сохраненный результат запроса с ключом идемпотентности.
+// Пока Completed == false, запрос с этим ключом еще выполняется.
+type IdempotencyRecord struct {
+	RequestHash   []byte
+	Completed     bool
+	Response      []byte
+	StatusCode    uint32
+	StatusMessage string
+}
+
+// NewIdempotencyLease создает токен владельца ключа идемпотентности. Ключ, занятый повтором
+// после истечения lockTimeout, получает новый токен, и прежний владелец его уже не изменит
+func NewIdempotencyLease() string {
+	lease := make([]byte, 16)
+	// crypto/rand.Read не возвращает ошибок на поддерживаемых платформах
+	_, _ = rand.Read(lease)
+	return hex.EncodeToString(lease)
+}
@@ -0,0 +1,27 @@
+package domain_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vestamart/loms/internal/domain"
+)
+
+func TestOrderStatusCanTransitionTo(t *testing.T) {
+	statuses := []domain.OrderStatus{domain.New, domain.AwaitingPayment, domain.Failed, domain.Payed, domain.Cancelled}
+	allowed := map[[2]domain.OrderStatus]bool{
+		{domain.New, domain.AwaitingPayment}:       true,
+		{domain.New, domain.Failed}:                true,
+		{domain.AwaitingPayment, domain.Payed}:     true,
+		{domain.AwaitingPayment, domain.Cancelled}: true,
+	}
+
+	for _, from := range statuses {
+		for _, to := range statuses {
+			t.Run(fmt.Sprintf("%s to %s", from, to), func(t *testing.T) {
+				assert.Equal(t, allowed[[2]domain.OrderStatus{from, to}], from.CanTransitionTo(to))
+			})
+		}
+	}
+}
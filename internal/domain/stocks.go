@@ -0,0 +1,96 @@
+package domain
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/vestamart/loms/internal/localErr"
+)
+
+// MaxStockCount наибольший total_count, помещающийся в колонку INTEGER таблицы stocks
+const MaxStockCount = math.MaxInt32
+
+// DefaultWarehouseID основной склад, на который попадают стоки без явно указанного склада
+const DefaultWarehouseID = 1
+
+// StocksItem сток товара. Инвариант: Reserved <= TotalCount. Методы ниже задают единые
+// для всех репозиториев правила изменения стока и не меняют его при ошибке.
+type StocksItem struct {
+	TotalCount uint32 `json:"total_count"`
+	Reserved   uint32 `json:"reserved"`
+}
+
+// StockKey идентифицирует сток товара на складе
+type StockKey struct {
+	WarehouseID uint32
+	Sku         uint32
+}
+
+// WarehouseStock сток товара на одном складе. Чем меньше Priority, тем ближе склад.
+type WarehouseStock struct {
+	WarehouseID uint32
+	Priority    int32
+	StocksItem
+}
+
+// Available возвращает количество товара, доступного для покупки
+func (s StocksItem) Available() uint32 {
+	if s.Reserved > s.TotalCount {
+		return 0
+	}
+	return s.TotalCount - s.Reserved
+}
+
+// Validate проверяет инвариант стока
+func (s StocksItem) Validate() error {
+	if s.Reserved > s.TotalCount {
+		return fmt.Errorf("reserved %d exceeds total count %d: %w", s.Reserved, s.TotalCount, localErr.StockBelowReservedErr)
+	}
+	return nil
+}
+
+// Reserve резервирует count единиц из свободного остатка
+func (s StocksItem) Reserve(count uint32) (StocksItem, error) {
+	if s.Available() < count {
+		return s, fmt.Errorf("available %d, requested %d: %w", s.Available(), count, localErr.ItemNotEnoughErr)
+	}
+	s.Reserved += count
+	return s, nil
+}
+
+// ReserveRemove списывает count зарезервированных единиц при оплате: уменьшаются и резерв, и общий остаток
+func (s StocksItem) ReserveRemove(count uint32) (StocksItem, error) {
+	if s.Reserved < count {
+		return s, fmt.Errorf("reserved %d, removing %d: %w", s.Reserved, count, localErr.ReservedNotEnoughErr)
+	}
+	s.Reserved -= count
+	s.TotalCount -= count
+	return s, nil
+}
+
+// ReserveCancel возвращает count зарезервированных единиц в свободный остаток
+func (s StocksItem) ReserveCancel(count uint32) (StocksItem, error) {
+	if s.Reserved < count {
+		return s, fmt.Errorf("reserved %d, cancelling %d: %w", s.Reserved, count, localErr.ReservedNotEnoughErr)
+	}
+	s.Reserved -= count
+	return s, nil
+}
+
+// Replenish увеличивает общий остаток на count
+func (s StocksItem) Replenish(count uint32) (StocksItem, error) {
+	return s.Adjust(int64(count))
+}
+
+// Adjust меняет общий остаток на delta, не опуская его ниже резерва и не превышая MaxStockCount
+func (s StocksItem) Adjust(delta int64) (StocksItem, error) {
+	total := int64(s.TotalCount) + delta
+	if total < int64(s.Reserved) {
+		return s, fmt.Errorf("total count %d, reserved %d: %w", max(total, 0), s.Reserved, localErr.StockBelowReservedErr)
+	}
+	if total > MaxStockCount {
+		return s, fmt.Errorf("total count %d exceeds %d: %w", total, MaxStockCount, localErr.StockOverflowErr)
+	}
+	s.TotalCount = uint32(total)
+	return s, nil
+}
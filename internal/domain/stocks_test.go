@@ -0,0 +1,86 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vestamart/loms/internal/domain"
+	"github.com/vestamart/loms/internal/localErr"
+)
+
+func TestStocksItemTransitions(t *testing.T) {
+	stock := domain.StocksItem{TotalCount: 10, Reserved: 4}
+
+	tests := []struct {
+		name    string
+		apply   func(domain.StocksItem) (domain.StocksItem, error)
+		want    domain.StocksItem
+		wantErr error
+	}{
+		{
+			name:  "reserve free stock",
+			apply: func(s domain.StocksItem) (domain.StocksItem, error) { return s.Reserve(6) },
+			want:  domain.StocksItem{TotalCount: 10, Reserved: 10},
+		},
+		{
+			name:    "reserve more than available",
+			apply:   func(s domain.StocksItem) (domain.StocksItem, error) { return s.Reserve(7) },
+			wantErr: localErr.ItemNotEnoughErr,
+		},
+		{
+			name:  "remove decreases reserved and total",
+			apply: func(s domain.StocksItem) (domain.StocksItem, error) { return s.ReserveRemove(3) },
+			want:  domain.StocksItem{TotalCount: 7, Reserved: 1},
+		},
+		{
+			name:    "remove more than reserved",
+			apply:   func(s domain.StocksItem) (domain.StocksItem, error) { return s.ReserveRemove(5) },
+			wantErr: localErr.ReservedNotEnoughErr,
+		},
+		{
+			name:  "cancel decreases only reserved",
+			apply: func(s domain.StocksItem) (domain.StocksItem, error) { return s.ReserveCancel(4) },
+			want:  domain.StocksItem{TotalCount: 10, Reserved: 0},
+		},
+		{
+			name:    "cancel more than reserved",
+			apply:   func(s domain.StocksItem) (domain.StocksItem, error) { return s.ReserveCancel(5) },
+			wantErr: localErr.ReservedNotEnoughErr,
+		},
+		{
+			name:  "adjust down to reserved",
+			apply: func(s domain.StocksItem) (domain.StocksItem, error) { return s.Adjust(-6) },
+			want:  domain.StocksItem{TotalCount: 4, Reserved: 4},
+		},
+		{
+			name:    "adjust below reserved",
+			apply:   func(s domain.StocksItem) (domain.StocksItem, error) { return s.Adjust(-7) },
+			wantErr: localErr.StockBelowReservedErr,
+		},
+		{
+			name:    "replenish over limit",
+			apply:   func(s domain.StocksItem) (domain.StocksItem, error) { return s.Replenish(domain.MaxStockCount) },
+			wantErr: localErr.StockOverflowErr,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.apply(stock)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Equal(t, stock, got, "stock must not change on error")
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.NoError(t, got.Validate())
+		})
+	}
+}
+
+func TestStocksItemValidate(t *testing.T) {
+	assert.NoError(t, domain.StocksItem{TotalCount: 5, Reserved: 5}.Validate())
+	assert.ErrorIs(t, domain.StocksItem{TotalCount: 5, Reserved: 6}.Validate(), localErr.StockBelowReservedErr)
+}
@@ -0,0 +1,32 @@
+package e2e_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vestamart/loms/internal/metrics"
+	desc "github.com/vestamart/loms/pkg/api/loms/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Харнесс использует ту же цепочку, что и main: запросы попадают в метрики,
+// в том числе отклоненные валидацией
+func TestInterceptorChainRecordsMetrics(t *testing.T) {
+	client := newClient(t)
+	requests := func(code codes.Code) float64 {
+		return testutil.ToFloat64(metrics.GRPCRequestsTotal.WithLabelValues("/Loms/OrderCreate", code.String()))
+	}
+	okBefore, invalidBefore := requests(codes.OK), requests(codes.InvalidArgument)
+
+	_, err := client.OrderCreate(context.Background(), &desc.OrderCreateRequest{User: userID, Items: []*desc.Item{{Sku: 1002, Count: 1}}})
+	require.NoError(t, err)
+	_, err = client.OrderCreate(context.Background(), &desc.OrderCreateRequest{User: userID})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	assert.Equal(t, okBefore+1, requests(codes.OK))
+	assert.Equal(t, invalidBefore+1, requests(codes.InvalidArgument))
+}
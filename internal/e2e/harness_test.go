@@ -0,0 +1,52 @@
+package e2e_test
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vestamart/loms/internal/app/loms"
+	"github.com/vestamart/loms/internal/delivery"
+	"github.com/vestamart/loms/internal/mw"
+	"github.com/vestamart/loms/internal/repository"
+	desc "github.com/vestamart/loms/pkg/api/loms/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// newClient поднимает LOMS с цепочкой интерцепторов из mw.ServerOptions, как в main, и in-memory
+// бэкендом на bufconn и возвращает клиента к нему. Стоки берутся из встроенного stock-data.json
+func newClient(t *testing.T) desc.LomsClient {
+	t.Helper()
+
+	stocks, err := repository.NewInMemoryStocksRepository("")
+	require.NoError(t, err)
+	outbox := repository.NewInMemoryOutboxRepository()
+	orders := repository.NewInMemoryOrderRepository(100, outbox)
+	service := loms.NewService(orders, stocks, repository.NewInMemoryTxManager(), loms.NearestFirst)
+
+	grpcServer := grpc.NewServer(mw.ServerOptions(mw.ChainConfig{
+		IdempotencyStore:       repository.NewInMemoryIdempotencyRepository(),
+		IdempotencyLockTimeout: time.Minute,
+		IdempotencyTTL:         time.Hour,
+	})...)
+	desc.RegisterLomsServer(grpcServer, delivery.NewServer(*service))
+
+	lis := bufconn.Listen(1 << 20)
+	go func() { _ = grpcServer.Serve(lis) }()
+	t.Cleanup(grpcServer.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return desc.NewLomsClient(conn)
+}
@@ -0,0 +1,141 @@
+package e2e_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	desc "github.com/vestamart/loms/pkg/api/loms/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const userID = 42
+
+func requireStock(t *testing.T, client desc.LomsClient, sku uint32, want *desc.WarehouseStock) {
+	t.Helper()
+
+	resp, err := client.StocksInfo(context.Background(), &desc.StocksInfoRequest{Sku: sku})
+	require.NoError(t, err)
+	require.Len(t, resp.Warehouses, 1)
+	assert.Equal(t, want.Count, resp.Count, "sku %d count", sku)
+	assert.Equal(t, want.TotalCount, resp.Warehouses[0].TotalCount, "sku %d total count", sku)
+	assert.Equal(t, want.Reserved, resp.Warehouses[0].Reserved, "sku %d reserved", sku)
+}
+
+func requireStatus(t *testing.T, client desc.LomsClient, orderID int64, want desc.OrderStatus) {
+	t.Helper()
+
+	info, err := client.OrderInfo(context.Background(), &desc.OrderInfoRequest{OrderId: orderID})
+	require.NoError(t, err)
+	assert.Equal(t, want, info.Status)
+}
+
+func TestCreatePayOrder(t *testing.T) {
+	client := newClient(t)
+	ctx := context.Background()
+	requireStock(t, client, 1002, &desc.WarehouseStock{Count: 180, TotalCount: 200, Reserved: 20})
+
+	created, err := client.OrderCreate(ctx, &desc.OrderCreateRequest{User: userID, Items: []*desc.Item{{Sku: 1002, Count: 10}}})
+	require.NoError(t, err)
+
+	info, err := client.OrderInfo(ctx, &desc.OrderInfoRequest{OrderId: created.OrderId})
+	require.NoError(t, err)
+	assert.Equal(t, desc.OrderStatus_AWAITING_PAYMENT, info.Status)
+	assert.EqualValues(t, userID, info.User)
+	require.Len(t, info.Reservations, 1)
+	assert.Equal(t, uint32(10), info.Reservations[0].Count)
+	requireStock(t, client, 1002, &desc.WarehouseStock{Count: 170, TotalCount: 200, Reserved: 30})
+
+	_, err = client.OrderPay(ctx, &desc.OrderPayRequest{OrderID: created.OrderId})
+	require.NoError(t, err)
+	requireStatus(t, client, created.OrderId, desc.OrderStatus_PAYED)
+	requireStock(t, client, 1002, &desc.WarehouseStock{Count: 170, TotalCount: 190, Reserved: 20})
+
+	_, err = client.OrderPay(ctx, &desc.OrderPayRequest{OrderID: created.OrderId})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	_, err = client.OrderCancel(ctx, &desc.OrderCancelRequest{OrderID: created.OrderId})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	requireStock(t, client, 1002, &desc.WarehouseStock{Count: 170, TotalCount: 190, Reserved: 20})
+}
+
+func TestCreateCancelOrder(t *testing.T) {
+	client := newClient(t)
+	ctx := context.Background()
+
+	created, err := client.OrderCreate(ctx, &desc.OrderCreateRequest{User: userID, Items: []*desc.Item{
+		{Sku: 1003, Count: 20},
+		{Sku: 1004, Count: 5},
+	}})
+	require.NoError(t, err)
+	requireStock(t, client, 1003, &desc.WarehouseStock{Count: 200, TotalCount: 250, Reserved: 50})
+	requireStock(t, client, 1004, &desc.WarehouseStock{Count: 255, TotalCount: 300, Reserved: 45})
+
+	_, err = client.OrderCancel(ctx, &desc.OrderCancelRequest{OrderID: created.OrderId})
+	require.NoError(t, err)
+	requireStatus(t, client, created.OrderId, desc.OrderStatus_CANCELLED)
+	requireStock(t, client, 1003, &desc.WarehouseStock{Count: 220, TotalCount: 250, Reserved: 30})
+	requireStock(t, client, 1004, &desc.WarehouseStock{Count: 260, TotalCount: 300, Reserved: 40})
+
+	_, err = client.OrderPay(ctx, &desc.OrderPayRequest{OrderID: created.OrderId})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestCreateOrderInsufficientStock(t *testing.T) {
+	client := newClient(t)
+	ctx := context.Background()
+
+	_, err := client.OrderCreate(ctx, &desc.OrderCreateRequest{User: userID, Items: []*desc.Item{
+		{Sku: 1005, Count: 10},
+		{Sku: 1004, Count: 261},
+	}})
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// Резерв 1005 откатывается вместе с заказом
+	requireStock(t, client, 1005, &desc.WarehouseStock{Count: 300, TotalCount: 350, Reserved: 50})
+	requireStock(t, client, 1004, &desc.WarehouseStock{Count: 260, TotalCount: 300, Reserved: 40})
+
+	failed := requireFailedOrder(t, client)
+	assert.Len(t, failed.Items, 2)
+}
+
+func TestCreateOrderUnknownSKU(t *testing.T) {
+	client := newClient(t)
+	ctx := context.Background()
+
+	_, err := client.OrderCreate(ctx, &desc.OrderCreateRequest{User: userID, Items: []*desc.Item{
+		{Sku: 1002, Count: 1},
+		{Sku: 404, Count: 1},
+	}})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	requireStock(t, client, 1002, &desc.WarehouseStock{Count: 180, TotalCount: 200, Reserved: 20})
+	_, err = client.StocksInfo(ctx, &desc.StocksInfoRequest{Sku: 404})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	requireFailedOrder(t, client)
+}
+
+func TestCreateOrderInvalidRequest(t *testing.T) {
+	client := newClient(t)
+
+	_, err := client.OrderCreate(context.Background(), &desc.OrderCreateRequest{User: userID})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	resp, err := client.ListOrders(context.Background(), &desc.ListOrdersRequest{User: userID})
+	require.NoError(t, err)
+	assert.Empty(t, resp.Orders)
+}
+
+// requireFailedOrder проверяет, что у пользователя ровно один заказ и он в статусе FAILED
+func requireFailedOrder(t *testing.T, client desc.LomsClient) *desc.Order {
+	t.Helper()
+
+	resp, err := client.ListOrders(context.Background(), &desc.ListOrdersRequest{User: userID})
+	require.NoError(t, err)
+	require.Len(t, resp.Orders, 1)
+	assert.Equal(t, desc.OrderStatus_FAILED, resp.Orders[0].Status)
+	requireStatus(t, client, resp.Orders[0].OrderId, desc.OrderStatus_FAILED)
+	return resp.Orders[0]
+}
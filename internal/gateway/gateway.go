@@ -0,0 +1,54 @@
+package gateway
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	swaggerFiles "github.com/swaggo/files/v2"
+	desc "github.com/vestamart/loms/pkg/api/loms/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// swaggerInitializer заменяет одноименный файл Swagger UI, чтобы по умолчанию открывалась наша спецификация
+//
+//go:embed swagger-initializer.js
+var swaggerInitializer []byte
+
+// NewHandler возвращает HTTP-обработчик, который проксирует JSON-запросы в gRPC-сервер grpcAddr,
+// а по пути /swagger/ отдает Swagger UI и OpenAPI-спецификацию
+func NewHandler(ctx context.Context, grpcAddr string) (http.Handler, error) {
+	gwMux := runtime.NewServeMux(runtime.WithIncomingHeaderMatcher(headerMatcher))
+	opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+	if err := desc.RegisterLomsHandlerFromEndpoint(ctx, gwMux, grpcAddr, opts); err != nil {
+		return nil, fmt.Errorf("register gateway failed: %w", err)
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/", gwMux)
+	mux.HandleFunc("GET /swagger/loms.swagger.json", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(desc.SwaggerJSON)
+	})
+	mux.HandleFunc("GET /swagger/swagger-initializer.js", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "text/javascript")
+		_, _ = w.Write(swaggerInitializer)
+	})
+	mux.Handle("GET /swagger/", http.StripPrefix("/swagger/", http.FileServerFS(swaggerFiles.FS)))
+
+	return mux, nil
+}
+
+// headerMatcher пробрасывает заголовки Idempotency-Key, X-Request-Id и заголовки W3C trace context
+// в метаданные gRPC в дополнение к стандартным правилам grpc-gateway
+func headerMatcher(key string) (string, bool) {
+	switch strings.ToLower(key) {
+	case "idempotency-key", "x-request-id", "traceparent", "tracestate":
+		return strings.ToLower(key), true
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
@@ -0,0 +1,119 @@
+package gateway_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vestamart/loms/internal/gateway"
+	desc "github.com/vestamart/loms/pkg/api/loms/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+type stubServer struct {
+	desc.UnimplementedLomsServer
+	idempotencyKey string
+	requestID      string
+}
+
+func (s *stubServer) OrderInfo(_ context.Context, request *desc.OrderInfoRequest) (*desc.OrderInfoResponse, error) {
+	return &desc.OrderInfoResponse{
+		Status: desc.OrderStatus_AWAITING_PAYMENT,
+		User:   request.OrderId * 10,
+		Items:  []*desc.Item{{Sku: 1001, Count: 2}},
+	}, nil
+}
+
+func (s *stubServer) OrderPay(ctx context.Context, _ *desc.OrderPayRequest) (*desc.OrderPayResponse, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if v := md.Get("idempotency-key"); len(v) > 0 {
+		s.idempotencyKey = v[0]
+	}
+	if v := md.Get("x-request-id"); len(v) > 0 {
+		s.requestID = v[0]
+	}
+	return &desc.OrderPayResponse{}, nil
+}
+
+func newGateway(t *testing.T, server desc.LomsServer) *httptest.Server {
+	t.Helper()
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	grpcServer := grpc.NewServer()
+	desc.RegisterLomsServer(grpcServer, server)
+	go func() { _ = grpcServer.Serve(lis) }()
+	t.Cleanup(grpcServer.Stop)
+
+	handler, err := gateway.NewHandler(context.Background(), lis.Addr().String())
+	require.NoError(t, err)
+
+	httpServer := httptest.NewServer(handler)
+	t.Cleanup(httpServer.Close)
+	return httpServer
+}
+
+func TestGatewayProxiesRequests(t *testing.T) {
+	server := &stubServer{}
+	gw := newGateway(t, server)
+
+	resp, err := http.Get(gw.URL + "/v1/orders/5")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var info struct {
+		Status string `json:"status"`
+		User   string `json:"user"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&info))
+	assert.Equal(t, "AWAITING_PAYMENT", info.Status)
+	assert.Equal(t, "50", info.User)
+
+	req, err := http.NewRequest(http.MethodPost, gw.URL+"/v1/orders/5/pay", strings.NewReader("{}"))
+	require.NoError(t, err)
+	req.Header.Set("Idempotency-Key", "pay-5")
+	req.Header.Set("X-Request-Id", "req-5")
+	resp, err = http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "pay-5", server.idempotencyKey)
+	assert.Equal(t, "req-5", server.requestID)
+}
+
+func TestGatewayServesSwagger(t *testing.T) {
+	gw := newGateway(t, &stubServer{})
+
+	resp, err := http.Get(gw.URL + "/swagger/loms.swagger.json")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var spec struct {
+		Paths map[string]any `json:"paths"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&spec))
+	assert.Contains(t, spec.Paths, "/v1/orders/{orderId}")
+
+	resp, err = http.Get(gw.URL + "/swagger/")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	resp, err = http.Get(gw.URL + "/swagger/swagger-initializer.js")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Contains(t, string(body), "/swagger/loms.swagger.json")
+}
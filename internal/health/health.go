@@ -0,0 +1,147 @@
+package health
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// Pinger проверяет доступность зависимости, например пула соединений с базой
+type Pinger interface {
+	Ping(ctx context.Context) error
+}
+
+type Config struct {
+	Interval time.Duration
+	Timeout  time.Duration
+}
+
+// Checker периодически проверяет базу и выставляет статус grpc.health.v1 для сервера
+// в целом и для services. После Shutdown статус остается NOT_SERVING.
+type Checker struct {
+	server   *health.Server
+	db       Pinger
+	cfg      Config
+	services []string
+	ready    atomic.Bool
+
+	// mu упорядочивает смену статуса и Shutdown, чтобы завершившийся после Shutdown Ping не вернул SERVING
+	mu      sync.Mutex
+	stopped bool
+}
+
+func NewChecker(db Pinger, cfg Config, services ...string) *Checker {
+	c := &Checker{
+		server:   health.NewServer(),
+		db:       db,
+		cfg:      cfg,
+		services: append([]string{""}, services...),
+	}
+	c.setStatus(healthpb.HealthCheckResponse_NOT_SERVING)
+	return c
+}
+
+// Server возвращает реализацию grpc.health.v1 для регистрации на gRPC-сервере
+func (c *Checker) Server() *health.Server {
+	return c.server
+}
+
+// Run проверяет базу сразу и затем каждые Interval до отмены ctx
+func (c *Checker) Run(ctx context.Context) {
+	ticker := time.NewTicker(c.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		c.Check(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Check пингует базу и обновляет статус
+func (c *Checker) Check(ctx context.Context) {
+	if c.isStopped() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.cfg.Timeout)
+	defer cancel()
+
+	if err := c.db.Ping(ctx); err != nil {
+		if c.ready.Load() {
+			slog.ErrorContext(ctx, "health: database is unavailable", "error", err)
+		}
+		c.setStatus(healthpb.HealthCheckResponse_NOT_SERVING)
+		return
+	}
+
+	if !c.ready.Load() {
+		slog.InfoContext(ctx, "health: database is available")
+	}
+	c.setStatus(healthpb.HealthCheckResponse_SERVING)
+}
+
+// Shutdown переводит сервис в NOT_SERVING перед остановкой, чтобы балансировщик перестал слать запросы
+func (c *Checker) Shutdown() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.stopped = true
+	c.ready.Store(false)
+	c.server.Shutdown()
+}
+
+func (c *Checker) isStopped() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.stopped
+}
+
+// Ready сообщает, готов ли сервис принимать запросы
+func (c *Checker) Ready() bool {
+	return c.ready.Load()
+}
+
+// LivenessHandler отвечает 200, пока процесс жив
+func (c *Checker) LivenessHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte("ok"))
+	})
+}
+
+// ReadinessHandler отвечает 200, если база доступна и сервис не останавливается, иначе 503
+func (c *Checker) ReadinessHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if !c.Ready() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			_, _ = w.Write([]byte("not ready"))
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte("ok"))
+	})
+}
+
+// setStatus ничего не меняет после Shutdown
+func (c *Checker) setStatus(status healthpb.HealthCheckResponse_ServingStatus) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.stopped {
+		return
+	}
+	c.ready.Store(status == healthpb.HealthCheckResponse_SERVING)
+	for _, service := range c.services {
+		c.server.SetServingStatus(service, status)
+	}
+}
@@ -0,0 +1,101 @@
+package health_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vestamart/loms/internal/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+type fakePinger struct {
+	err error
+}
+
+func (p *fakePinger) Ping(context.Context) error {
+	return p.err
+}
+
+func servingStatus(t *testing.T, checker *health.Checker, service string) healthpb.HealthCheckResponse_ServingStatus {
+	t.Helper()
+
+	resp, err := checker.Server().Check(context.Background(), &healthpb.HealthCheckRequest{Service: service})
+	require.NoError(t, err)
+	return resp.Status
+}
+
+func readyz(checker *health.Checker) int {
+	rec := httptest.NewRecorder()
+	checker.ReadinessHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	return rec.Code
+}
+
+func TestCheckerFollowsDatabase(t *testing.T) {
+	db := &fakePinger{}
+	checker := health.NewChecker(db, health.Config{Interval: time.Second, Timeout: time.Second}, "Loms")
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, servingStatus(t, checker, "Loms"))
+	assert.Equal(t, http.StatusServiceUnavailable, readyz(checker))
+
+	checker.Check(context.Background())
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, servingStatus(t, checker, ""))
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, servingStatus(t, checker, "Loms"))
+	assert.Equal(t, http.StatusOK, readyz(checker))
+
+	db.err = errors.New("connection refused")
+	checker.Check(context.Background())
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, servingStatus(t, checker, "Loms"))
+	assert.Equal(t, http.StatusServiceUnavailable, readyz(checker))
+}
+
+func TestCheckerShutdown(t *testing.T) {
+	checker := health.NewChecker(&fakePinger{}, health.Config{Interval: time.Second, Timeout: time.Second}, "Loms")
+	checker.Check(context.Background())
+	require.True(t, checker.Ready())
+
+	checker.Shutdown()
+	checker.Check(context.Background())
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, servingStatus(t, checker, "Loms"))
+	assert.Equal(t, http.StatusServiceUnavailable, readyz(checker))
+
+	rec := httptest.NewRecorder()
+	checker.LivenessHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
+
+// blockingPinger держит Ping до закрытия release
+type blockingPinger struct {
+	started chan struct{}
+	release chan struct{}
+}
+
+func (p *blockingPinger) Ping(context.Context) error {
+	close(p.started)
+	<-p.release
+	return nil
+}
+
+func TestCheckerShutdownDuringPing(t *testing.T) {
+	db := &blockingPinger{started: make(chan struct{}), release: make(chan struct{})}
+	checker := health.NewChecker(db, health.Config{Interval: time.Second, Timeout: time.Second}, "Loms")
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		checker.Check(context.Background())
+	}()
+
+	<-db.started
+	checker.Shutdown()
+	close(db.release)
+	<-done
+
+	assert.False(t, checker.Ready())
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, servingStatus(t, checker, "Loms"))
+	assert.Equal(t, http.StatusServiceUnavailable, readyz(checker))
+}
@@ -0,0 +1,14 @@
+package kafka
+
+import (
+	"context"
+	"log/slog"
+)
+
+// LogProducer пишет события в лог вместо брокера. Используется, когда Kafka отключена
+type LogProducer struct{}
+
+func (LogProducer) Send(ctx context.Context, key string, payload []byte) error {
+	slog.InfoContext(ctx, "kafka is disabled, event is not published", "key", key, "payload", string(payload))
+	return nil
+}
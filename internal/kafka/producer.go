@@ -0,0 +1,46 @@
+package kafka
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/IBM/sarama"
+)
+
+// Producer синхронный продюсер, отправляющий сообщения в один топик
+type Producer struct {
+	producer sarama.SyncProducer
+	topic    string
+}
+
+func NewProducer(brokers []string, topic string) (*Producer, error) {
+	cfg := sarama.NewConfig()
+	cfg.Producer.RequiredAcks = sarama.WaitForAll
+	cfg.Producer.Partitioner = sarama.NewHashPartitioner
+	cfg.Producer.Return.Successes = true
+	cfg.Producer.Retry.Max = 3
+
+	producer, err := sarama.NewSyncProducer(brokers, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("create kafka producer failed: %w", err)
+	}
+
+	return &Producer{producer: producer, topic: topic}, nil
+}
+
+func (p *Producer) Send(_ context.Context, key string, payload []byte) error {
+	_, _, err := p.producer.SendMessage(&sarama.ProducerMessage{
+		Topic: p.topic,
+		Key:   sarama.StringEncoder(key),
+		Value: sarama.ByteEncoder(payload),
+	})
+	if err != nil {
+		return fmt.Errorf("send message failed: %w", err)
+	}
+
+	return nil
+}
+
+func (p *Producer) Close() error {
+	return p.producer.Close()
+}
@@ -0,0 +1,146 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// Manager запускает фоновые воркеры и останавливает компоненты сервиса в порядке, обратном регистрации,
+// как defer: сначала то, что зарегистрировано последним
+type Manager struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+	// closeTimeout время на освобождение ресурса, отсчитывается отдельно от общего дедлайна остановки
+	closeTimeout time.Duration
+
+	mu    sync.Mutex
+	steps []step
+}
+
+type step struct {
+	name string
+	fn   func(ctx context.Context) error
+	// closer шаг освобождает ресурс, которым пользуются воркеры
+	closer bool
+}
+
+func NewManager(closeTimeout time.Duration) *Manager {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Manager{ctx: ctx, cancel: cancel, closeTimeout: closeTimeout}
+}
+
+// Context отменяется при остановке воркеров
+func (m *Manager) Context() context.Context {
+	return m.ctx
+}
+
+// Go запускает фоновый воркер, fn должна вернуться после отмены ctx
+func (m *Manager) Go(name string, fn func(ctx context.Context)) {
+	m.wg.Add(1)
+	go func() {
+		defer m.wg.Done()
+		fn(m.ctx)
+		slog.Debug("lifecycle: worker stopped", "worker", name)
+	}()
+}
+
+// OnShutdown добавляет шаг остановки. Шаги выполняются в обратном порядке добавления
+func (m *Manager) OnShutdown(name string, fn func(ctx context.Context) error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.steps = append(m.steps, step{name: name, fn: fn})
+}
+
+// OnClose добавляет шаг освобождения ресурса, которым пользуются воркеры: пула соединений, продюсера.
+// Перед таким шагом Manager дожидается остановки воркеров, даже если общий дедлайн уже истек,
+// а ожидание и сам шаг ограничены отдельным таймаутом closeTimeout
+func (m *Manager) OnClose(name string, fn func(ctx context.Context) error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.steps = append(m.steps, step{name: name, fn: fn, closer: true})
+}
+
+// StopWorkers отменяет контекст воркеров и ждет их завершения, но не дольше ctx
+func (m *Manager) StopWorkers(ctx context.Context) error {
+	m.cancel()
+
+	done := make(chan struct{})
+	go func() {
+		m.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("workers did not stop: %w", ctx.Err())
+	}
+}
+
+// Shutdown выполняет все шаги остановки с общим дедлайном ctx, шаги OnClose - со своим таймаутом.
+// Ошибка шага не прерывает остальные, чтобы ресурсы освобождались даже после зависшего компонента
+func (m *Manager) Shutdown(ctx context.Context) error {
+	m.mu.Lock()
+	steps := m.steps
+	m.mu.Unlock()
+
+	var errs []error
+	for i := len(steps) - 1; i >= 0; i-- {
+		s := steps[i]
+		if err := m.runStep(ctx, s); err != nil {
+			slog.ErrorContext(ctx, "lifecycle: shutdown step failed", "step", s.name, "error", err)
+			errs = append(errs, fmt.Errorf("%s: %w", s.name, err))
+			continue
+		}
+		slog.InfoContext(ctx, "lifecycle: shutdown step done", "step", s.name)
+	}
+	// воркеры останавливаются всегда, даже если для них не добавлен шаг
+	m.cancel()
+
+	return errors.Join(errs...)
+}
+
+func (m *Manager) runStep(ctx context.Context, s step) error {
+	if !s.closer {
+		return s.fn(ctx)
+	}
+
+	// Общий дедлайн мог уйти на остановку серверов, но закрывать ресурс под работающим воркером нельзя
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), m.closeTimeout)
+	defer cancel()
+	if err := m.StopWorkers(ctx); err != nil {
+		return fmt.Errorf("not closed: %w", err)
+	}
+	return s.fn(ctx)
+}
+
+// GRPCServer - часть *grpc.Server, нужная для остановки
+type GRPCServer interface {
+	GracefulStop()
+	Stop()
+}
+
+// StopGRPC перестает принимать новые RPC и ждет завершения текущих. Если ctx истекает раньше,
+// оставшиеся RPC прерываются через Stop
+func StopGRPC(ctx context.Context, server GRPCServer) error {
+	done := make(chan struct{})
+	go func() {
+		server.GracefulStop()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		server.Stop()
+		<-done
+		return fmt.Errorf("forced gRPC stop: %w", ctx.Err())
+	}
+}
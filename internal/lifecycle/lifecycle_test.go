@@ -0,0 +1,204 @@
+package lifecycle_test
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vestamart/loms/internal/lifecycle"
+	desc "github.com/vestamart/loms/pkg/api/loms/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+func TestShutdownRunsStepsInReverseOrder(t *testing.T) {
+	app := lifecycle.NewManager(time.Second)
+
+	var order []string
+	for _, name := range []string{"database", "workers", "grpc server"} {
+		app.OnShutdown(name, func(context.Context) error {
+			order = append(order, name)
+			return nil
+		})
+	}
+
+	require.NoError(t, app.Shutdown(context.Background()))
+	assert.Equal(t, []string{"grpc server", "workers", "database"}, order)
+}
+
+func TestShutdownContinuesAfterFailedStep(t *testing.T) {
+	app := lifecycle.NewManager(time.Second)
+
+	var closed bool
+	app.OnShutdown("database", func(context.Context) error {
+		closed = true
+		return nil
+	})
+	app.OnShutdown("kafka producer", func(context.Context) error {
+		return errors.New("broker unavailable")
+	})
+
+	err := app.Shutdown(context.Background())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "kafka producer: broker unavailable")
+	assert.True(t, closed)
+}
+
+func TestStopWorkersWaitsForWorkers(t *testing.T) {
+	app := lifecycle.NewManager(time.Second)
+
+	var stopped atomic.Int32
+	for i := 0; i < 3; i++ {
+		app.Go("worker", func(ctx context.Context) {
+			<-ctx.Done()
+			time.Sleep(10 * time.Millisecond)
+			stopped.Add(1)
+		})
+	}
+	app.OnShutdown("workers", app.StopWorkers)
+
+	require.NoError(t, app.Shutdown(context.Background()))
+	assert.EqualValues(t, 3, stopped.Load())
+	assert.Error(t, app.Context().Err())
+}
+
+// Медленный шаг исчерпал общий дедлайн, но ресурс закрывается только после остановки воркера
+func TestShutdownClosesResourcesAfterWorkers(t *testing.T) {
+	app := lifecycle.NewManager(time.Second)
+
+	var workerDone, closedAfterWorker atomic.Bool
+	app.OnClose("database", func(ctx context.Context) error {
+		closedAfterWorker.Store(workerDone.Load())
+		return ctx.Err()
+	})
+	app.OnShutdown("workers", app.StopWorkers)
+	app.Go("worker", func(ctx context.Context) {
+		<-ctx.Done()
+		time.Sleep(50 * time.Millisecond)
+		workerDone.Store(true)
+	})
+	app.OnShutdown("grpc server", func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	err := app.Shutdown(ctx)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "grpc server")
+	assert.Contains(t, err.Error(), "workers")
+	assert.NotContains(t, err.Error(), "database")
+	assert.True(t, closedAfterWorker.Load(), "database must be closed after the worker stopped")
+}
+
+func TestShutdownSkipsCloseWithStuckWorker(t *testing.T) {
+	app := lifecycle.NewManager(20 * time.Millisecond)
+
+	release := make(chan struct{})
+	defer close(release)
+	var closed atomic.Bool
+	app.OnClose("database", func(context.Context) error {
+		closed.Store(true)
+		return nil
+	})
+	app.Go("stuck worker", func(context.Context) {
+		<-release
+	})
+
+	err := app.Shutdown(context.Background())
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.False(t, closed.Load(), "resource must not be closed under a running worker")
+}
+
+func TestStopWorkersDeadline(t *testing.T) {
+	app := lifecycle.NewManager(time.Second)
+
+	release := make(chan struct{})
+	defer close(release)
+	app.Go("stuck worker", func(context.Context) {
+		<-release
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	err := app.StopWorkers(ctx)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+type fakeGRPCServer struct {
+	release chan struct{}
+	forced  atomic.Bool
+}
+
+func (s *fakeGRPCServer) GracefulStop() {
+	<-s.release
+}
+
+func (s *fakeGRPCServer) Stop() {
+	s.forced.Store(true)
+	close(s.release)
+}
+
+func TestStopGRPC(t *testing.T) {
+	t.Run("graceful", func(t *testing.T) {
+		server := &fakeGRPCServer{release: make(chan struct{})}
+		close(server.release)
+
+		require.NoError(t, lifecycle.StopGRPC(context.Background(), server))
+		assert.False(t, server.forced.Load())
+	})
+
+	t.Run("forced after deadline", func(t *testing.T) {
+		server := &fakeGRPCServer{release: make(chan struct{})}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+		err := lifecycle.StopGRPC(ctx, server)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.True(t, server.forced.Load())
+	})
+}
+
+type hungServer struct {
+	desc.UnimplementedLomsServer
+	started chan struct{}
+}
+
+func (s *hungServer) OrderInfo(ctx context.Context, _ *desc.OrderInfoRequest) (*desc.OrderInfoResponse, error) {
+	close(s.started)
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+func TestStopGRPCInterruptsHungRequest(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	server := &hungServer{started: make(chan struct{})}
+	grpcServer := grpc.NewServer()
+	desc.RegisterLomsServer(grpcServer, server)
+	go func() { _ = grpcServer.Serve(lis) }()
+
+	conn, err := grpc.NewClient(lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+
+	callErr := make(chan error, 1)
+	go func() {
+		_, err := desc.NewLomsClient(conn).OrderInfo(context.Background(), &desc.OrderInfoRequest{OrderId: 1})
+		callErr <- err
+	}()
+	<-server.started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	err = lifecycle.StopGRPC(ctx, grpcServer)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Error(t, <-callErr)
+}
@@ -7,3 +7,21 @@ var ItemNotEnoughErr = errors.New("item not enough")
 var SKUNotExistErr = errors.New("sku not exist")
 
 var OrderNotFoundErr = errors.New("order not found")
+
+var InvalidStatusTransitionErr = errors.New("invalid order status transition")
+
+var InvalidPageTokenErr = errors.New("invalid page token")
+
+var StockAlreadyExistErr = errors.New("stock already exist")
+
+var WarehouseNotExistErr = errors.New("warehouse not exist")
+
+var StockBelowReservedErr = errors.New("total count below reserved")
+
+var StockReservedErr = errors.New("stock has active reservations")
+
+var ReservedNotEnoughErr = errors.New("reserved not enough")
+
+var StockOverflowErr = errors.New("total count overflow")
+
+var IdempotencyLeaseLostErr = errors.New("idempotency key lease lost")
@@ -0,0 +1,27 @@
+package logger
+
+import "context"
+
+type (
+	requestIDKey struct{}
+	orderIDKey   struct{}
+	userIDKey    struct{}
+)
+
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, requestID)
+}
+
+// RequestID возвращает идентификатор запроса из контекста или пустую строку
+func RequestID(ctx context.Context) string {
+	v, _ := ctx.Value(requestIDKey{}).(string)
+	return v
+}
+
+func WithOrderID(ctx context.Context, orderID int64) context.Context {
+	return context.WithValue(ctx, orderIDKey{}, orderID)
+}
+
+func WithUserID(ctx context.Context, userID int64) context.Context {
+	return context.WithValue(ctx, userIDKey{}, userID)
+}
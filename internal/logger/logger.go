@@ -0,0 +1,66 @@
+package logger
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"strings"
+
+	"github.com/vestamart/loms/internal/config"
+)
+
+const (
+	FormatJSON = "json"
+	FormatText = "text"
+)
+
+// New создает логгер с уровнем и форматом из конфига. Каждая запись дополняется
+// request_id, order_id и user_id из контекста, если они там есть.
+func New(w io.Writer, cfg config.LoggerConfig) (*slog.Logger, error) {
+	var level slog.Level
+	if cfg.Level != "" {
+		if err := level.UnmarshalText([]byte(cfg.Level)); err != nil {
+			return nil, fmt.Errorf("parse log level: %w", err)
+		}
+	}
+
+	opts := &slog.HandlerOptions{Level: level}
+	var handler slog.Handler
+	switch strings.ToLower(cfg.Format) {
+	case FormatJSON, "":
+		handler = slog.NewJSONHandler(w, opts)
+	case FormatText:
+		handler = slog.NewTextHandler(w, opts)
+	default:
+		return nil, fmt.Errorf("unknown log format %q", cfg.Format)
+	}
+
+	return slog.New(contextHandler{Handler: handler}), nil
+}
+
+// contextHandler добавляет в запись поля, сохраненные в контексте
+type contextHandler struct {
+	slog.Handler
+}
+
+func (h contextHandler) Handle(ctx context.Context, record slog.Record) error {
+	if v, ok := ctx.Value(requestIDKey{}).(string); ok {
+		record.AddAttrs(slog.String("request_id", v))
+	}
+	if v, ok := ctx.Value(orderIDKey{}).(int64); ok {
+		record.AddAttrs(slog.Int64("order_id", v))
+	}
+	if v, ok := ctx.Value(userIDKey{}).(int64); ok {
+		record.AddAttrs(slog.Int64("user_id", v))
+	}
+	return h.Handler.Handle(ctx, record)
+}
+
+func (h contextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return contextHandler{Handler: h.Handler.WithAttrs(attrs)}
+}
+
+func (h contextHandler) WithGroup(name string) slog.Handler {
+	return contextHandler{Handler: h.Handler.WithGroup(name)}
+}
@@ -0,0 +1,40 @@
+package logger_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vestamart/loms/internal/config"
+	"github.com/vestamart/loms/internal/logger"
+)
+
+func TestLoggerAddsContextFields(t *testing.T) {
+	var buf bytes.Buffer
+	log, err := logger.New(&buf, config.LoggerConfig{Level: "info", Format: "json"})
+	require.NoError(t, err)
+
+	ctx := logger.WithRequestID(context.Background(), "req-1")
+	ctx = logger.WithUserID(ctx, 42)
+	ctx = logger.WithOrderID(ctx, 7)
+	log.DebugContext(ctx, "skipped")
+	log.InfoContext(ctx, "order paid")
+
+	var record map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &record))
+	assert.Equal(t, "order paid", record["msg"])
+	assert.Equal(t, "req-1", record["request_id"])
+	assert.EqualValues(t, 42, record["user_id"])
+	assert.EqualValues(t, 7, record["order_id"])
+}
+
+func TestLoggerRejectsUnknownSettings(t *testing.T) {
+	_, err := logger.New(&bytes.Buffer{}, config.LoggerConfig{Level: "verbose"})
+	assert.Error(t, err)
+
+	_, err = logger.New(&bytes.Buffer{}, config.LoggerConfig{Format: "xml"})
+	assert.Error(t, err)
+}
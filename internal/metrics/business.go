@@ -0,0 +1,114 @@
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/vestamart/loms/internal/domain"
+)
+
+type OrderStats interface {
+	CountByStatus(ctx context.Context) (map[domain.OrderStatus]int64, error)
+}
+
+type StockStats interface {
+	GetWarehouseTotals(ctx context.Context) (map[uint32]domain.StocksItem, error)
+}
+
+var (
+	ordersDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "", "orders"),
+		"Количество заказов в статусе.",
+		[]string{"status"}, nil,
+	)
+	stockTotalDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "stock", "total"),
+		"Общее количество товара на складе.",
+		[]string{"warehouse_id"}, nil,
+	)
+	stockReservedDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "stock", "reserved"),
+		"Зарезервированное количество товара на складе.",
+		[]string{"warehouse_id"}, nil,
+	)
+)
+
+// BusinessCollector отдает количество заказов по статусам и стоки по складам из снимка.
+// Снимок обновляет Run раз в interval, поэтому scrape не ходит в хранилище.
+type BusinessCollector struct {
+	orders   OrderStats
+	stocks   StockStats
+	timeout  time.Duration
+	interval time.Duration
+
+	mu     sync.RWMutex
+	counts map[domain.OrderStatus]int64
+	totals map[uint32]domain.StocksItem
+}
+
+func NewBusinessCollector(orders OrderStats, stocks StockStats, timeout, interval time.Duration) *BusinessCollector {
+	return &BusinessCollector{orders: orders, stocks: stocks, timeout: timeout, interval: interval}
+}
+
+// Run обновляет снимок сразу и затем раз в interval до отмены ctx
+func (c *BusinessCollector) Run(ctx context.Context) {
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := c.Refresh(ctx); err != nil && ctx.Err() == nil {
+			slog.ErrorContext(ctx, "refresh business metrics", "error", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Refresh перечитывает снимок из хранилища. При ошибке остается предыдущий снимок.
+func (c *BusinessCollector) Refresh(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	counts, err := c.orders.CountByStatus(ctx)
+	if err != nil {
+		return fmt.Errorf("count orders by status: %w", err)
+	}
+	totals, err := c.stocks.GetWarehouseTotals(ctx)
+	if err != nil {
+		return fmt.Errorf("get warehouse totals: %w", err)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.counts = counts
+	c.totals = totals
+	return nil
+}
+
+func (c *BusinessCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- ordersDesc
+	ch <- stockTotalDesc
+	ch <- stockReservedDesc
+}
+
+func (c *BusinessCollector) Collect(ch chan<- prometheus.Metric) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	for status, count := range c.counts {
+		ch <- prometheus.MustNewConstMetric(ordersDesc, prometheus.GaugeValue, float64(count), status.String())
+	}
+	for warehouseID, v := range c.totals {
+		label := strconv.FormatUint(uint64(warehouseID), 10)
+		ch <- prometheus.MustNewConstMetric(stockTotalDesc, prometheus.GaugeValue, float64(v.TotalCount), label)
+		ch <- prometheus.MustNewConstMetric(stockReservedDesc, prometheus.GaugeValue, float64(v.Reserved), label)
+	}
+}
@@ -0,0 +1,47 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const namespace = "loms"
+
+var (
+	GRPCRequestsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "grpc",
+		Name:      "requests_total",
+		Help:      "Количество обработанных gRPC-запросов.",
+	}, []string{"method", "code"})
+
+	GRPCRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "grpc",
+		Name:      "request_duration_seconds",
+		Help:      "Время обработки gRPC-запросов.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "code"})
+
+	PanicsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "grpc",
+		Name:      "panics_total",
+		Help:      "Количество паник, перехваченных в обработчиках gRPC.",
+	}, []string{"method"})
+
+	RepositoryDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "repository",
+		Name:      "duration_seconds",
+		Help:      "Время выполнения методов репозиториев.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"repository", "method"})
+
+	RepositoryErrorsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "repository",
+		Name:      "errors_total",
+		Help:      "Количество ошибок методов репозиториев.",
+	}, []string{"repository", "method"})
+)
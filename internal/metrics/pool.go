@@ -0,0 +1,75 @@
+package metrics
+
+import (
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// PoolStater отдает статистику пула соединений, реализуется *pgxpool.Pool
+type PoolStater interface {
+	Stat() *pgxpool.Stat
+}
+
+var (
+	poolConnsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "db_pool", "conns"),
+		"Соединения пула по состоянию.",
+		[]string{"state"}, nil,
+	)
+	poolMaxConnsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "db_pool", "max_conns"),
+		"Максимальный размер пула.",
+		nil, nil,
+	)
+	poolAcquiresDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "db_pool", "acquires_total"),
+		"Количество успешных получений соединения из пула.",
+		nil, nil,
+	)
+	poolEmptyAcquiresDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "db_pool", "empty_acquires_total"),
+		"Количество получений соединения, которым пришлось ждать или создавать соединение.",
+		nil, nil,
+	)
+	poolCanceledAcquiresDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "db_pool", "canceled_acquires_total"),
+		"Количество получений соединения, отмененных контекстом.",
+		nil, nil,
+	)
+	poolAcquireSecondsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "db_pool", "acquire_seconds_total"),
+		"Суммарное время получения соединений из пула.",
+		nil, nil,
+	)
+)
+
+// PoolCollector отдает статистику пула соединений Postgres при каждом scrape
+type PoolCollector struct {
+	pool PoolStater
+}
+
+func NewPoolCollector(pool PoolStater) *PoolCollector {
+	return &PoolCollector{pool: pool}
+}
+
+func (c *PoolCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- poolConnsDesc
+	ch <- poolMaxConnsDesc
+	ch <- poolAcquiresDesc
+	ch <- poolEmptyAcquiresDesc
+	ch <- poolCanceledAcquiresDesc
+	ch <- poolAcquireSecondsDesc
+}
+
+func (c *PoolCollector) Collect(ch chan<- prometheus.Metric) {
+	stat := c.pool.Stat()
+
+	ch <- prometheus.MustNewConstMetric(poolConnsDesc, prometheus.GaugeValue, float64(stat.AcquiredConns()), "acquired")
+	ch <- prometheus.MustNewConstMetric(poolConnsDesc, prometheus.GaugeValue, float64(stat.IdleConns()), "idle")
+	ch <- prometheus.MustNewConstMetric(poolConnsDesc, prometheus.GaugeValue, float64(stat.ConstructingConns()), "constructing")
+	ch <- prometheus.MustNewConstMetric(poolMaxConnsDesc, prometheus.GaugeValue, float64(stat.MaxConns()))
+	ch <- prometheus.MustNewConstMetric(poolAcquiresDesc, prometheus.CounterValue, float64(stat.AcquireCount()))
+	ch <- prometheus.MustNewConstMetric(poolEmptyAcquiresDesc, prometheus.CounterValue, float64(stat.EmptyAcquireCount()))
+	ch <- prometheus.MustNewConstMetric(poolCanceledAcquiresDesc, prometheus.CounterValue, float64(stat.CanceledAcquireCount()))
+	ch <- prometheus.MustNewConstMetric(poolAcquireSecondsDesc, prometheus.CounterValue, stat.AcquireDuration().Seconds())
+}
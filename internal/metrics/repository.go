@@ -0,0 +1,110 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/vestamart/loms/internal/app/loms"
+	"github.com/vestamart/loms/internal/domain"
+)
+
+// observe записывает время выполнения и ошибку метода репозитория
+func observe(repository, method string, start time.Time, err error) {
+	RepositoryDuration.WithLabelValues(repository, method).Observe(time.Since(start).Seconds())
+	if err != nil {
+		RepositoryErrorsTotal.WithLabelValues(repository, method).Inc()
+	}
+}
+
+// OrdersRepository добавляет метрики к вызовам loms.OrdersRepository
+type OrdersRepository struct {
+	repo loms.OrdersRepository
+}
+
+func NewOrdersRepository(repo loms.OrdersRepository) *OrdersRepository {
+	return &OrdersRepository{repo: repo}
+}
+
+func (r OrdersRepository) Create(ctx context.Context, userID int64, items *[]domain.Item) (orderID int64, err error) {
+	defer func(start time.Time) { observe("orders", "Create", start, err) }(time.Now())
+	return r.repo.Create(ctx, userID, items)
+}
+
+func (r OrdersRepository) SetStatus(ctx context.Context, orderID int64, expected, status domain.OrderStatus, reason string) (err error) {
+	defer func(start time.Time) { observe("orders", "SetStatus", start, err) }(time.Now())
+	return r.repo.SetStatus(ctx, orderID, expected, status, reason)
+}
+
+func (r OrdersRepository) GetByID(ctx context.Context, orderID int64) (order *domain.Order, err error) {
+	defer func(start time.Time) { observe("orders", "GetByID", start, err) }(time.Now())
+	return r.repo.GetByID(ctx, orderID)
+}
+
+func (r OrdersRepository) History(ctx context.Context, orderID int64) (history []domain.StatusChange, err error) {
+	defer func(start time.Time) { observe("orders", "History", start, err) }(time.Now())
+	return r.repo.History(ctx, orderID)
+}
+
+func (r OrdersRepository) List(ctx context.Context, filter domain.OrderFilter) (orders []domain.Order, err error) {
+	defer func(start time.Time) { observe("orders", "List", start, err) }(time.Now())
+	return r.repo.List(ctx, filter)
+}
+
+func (r OrdersRepository) ListExpired(ctx context.Context, status domain.OrderStatus, updatedBefore time.Time, exclude []int64, limit int32) (ids []int64, err error) {
+	defer func(start time.Time) { observe("orders", "ListExpired", start, err) }(time.Now())
+	return r.repo.ListExpired(ctx, status, updatedBefore, exclude, limit)
+}
+
+// StocksStorage добавляет метрики к вызовам loms.StocksStorage
+type StocksStorage struct {
+	repo loms.StocksStorage
+}
+
+func NewStocksStorage(repo loms.StocksStorage) *StocksStorage {
+	return &StocksStorage{repo: repo}
+}
+
+func (r StocksStorage) Reserve(ctx context.Context, key domain.StockKey, count uint32) (err error) {
+	defer func(start time.Time) { observe("stocks", "Reserve", start, err) }(time.Now())
+	return r.repo.Reserve(ctx, key, count)
+}
+
+func (r StocksStorage) ReserveRemove(ctx context.Context, items map[domain.StockKey]uint32) (err error) {
+	defer func(start time.Time) { observe("stocks", "ReserveRemove", start, err) }(time.Now())
+	return r.repo.ReserveRemove(ctx, items)
+}
+
+func (r StocksStorage) ReserveCancel(ctx context.Context, items map[domain.StockKey]uint32) (err error) {
+	defer func(start time.Time) { observe("stocks", "ReserveCancel", start, err) }(time.Now())
+	return r.repo.ReserveCancel(ctx, items)
+}
+
+func (r StocksStorage) GetWarehouseStocks(ctx context.Context, sku uint32) (stocks []domain.WarehouseStock, err error) {
+	defer func(start time.Time) { observe("stocks", "GetWarehouseStocks", start, err) }(time.Now())
+	return r.repo.GetWarehouseStocks(ctx, sku)
+}
+
+func (r StocksStorage) GetBySKUs(ctx context.Context, skus []uint32) (stocks map[uint32]domain.StocksItem, err error) {
+	defer func(start time.Time) { observe("stocks", "GetBySKUs", start, err) }(time.Now())
+	return r.repo.GetBySKUs(ctx, skus)
+}
+
+func (r StocksStorage) Create(ctx context.Context, key domain.StockKey, totalCount uint32) (err error) {
+	defer func(start time.Time) { observe("stocks", "Create", start, err) }(time.Now())
+	return r.repo.Create(ctx, key, totalCount)
+}
+
+func (r StocksStorage) Replenish(ctx context.Context, key domain.StockKey, count uint32) (stock domain.StocksItem, err error) {
+	defer func(start time.Time) { observe("stocks", "Replenish", start, err) }(time.Now())
+	return r.repo.Replenish(ctx, key, count)
+}
+
+func (r StocksStorage) Adjust(ctx context.Context, key domain.StockKey, delta int32, reason string) (stock domain.StocksItem, err error) {
+	defer func(start time.Time) { observe("stocks", "Adjust", start, err) }(time.Now())
+	return r.repo.Adjust(ctx, key, delta, reason)
+}
+
+func (r StocksStorage) Delete(ctx context.Context, key domain.StockKey) (err error) {
+	defer func(start time.Time) { observe("stocks", "Delete", start, err) }(time.Now())
+	return r.repo.Delete(ctx, key)
+}
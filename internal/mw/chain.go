@@ -0,0 +1,37 @@
+package mw
+
+import (
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+// ChainConfig настройки интерцепторов, которым нужны зависимости или конфиг
+type ChainConfig struct {
+	LogPayloads  bool
+	RedactFields []string
+
+	IdempotencyStore       IdempotencyStore
+	IdempotencyLockTimeout time.Duration
+	IdempotencyTTL         time.Duration
+}
+
+// ServerOptions возвращает цепочки интерцепторов gRPC-сервера LOMS. Порядок важен: трейсинг и
+// метрики видят все запросы, request id и recovery нужны логированию, идемпотентность
+// сохраняет ответы только для валидных запросов
+func ServerOptions(cfg ChainConfig) []grpc.ServerOption {
+	return []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(
+			Tracing,
+			Metrics,
+			RequestID,
+			Panic,
+			Logger(cfg.LogPayloads, cfg.RedactFields),
+			Validate,
+			Idempotency(cfg.IdempotencyStore, cfg.IdempotencyLockTimeout, cfg.IdempotencyTTL),
+		),
+		grpc.ChainStreamInterceptor(
+			PanicStream,
+		),
+	}
+}
@@ -2,19 +2,92 @@ package mw
 
 import (
 	"context"
-	"github.com/jackc/pgx/v5"
+	"fmt"
+	"log/slog"
 	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/vestamart/loms/internal/config"
 )
 
-func ConnectWithRetry(ctx context.Context, dsn string, maxAttempts int, delay time.Duration) (*pgx.Conn, error) {
-	var conn *pgx.Conn
-	var err error
-	for i := 0; i < maxAttempts; i++ {
-		conn, err = pgx.Connect(ctx, dsn)
-		if err == nil {
-			return conn, nil
+// NewPoolConfig собирает настройки пула соединений из конфига
+func NewPoolConfig(cfg config.DatabaseConfig) (*pgxpool.Config, error) {
+	poolCfg, err := pgxpool.ParseConfig(cfg.DSN())
+	if err != nil {
+		return nil, fmt.Errorf("parse database config: %w", err)
+	}
+
+	if cfg.MaxConns > 0 {
+		poolCfg.MaxConns = cfg.MaxConns
+	}
+	if cfg.MinConns > 0 {
+		poolCfg.MinConns = cfg.MinConns
+	}
+	if cfg.MaxConnIdleTime > 0 {
+		poolCfg.MaxConnIdleTime = cfg.MaxConnIdleTime
+	}
+	if cfg.MaxConnLifetime > 0 {
+		poolCfg.MaxConnLifetime = cfg.MaxConnLifetime
+	}
+	if cfg.HealthCheckPeriod > 0 {
+		poolCfg.HealthCheckPeriod = cfg.HealthCheckPeriod
+	}
+
+	return poolCfg, nil
+}
+
+// ConnectWithRetry создает пул и ждет, пока база станет доступна.
+// Задержка между попытками удваивается, начиная с delay, но не превышает maxDelay.
+func ConnectWithRetry(ctx context.Context, poolCfg *pgxpool.Config, maxAttempts int, delay, maxDelay time.Duration) (*pgxpool.Pool, error) {
+	delay = min(delay, maxDelay)
+
+	pool, err := pgxpool.NewWithConfig(ctx, poolCfg)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := 1; ; i++ {
+		if err = pool.Ping(ctx); err == nil {
+			return pool, nil
+		}
+		if i >= maxAttempts {
+			break
 		}
-		time.Sleep(delay)
+
+		slog.WarnContext(ctx, "database is unavailable", "attempt", i, "max_attempts", maxAttempts, "error", err)
+		select {
+		case <-ctx.Done():
+			pool.Close()
+			return nil, ctx.Err()
+		case <-time.After(delay):
+		}
+		delay = min(delay*2, maxDelay)
 	}
+
+	pool.Close()
 	return nil, err
 }
+
+// LogPoolStats периодически пишет в лог статистику пула до отмены ctx
+func LogPoolStats(ctx context.Context, pool *pgxpool.Pool, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			stat := pool.Stat()
+			slog.InfoContext(ctx, "database pool",
+				"total", stat.TotalConns(),
+				"acquired", stat.AcquiredConns(),
+				"idle", stat.IdleConns(),
+				"max", stat.MaxConns(),
+				"acquire_count", stat.AcquireCount(),
+				"empty_acquire_count", stat.EmptyAcquireCount(),
+				"acquire_duration", stat.AcquireDuration(),
+			)
+		}
+	}
+}
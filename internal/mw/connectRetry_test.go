@@ -0,0 +1,29 @@
+package mw_test
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vestamart/loms/internal/mw"
+)
+
+func TestConnectWithRetryCapsDelay(t *testing.T) {
+	// Порт освобождается сразу, поэтому каждая попытка быстро получает connection refused
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := ln.Addr().String()
+	require.NoError(t, ln.Close())
+
+	poolCfg, err := pgxpool.ParseConfig("postgres://user:pass@" + addr + "/db?connect_timeout=1")
+	require.NoError(t, err)
+
+	start := time.Now()
+	_, err = mw.ConnectWithRetry(context.Background(), poolCfg, 4, time.Hour, 10*time.Millisecond)
+	require.Error(t, err)
+	assert.Less(t, time.Since(start), 5*time.Second, "delay must be clamped to maxDelay")
+}
@@ -0,0 +1,166 @@
+package mw
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/vestamart/loms/internal/domain"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+const idempotencyKeyHeader = "idempotency-key"
+
+// storeTimeout ограничивает сохранение результата, которое не зависит от отмены запроса
+const storeTimeout = 5 * time.Second
+
+// IdempotencyStore хранит результаты запросов по ключу идемпотентности
+type IdempotencyStore interface {
+	// Acquire занимает ключ: результат хранится ttl, а незавершенный запрос держит ключ lockTimeout,
+	// после чего ключ может занять повтор. Если ключ уже занят, возвращает сохраненную запись,
+	// иначе токен владельца, без которого Complete и Release ключ не изменят.
+	Acquire(ctx context.Context, method, key string, requestHash []byte, lockTimeout, ttl time.Duration) (lease string, record *domain.IdempotencyRecord, err error)
+	Complete(ctx context.Context, method, key, lease string, record domain.IdempotencyRecord) error
+	Release(ctx context.Context, method, key, lease string) error
+}
+
+type idempotentRequest interface {
+	GetIdempotencyKey() string
+}
+
+// Idempotency возвращает сохраненный ответ или ошибку на повтор запроса с тем же ключом.
+// Ключ берется из метаданных idempotency-key или из поля idempotencyKey запроса, запросы
+// без такого поля не кешируются. Повтор ключа с другим телом запроса отклоняется.
+// Пока запрос выполняется, повторы получают Aborted, но не дольше lockTimeout: ключ запроса,
+// упавшего вместе с репликой, занимает следующий повтор. Поэтому обработчик ограничен lockTimeout,
+// чтобы повтор не выполнялся одновременно с исходным запросом.
+func Idempotency(store IdempotencyStore, lockTimeout, ttl time.Duration) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		request, ok := req.(idempotentRequest)
+		if !ok {
+			return handler(ctx, req)
+		}
+		key := request.GetIdempotencyKey()
+		if values := metadata.ValueFromIncomingContext(ctx, idempotencyKeyHeader); len(values) > 0 && values[0] != "" {
+			key = values[0]
+		}
+		if key == "" {
+			return handler(ctx, req)
+		}
+
+		hash, err := requestHash(req.(proto.Message))
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "hash request: %v", err)
+		}
+
+		// Дедлайн считается до Acquire, поэтому обработчик завершится не позже, чем истечет lockTimeout
+		handlerCtx, cancelHandler := context.WithDeadline(ctx, time.Now().Add(lockTimeout))
+		defer cancelHandler()
+
+		lease, record, err := store.Acquire(ctx, info.FullMethod, key, hash, lockTimeout, ttl)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "acquire idempotency key: %v", err)
+		}
+		if record != nil {
+			return replay(info.FullMethod, hash, record)
+		}
+
+		resp, err := handler(handlerCtx, req)
+
+		// Клиент мог уже отключиться по таймауту, но результат нужен его повтору
+		storeCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), storeTimeout)
+		defer cancel()
+
+		if !isDeterministic(status.Code(err)) {
+			if releaseErr := store.Release(storeCtx, info.FullMethod, key, lease); releaseErr != nil {
+				slog.ErrorContext(ctx, "idempotency: release key", "method", info.FullMethod, "error", releaseErr)
+			}
+			return resp, err
+		}
+
+		result := domain.IdempotencyRecord{
+			StatusCode:    uint32(status.Code(err)),
+			StatusMessage: status.Convert(err).Message(),
+		}
+		if err == nil {
+			if result.Response, err = proto.Marshal(resp.(proto.Message)); err != nil {
+				return nil, status.Errorf(codes.Internal, "marshal response: %v", err)
+			}
+		}
+		if completeErr := store.Complete(storeCtx, info.FullMethod, key, lease, result); completeErr != nil {
+			slog.ErrorContext(ctx, "idempotency: complete key", "method", info.FullMethod, "error", completeErr)
+		}
+
+		return resp, err
+	}
+}
+
+// isDeterministic сообщает, повторится ли результат при повторе запроса.
+// Временные ошибки не сохраняются, чтобы клиент мог повторить запрос.
+func isDeterministic(code codes.Code) bool {
+	switch code {
+	case codes.Internal, codes.Unknown, codes.Unavailable, codes.DeadlineExceeded, codes.Canceled, codes.Aborted:
+		return false
+	}
+	return true
+}
+
+func replay(method string, hash []byte, record *domain.IdempotencyRecord) (any, error) {
+	if !bytes.Equal(record.RequestHash, hash) {
+		return nil, status.Error(codes.AlreadyExists, "idempotency key was already used with a different request")
+	}
+	if !record.Completed {
+		return nil, status.Error(codes.Aborted, "request with this idempotency key is in progress")
+	}
+	if codes.Code(record.StatusCode) != codes.OK {
+		return nil, status.Error(codes.Code(record.StatusCode), record.StatusMessage)
+	}
+
+	resp, err := newResponse(method)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "replay response: %v", err)
+	}
+	if err = proto.Unmarshal(record.Response, resp); err != nil {
+		return nil, status.Errorf(codes.Internal, "unmarshal response: %v", err)
+	}
+	return resp, nil
+}
+
+// newResponse создает пустой ответ метода по его имени вида /Loms/OrderCreate
+func newResponse(method string) (proto.Message, error) {
+	name := protoreflect.FullName(strings.ReplaceAll(strings.TrimPrefix(method, "/"), "/", "."))
+	desc, err := protoregistry.GlobalFiles.FindDescriptorByName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	msgType, err := protoregistry.GlobalTypes.FindMessageByName(desc.(protoreflect.MethodDescriptor).Output().FullName())
+	if err != nil {
+		return nil, err
+	}
+	return msgType.New().Interface(), nil
+}
+
+// requestHash считает хеш запроса без учета самого ключа идемпотентности
+func requestHash(req proto.Message) ([]byte, error) {
+	msg := proto.Clone(req).ProtoReflect()
+	if field := msg.Descriptor().Fields().ByName("idempotencyKey"); field != nil {
+		msg.Clear(field)
+	}
+
+	raw, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg.Interface())
+	if err != nil {
+		return nil, err
+	}
+	hash := sha256.Sum256(raw)
+	return hash[:], nil
+}
@@ -0,0 +1,223 @@
+package mw_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vestamart/loms/internal/domain"
+	"github.com/vestamart/loms/internal/mw"
+	"github.com/vestamart/loms/internal/repository"
+	desc "github.com/vestamart/loms/pkg/api/loms/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+var orderCreateInfo = &grpc.UnaryServerInfo{FullMethod: "/Loms/OrderCreate"}
+
+func TestIdempotencyReplaysResponse(t *testing.T) {
+	interceptor := mw.Idempotency(repository.NewInMemoryIdempotencyRepository(), time.Minute, time.Hour)
+
+	var calls int64
+	handler := func(ctx context.Context, req any) (any, error) {
+		calls++
+		return &desc.OrderCreateResponse{OrderId: calls}, nil
+	}
+
+	req := &desc.OrderCreateRequest{User: 1, Items: []*desc.Item{{Sku: 1002, Count: 1}}, IdempotencyKey: "key"}
+	first, err := interceptor(context.Background(), req, orderCreateInfo, handler)
+	require.NoError(t, err)
+
+	second, err := interceptor(context.Background(), proto.Clone(req), orderCreateInfo, handler)
+	require.NoError(t, err)
+
+	assert.EqualValues(t, 1, calls)
+	assert.True(t, proto.Equal(first.(proto.Message), second.(proto.Message)))
+}
+
+func TestIdempotencyKeyFromMetadata(t *testing.T) {
+	interceptor := mw.Idempotency(repository.NewInMemoryIdempotencyRepository(), time.Minute, time.Hour)
+
+	var calls int
+	handler := func(ctx context.Context, req any) (any, error) {
+		calls++
+		return nil, status.Error(codes.ResourceExhausted, "item not enough")
+	}
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("idempotency-key", "key"))
+	req := &desc.OrderCreateRequest{User: 1, Items: []*desc.Item{{Sku: 1002, Count: 1000}}}
+	for range 2 {
+		_, err := interceptor(ctx, req, orderCreateInfo, handler)
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	}
+	assert.Equal(t, 1, calls)
+}
+
+func TestIdempotencyConflict(t *testing.T) {
+	interceptor := mw.Idempotency(repository.NewInMemoryIdempotencyRepository(), time.Minute, time.Hour)
+	handler := func(ctx context.Context, req any) (any, error) {
+		return &desc.OrderCreateResponse{OrderId: 1}, nil
+	}
+
+	_, err := interceptor(context.Background(), &desc.OrderCreateRequest{User: 1, IdempotencyKey: "key"}, orderCreateInfo, handler)
+	require.NoError(t, err)
+
+	_, err = interceptor(context.Background(), &desc.OrderCreateRequest{User: 2, IdempotencyKey: "key"}, orderCreateInfo, handler)
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+}
+
+func TestIdempotencyTransientErrorIsNotStored(t *testing.T) {
+	interceptor := mw.Idempotency(repository.NewInMemoryIdempotencyRepository(), time.Minute, time.Hour)
+
+	var calls int
+	handler := func(ctx context.Context, req any) (any, error) {
+		calls++
+		if calls == 1 {
+			return nil, status.Error(codes.Internal, "database is down")
+		}
+		return &desc.OrderPayResponse{}, nil
+	}
+
+	info := &grpc.UnaryServerInfo{FullMethod: "/Loms/OrderPay"}
+	req := &desc.OrderPayRequest{OrderID: 1, IdempotencyKey: "key"}
+
+	_, err := interceptor(context.Background(), req, info, handler)
+	assert.Equal(t, codes.Internal, status.Code(err))
+
+	_, err = interceptor(context.Background(), req, info, handler)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, calls)
+}
+
+// ctxAwareStore как и Postgres не сохраняет результат по отмененному контексту
+type ctxAwareStore struct {
+	*repository.InMemoryIdempotencyRepository
+}
+
+func (s ctxAwareStore) Complete(ctx context.Context, method, key, lease string, record domain.IdempotencyRecord) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return s.InMemoryIdempotencyRepository.Complete(ctx, method, key, lease, record)
+}
+
+func (s ctxAwareStore) Release(ctx context.Context, method, key, lease string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return s.InMemoryIdempotencyRepository.Release(ctx, method, key, lease)
+}
+
+func TestIdempotencyCompletesAfterClientTimeout(t *testing.T) {
+	interceptor := mw.Idempotency(ctxAwareStore{repository.NewInMemoryIdempotencyRepository()}, time.Minute, time.Hour)
+
+	var calls int64
+	req := &desc.OrderCreateRequest{User: 1, Items: []*desc.Item{{Sku: 1002, Count: 1}}, IdempotencyKey: "key"}
+	ctx, cancel := context.WithCancel(context.Background())
+	_, err := interceptor(ctx, req, orderCreateInfo, func(ctx context.Context, req any) (any, error) {
+		calls++
+		// Клиент отключается, пока заказ создается
+		cancel()
+		return &desc.OrderCreateResponse{OrderId: 7}, nil
+	})
+	require.NoError(t, err)
+
+	resp, err := interceptor(context.Background(), proto.Clone(req), orderCreateInfo, func(ctx context.Context, req any) (any, error) {
+		calls++
+		return &desc.OrderCreateResponse{OrderId: 8}, nil
+	})
+	require.NoError(t, err, "retry must get the stored response instead of Aborted")
+	assert.EqualValues(t, 7, resp.(*desc.OrderCreateResponse).OrderId)
+	assert.EqualValues(t, 1, calls)
+}
+
+func TestIdempotencyReleasesAfterClientTimeout(t *testing.T) {
+	interceptor := mw.Idempotency(ctxAwareStore{repository.NewInMemoryIdempotencyRepository()}, time.Minute, time.Hour)
+	info := &grpc.UnaryServerInfo{FullMethod: "/Loms/OrderPay"}
+	req := &desc.OrderPayRequest{OrderID: 1, IdempotencyKey: "key"}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	_, err := interceptor(ctx, req, info, func(ctx context.Context, req any) (any, error) {
+		cancel()
+		return nil, status.Error(codes.Canceled, "context canceled")
+	})
+	assert.Equal(t, codes.Canceled, status.Code(err))
+
+	_, err = interceptor(context.Background(), req, info, func(ctx context.Context, req any) (any, error) {
+		return &desc.OrderPayResponse{}, nil
+	})
+	assert.NoError(t, err)
+}
+
+func TestIdempotencyTakesOverExpiredLock(t *testing.T) {
+	store := repository.NewInMemoryIdempotencyRepository()
+	const lockTimeout = 50 * time.Millisecond
+	interceptor := mw.Idempotency(store, lockTimeout, time.Hour)
+	req := &desc.OrderCreateRequest{User: 1, Items: []*desc.Item{{Sku: 1002, Count: 1}}, IdempotencyKey: "key"}
+	handler := func(ctx context.Context, req any) (any, error) {
+		return &desc.OrderCreateResponse{OrderId: 1}, nil
+	}
+
+	// Реплика заняла ключ и упала, не завершив запрос
+	assert.Panics(t, func() {
+		_, _ = interceptor(context.Background(), req, orderCreateInfo, func(ctx context.Context, req any) (any, error) {
+			panic("replica crashed")
+		})
+	})
+
+	_, err := interceptor(context.Background(), req, orderCreateInfo, handler)
+	assert.Equal(t, codes.Aborted, status.Code(err), "key is still locked")
+
+	time.Sleep(lockTimeout)
+	resp, err := interceptor(context.Background(), req, orderCreateInfo, handler)
+	require.NoError(t, err, "expired lock must be taken over")
+	assert.EqualValues(t, 1, resp.(*desc.OrderCreateResponse).OrderId)
+}
+
+func TestIdempotencyStaleOwnerDoesNotOverwriteTakeover(t *testing.T) {
+	store := repository.NewInMemoryIdempotencyRepository()
+	const lockTimeout = 50 * time.Millisecond
+	interceptor := mw.Idempotency(store, lockTimeout, time.Hour)
+	req := &desc.OrderCreateRequest{User: 1, Items: []*desc.Item{{Sku: 1002, Count: 1}}, IdempotencyKey: "key"}
+
+	// Первый запрос выполняется дольше lockTimeout и ждет, пока ключ займет повтор
+	firstStarted, takenOver := make(chan struct{}), make(chan struct{})
+	firstDone := make(chan error, 1)
+	go func() {
+		_, err := interceptor(context.Background(), req, orderCreateInfo, func(ctx context.Context, req any) (any, error) {
+			close(firstStarted)
+			<-ctx.Done()
+			<-takenOver
+			return nil, status.FromContextError(ctx.Err()).Err()
+		})
+		firstDone <- err
+	}()
+
+	<-firstStarted
+	time.Sleep(lockTimeout)
+	var handlerCtxErr error
+	resp, err := interceptor(context.Background(), req, orderCreateInfo, func(ctx context.Context, req any) (any, error) {
+		_, hasDeadline := ctx.Deadline()
+		assert.True(t, hasDeadline, "handler must be bounded by lockTimeout")
+		handlerCtxErr = ctx.Err()
+		return &desc.OrderCreateResponse{OrderId: 2}, nil
+	})
+	require.NoError(t, err, "expired lock must be taken over")
+	require.NoError(t, handlerCtxErr)
+	assert.EqualValues(t, 2, resp.(*desc.OrderCreateResponse).OrderId)
+
+	// Первый обработчик к этому моменту уже отменен по дедлайну, и его Release не трогает ключ повтора
+	close(takenOver)
+	assert.Equal(t, codes.DeadlineExceeded, status.Code(<-firstDone))
+
+	resp, err = interceptor(context.Background(), req, orderCreateInfo, func(ctx context.Context, req any) (any, error) {
+		return &desc.OrderCreateResponse{OrderId: 3}, nil
+	})
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, resp.(*desc.OrderCreateResponse).OrderId, "stored response of the takeover must be replayed")
+}
@@ -2,23 +2,93 @@ package mw
 
 import (
 	"context"
+	"log/slog"
+	"time"
+
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
-	"log"
+	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
-func Logger(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
-	raw, _ := protojson.Marshal((req).(proto.Message))
-	log.Printf("request: method: %v, req: %v\n", info.FullMethod, string(raw))
+const redacted = "[REDACTED]"
+
+// Logger логирует каждый запрос с кодом ответа и длительностью. При logPayloads в лог
+// попадают тела запроса и ответа, значения полей из redactFields в них скрываются.
+func Logger(logPayloads bool, redactFields []string) grpc.UnaryServerInterceptor {
+	redact := make(map[protoreflect.Name]struct{}, len(redactFields))
+	for _, v := range redactFields {
+		redact[protoreflect.Name(v)] = struct{}{}
+	}
+
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if logPayloads {
+			slog.InfoContext(ctx, "request", "method", info.FullMethod, "request", payload(req, redact))
+		}
+
+		start := time.Now()
+		resp, err := handler(ctx, req)
+
+		attrs := []any{
+			"method", info.FullMethod,
+			"code", status.Code(err).String(),
+			"duration", time.Since(start),
+		}
+		if err != nil {
+			slog.WarnContext(ctx, "request failed", append(attrs, "error", err)...)
+			return resp, err
+		}
+
+		if logPayloads {
+			attrs = append(attrs, "response", payload(resp, redact))
+		}
+		slog.InfoContext(ctx, "request handled", attrs...)
 
-	if resp, err = handler(ctx, req); err != nil {
-		log.Printf("response: method: %v, err: %v\n", info.FullMethod, err)
-		return
+		return resp, err
 	}
+}
+
+// payload сериализует сообщение в JSON, скрывая значения полей из redact
+func payload(v any, redact map[protoreflect.Name]struct{}) string {
+	msg, ok := v.(proto.Message)
+	if !ok {
+		return ""
+	}
+	if len(redact) > 0 {
+		msg = proto.Clone(msg)
+		redactMessage(msg.ProtoReflect(), redact)
+	}
+
+	raw, _ := protojson.Marshal(msg)
+	return string(raw)
+}
 
-	rawResp, _ := protojson.Marshal((resp).(proto.Message))
-	log.Printf("response: method: %v, resp: %v\n", info.FullMethod, string(rawResp))
+func redactMessage(msg protoreflect.Message, redact map[protoreflect.Name]struct{}) {
+	msg.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if _, ok := redact[fd.Name()]; ok {
+			if fd.Kind() == protoreflect.StringKind && !fd.IsList() && !fd.IsMap() {
+				msg.Set(fd, protoreflect.ValueOfString(redacted))
+			} else {
+				msg.Clear(fd)
+			}
+			return true
+		}
 
-	return resp, err
+		switch {
+		case fd.IsList() && fd.Message() != nil:
+			list := v.List()
+			for i := 0; i < list.Len(); i++ {
+				redactMessage(list.Get(i).Message(), redact)
+			}
+		case fd.IsMap() && fd.MapValue().Message() != nil:
+			v.Map().Range(func(_ protoreflect.MapKey, mv protoreflect.Value) bool {
+				redactMessage(mv.Message(), redact)
+				return true
+			})
+		case !fd.IsList() && !fd.IsMap() && fd.Message() != nil:
+			redactMessage(v.Message(), redact)
+		}
+		return true
+	})
 }
@@ -0,0 +1,78 @@
+package mw_test
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vestamart/loms/internal/config"
+	"github.com/vestamart/loms/internal/logger"
+	"github.com/vestamart/loms/internal/mw"
+	desc "github.com/vestamart/loms/pkg/api/loms/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func captureLogs(t *testing.T) *bytes.Buffer {
+	t.Helper()
+
+	var buf bytes.Buffer
+	log, err := logger.New(&buf, config.LoggerConfig{Level: "debug", Format: "text"})
+	require.NoError(t, err)
+
+	previous := slog.Default()
+	slog.SetDefault(log)
+	t.Cleanup(func() { slog.SetDefault(previous) })
+
+	return &buf
+}
+
+func TestLoggerRedactsFields(t *testing.T) {
+	buf := captureLogs(t)
+	interceptor := mw.Logger(true, []string{"idempotencyKey", "count"})
+
+	handler := func(ctx context.Context, req any) (any, error) {
+		return &desc.OrderCreateResponse{OrderId: 1}, nil
+	}
+	req := &desc.OrderCreateRequest{User: 1, Items: []*desc.Item{{Sku: 1002, Count: 3}}, IdempotencyKey: "secret-key"}
+
+	_, err := interceptor(context.Background(), req, orderCreateInfo, handler)
+	require.NoError(t, err)
+
+	out := buf.String()
+	assert.NotContains(t, out, "secret-key")
+	assert.Contains(t, out, "[REDACTED]")
+	assert.NotContains(t, out, `\"count\":3`)
+	assert.Contains(t, out, `\"sku\":1002`)
+	assert.Equal(t, "secret-key", req.IdempotencyKey, "redaction must not modify the request")
+}
+
+func TestRequestIDPropagatesIncomingID(t *testing.T) {
+	buf := captureLogs(t)
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-request-id", "req-42"))
+
+	handler := func(ctx context.Context, req any) (any, error) {
+		assert.Equal(t, "req-42", logger.RequestID(ctx))
+		slog.InfoContext(ctx, "handled")
+		return &desc.OrderCreateResponse{}, nil
+	}
+
+	_, err := mw.RequestID(ctx, &desc.OrderCreateRequest{}, orderCreateInfo, handler)
+	require.NoError(t, err)
+	assert.Contains(t, buf.String(), "request_id=req-42")
+}
+
+func TestRequestIDGeneratesMissingID(t *testing.T) {
+	var requestID string
+	handler := func(ctx context.Context, req any) (any, error) {
+		requestID = logger.RequestID(ctx)
+		return &desc.OrderCreateResponse{}, nil
+	}
+
+	_, err := mw.RequestID(context.Background(), &desc.OrderCreateRequest{}, &grpc.UnaryServerInfo{}, handler)
+	require.NoError(t, err)
+	assert.Len(t, requestID, 32)
+}
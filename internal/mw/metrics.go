@@ -0,0 +1,22 @@
+package mw
+
+import (
+	"context"
+	"time"
+
+	"github.com/vestamart/loms/internal/metrics"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// Metrics считает количество и время обработки запросов по методу и коду ответа
+func Metrics(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	start := time.Now()
+	resp, err := handler(ctx, req)
+
+	code := status.Code(err).String()
+	metrics.GRPCRequestsTotal.WithLabelValues(info.FullMethod, code).Inc()
+	metrics.GRPCRequestDuration.WithLabelValues(info.FullMethod, code).Observe(time.Since(start).Seconds())
+
+	return resp, err
+}
@@ -2,19 +2,41 @@ package mw
 
 import (
 	"context"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
+	"log/slog"
+	"runtime/debug"
 
+	"github.com/vestamart/loms/internal/metrics"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
-func Panic(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+// Panic перехватывает панику в обработчике и возвращает клиенту codes.Internal
+func Panic(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
 	defer func() {
-		if err := recover(); err != nil {
-			err = status.Errorf(codes.Internal, "panic error: %v", err)
+		if r := recover(); r != nil {
+			resp, err = nil, recovered(ctx, info.FullMethod, r)
 		}
 	}()
 
 	return handler(ctx, req)
+}
+
+// PanicStream то же, что Panic, для потоковых методов
+func PanicStream(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = recovered(ss.Context(), info.FullMethod, r)
+		}
+	}()
+
+	return handler(srv, ss)
+}
+
+// recovered логирует панику со стеком и превращает ее в ошибку для клиента
+func recovered(ctx context.Context, method string, r any) error {
+	metrics.PanicsTotal.WithLabelValues(method).Inc()
+	slog.ErrorContext(ctx, "panic recovered", "method", method, "panic", r, "stack", string(debug.Stack()))
 
+	return status.Errorf(codes.Internal, "panic error: %v", r)
 }
@@ -0,0 +1,61 @@
+package mw_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vestamart/loms/internal/logger"
+	"github.com/vestamart/loms/internal/metrics"
+	"github.com/vestamart/loms/internal/mw"
+	desc "github.com/vestamart/loms/pkg/api/loms/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestPanicReturnsInternalError(t *testing.T) {
+	buf := captureLogs(t)
+	before := testutil.ToFloat64(metrics.PanicsTotal.WithLabelValues(orderCreateInfo.FullMethod))
+
+	handler := func(ctx context.Context, req any) (any, error) {
+		var order *desc.OrderCreateRequest
+		return order.Items[0], nil
+	}
+
+	ctx := logger.WithRequestID(context.Background(), "req-panic")
+	resp, err := mw.Panic(ctx, &desc.OrderCreateRequest{}, orderCreateInfo, handler)
+	require.Error(t, err)
+	assert.Nil(t, resp)
+	assert.Equal(t, codes.Internal, status.Code(err))
+
+	assert.Equal(t, before+1, testutil.ToFloat64(metrics.PanicsTotal.WithLabelValues(orderCreateInfo.FullMethod)))
+	out := buf.String()
+	assert.Contains(t, out, "panic recovered")
+	assert.Contains(t, out, "request_id=req-panic")
+	assert.Contains(t, out, "method=/Loms/OrderCreate")
+	assert.Contains(t, out, "panic_test.go")
+}
+
+type fakeStream struct {
+	grpc.ServerStream
+}
+
+func (fakeStream) Context() context.Context {
+	return context.Background()
+}
+
+func TestPanicStreamReturnsInternalError(t *testing.T) {
+	captureLogs(t)
+
+	handler := func(srv any, stream grpc.ServerStream) error {
+		panic("boom")
+	}
+
+	err := mw.PanicStream(nil, fakeStream{}, &grpc.StreamServerInfo{FullMethod: "/Loms/Watch"}, handler)
+	require.Error(t, err)
+	assert.Equal(t, codes.Internal, status.Code(err))
+	assert.Contains(t, err.Error(), "boom")
+}
@@ -0,0 +1,35 @@
+package mw
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+
+	"github.com/vestamart/loms/internal/logger"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const requestIDHeader = "x-request-id"
+
+// RequestID берет идентификатор запроса из метаданных x-request-id или генерирует новый,
+// кладет его в контекст для логов и возвращает клиенту в заголовке ответа
+func RequestID(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	requestID := ""
+	if values := metadata.ValueFromIncomingContext(ctx, requestIDHeader); len(values) > 0 {
+		requestID = values[0]
+	}
+	if requestID == "" {
+		requestID = newRequestID()
+	}
+
+	_ = grpc.SetHeader(ctx, metadata.Pairs(requestIDHeader, requestID))
+
+	return handler(logger.WithRequestID(ctx, requestID), req)
+}
+
+func newRequestID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
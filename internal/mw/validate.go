@@ -0,0 +1,85 @@
+package mw
+
+import (
+	"context"
+	"errors"
+	"unicode"
+	"unicode/utf8"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// validator реализуют запросы, для которых protoc-gen-validate сгенерировал правила
+type validator interface {
+	ValidateAll() error
+}
+
+// fieldError ошибка валидации поля, сгенерированная protoc-gen-validate
+type fieldError interface {
+	Field() string
+	Reason() string
+	Cause() error
+}
+
+// multiError набор ошибок, который возвращает ValidateAll
+type multiError interface {
+	AllErrors() []error
+}
+
+// Validate отклоняет запросы, не прошедшие правила из аннотаций validate в proto, с кодом
+// InvalidArgument. Нарушения по полям передаются в деталях ошибки как BadRequest.
+func Validate(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	request, ok := req.(validator)
+	if !ok {
+		return handler(ctx, req)
+	}
+
+	if err := request.ValidateAll(); err != nil {
+		st := status.New(codes.InvalidArgument, err.Error())
+		if withDetails, detailsErr := st.WithDetails(&errdetails.BadRequest{FieldViolations: fieldViolations("", err)}); detailsErr == nil {
+			st = withDetails
+		}
+		return nil, st.Err()
+	}
+
+	return handler(ctx, req)
+}
+
+// fieldViolations разворачивает ошибки вложенных сообщений в плоский список с путями вида items[0].sku
+func fieldViolations(prefix string, err error) []*errdetails.BadRequest_FieldViolation {
+	var multi multiError
+	if errors.As(err, &multi) {
+		violations := make([]*errdetails.BadRequest_FieldViolation, 0, len(multi.AllErrors()))
+		for _, v := range multi.AllErrors() {
+			violations = append(violations, fieldViolations(prefix, v)...)
+		}
+		return violations
+	}
+
+	var field fieldError
+	if !errors.As(err, &field) {
+		return []*errdetails.BadRequest_FieldViolation{{Field: prefix, Description: err.Error()}}
+	}
+
+	path := fieldPath(field.Field())
+	if prefix != "" {
+		path = prefix + "." + path
+	}
+	if cause := field.Cause(); cause != nil {
+		return fieldViolations(path, cause)
+	}
+
+	return []*errdetails.BadRequest_FieldViolation{{Field: path, Description: field.Reason()}}
+}
+
+// fieldPath переводит имя Go-поля из protoc-gen-validate в имя поля из proto: Items[0] -> items[0]
+func fieldPath(name string) string {
+	r, size := utf8.DecodeRuneInString(name)
+	if r == utf8.RuneError {
+		return name
+	}
+	return string(unicode.ToLower(r)) + name[size:]
+}
@@ -0,0 +1,52 @@
+package mw_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vestamart/loms/internal/mw"
+	desc "github.com/vestamart/loms/pkg/api/loms/v1"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestValidateRejectsInvalidRequest(t *testing.T) {
+	var called bool
+	handler := func(ctx context.Context, req any) (any, error) {
+		called = true
+		return &desc.OrderCreateResponse{}, nil
+	}
+
+	req := &desc.OrderCreateRequest{User: 0, Items: []*desc.Item{{Sku: 1002, Count: 1}, {Sku: 0, Count: 0}}}
+	_, err := mw.Validate(context.Background(), req, orderCreateInfo, handler)
+	require.Error(t, err)
+	assert.False(t, called)
+
+	st := status.Convert(err)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+	require.Len(t, st.Details(), 1)
+
+	badRequest, ok := st.Details()[0].(*errdetails.BadRequest)
+	require.True(t, ok)
+
+	fields := make([]string, 0, len(badRequest.FieldViolations))
+	for _, v := range badRequest.FieldViolations {
+		fields = append(fields, v.Field)
+		assert.NotEmpty(t, v.Description)
+	}
+	assert.Equal(t, []string{"user", "items[1].sku", "items[1].count"}, fields)
+}
+
+func TestValidatePassesValidRequest(t *testing.T) {
+	handler := func(ctx context.Context, req any) (any, error) {
+		return &desc.OrderCreateResponse{OrderId: 1}, nil
+	}
+
+	req := &desc.OrderCreateRequest{User: 1, Items: []*desc.Item{{Sku: 1002, Count: 1}}}
+	resp, err := mw.Validate(context.Background(), req, orderCreateInfo, handler)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, resp.(*desc.OrderCreateResponse).OrderId)
+}
@@ -0,0 +1,21 @@
+package repository_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vestamart/loms/internal/repository"
+	"github.com/vestamart/loms/internal/repository/repotest"
+)
+
+func TestInMemoryContract(t *testing.T) {
+	repotest.Run(t, func(t *testing.T) repotest.Backend {
+		stocks, err := repository.NewInMemoryStocksRepository("")
+		require.NoError(t, err)
+
+		return repotest.Backend{
+			Orders: repository.NewInMemoryOrderRepository(100, repository.NewInMemoryOutboxRepository()),
+			Stocks: stocks,
+		}
+	})
+}
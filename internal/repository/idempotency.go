@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/vestamart/loms/internal/domain"
+	"github.com/vestamart/loms/internal/localErr"
+)
+
+type idempotencyKey struct {
+	method string
+	key    string
+}
+
+type idempotencyEntry struct {
+	record      domain.IdempotencyRecord
+	lease       string
+	lockedUntil time.Time
+	expiresAt   time.Time
+}
+
+type InMemoryIdempotencyRepository struct {
+	mu      sync.Mutex
+	entries map[idempotencyKey]idempotencyEntry
+}
+
+func NewInMemoryIdempotencyRepository() *InMemoryIdempotencyRepository {
+	return &InMemoryIdempotencyRepository{entries: make(map[idempotencyKey]idempotencyEntry)}
+}
+
+// Acquire занимает свободный ключ, ключ с истекшим ttl или незавершенный ключ с истекшим lockTimeout
+// и возвращает токен владельца
+func (r *InMemoryIdempotencyRepository) Acquire(_ context.Context, method, key string, requestHash []byte, lockTimeout, ttl time.Duration) (string, *domain.IdempotencyRecord, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	k := idempotencyKey{method: method, key: key}
+	if v, ok := r.entries[k]; ok && now.Before(v.expiresAt) && (v.record.Completed || now.Before(v.lockedUntil)) {
+		record := v.record
+		return "", &record, nil
+	}
+
+	lease := domain.NewIdempotencyLease()
+	r.entries[k] = idempotencyEntry{
+		record:      domain.IdempotencyRecord{RequestHash: requestHash},
+		lease:       lease,
+		lockedUntil: now.Add(lockTimeout),
+		expiresAt:   now.Add(ttl),
+	}
+	return lease, nil, nil
+}
+
+// Complete сохраняет результат, если ключ все еще принадлежит lease, иначе возвращает IdempotencyLeaseLostErr
+func (r *InMemoryIdempotencyRepository) Complete(_ context.Context, method, key, lease string, record domain.IdempotencyRecord) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	k := idempotencyKey{method: method, key: key}
+	v, ok := r.entries[k]
+	if !ok || v.lease != lease || v.record.Completed {
+		return localErr.IdempotencyLeaseLostErr
+	}
+
+	record.RequestHash = v.record.RequestHash
+	record.Completed = true
+	v.record = record
+	r.entries[k] = v
+	return nil
+}
+
+// Release освобождает ключ, если он все еще принадлежит lease
+func (r *InMemoryIdempotencyRepository) Release(_ context.Context, method, key, lease string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	k := idempotencyKey{method: method, key: key}
+	if v, ok := r.entries[k]; ok && v.lease == lease && !v.record.Completed {
+		delete(r.entries, k)
+	}
+	return nil
+}
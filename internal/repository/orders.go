@@ -2,48 +2,177 @@ package repository
 
 import (
 	"context"
+	"fmt"
 	"github.com/vestamart/loms/internal/domain"
 	"github.com/vestamart/loms/internal/localErr"
+	"slices"
+	"sort"
+	"sync"
+	"time"
 )
 
 type OrderID = int64
 
 type OrdersStorage = map[OrderID]domain.Order
 
+// InMemoryOrderRepository хранит заказы в памяти. Методы безопасны для конкурентного вызова.
 type InMemoryOrderRepository struct {
+	mu           sync.RWMutex
 	orderStorage OrdersStorage
+	history      map[OrderID][]domain.StatusChange
 	lastOrderID  OrderID
+	outbox       *InMemoryOutboxRepository
 }
 
-func NewInMemoryOrderRepository(cap int) *InMemoryOrderRepository {
-	return &InMemoryOrderRepository{orderStorage: make(OrdersStorage, cap), lastOrderID: 0}
+func NewInMemoryOrderRepository(cap int, outbox *InMemoryOutboxRepository) *InMemoryOrderRepository {
+	return &InMemoryOrderRepository{
+		orderStorage: make(OrdersStorage, cap),
+		history:      make(map[OrderID][]domain.StatusChange, cap),
+		lastOrderID:  0,
+		outbox:       outbox,
+	}
 }
 
-func (r *InMemoryOrderRepository) Create(_ context.Context, userID int64, items *[]domain.Item) (OrderID, error) {
+func (r *InMemoryOrderRepository) Create(ctx context.Context, userID int64, items *[]domain.Item) (OrderID, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// lastOrderID не откатываем: как и sequence в Postgres, номера заказов не переиспользуются
 	r.lastOrderID++
 	orderID := r.lastOrderID
 
+	r.saveUndo(ctx, orderID)
+	now := time.Now()
 	r.orderStorage[orderID] = domain.Order{
-		UserID: userID,
-		Status: domain.New,
-		Items:  *items,
+		ID:        orderID,
+		UserID:    userID,
+		Status:    domain.New,
+		Items:     slices.Clone(*items),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	if err := r.outbox.Add(ctx, domain.NewOrderEvent(orderID, domain.New, "order created")); err != nil {
+		return 0, err
 	}
 
 	return orderID, nil
 }
 
-func (r *InMemoryOrderRepository) SetStatus(_ context.Context, orderID int64, status domain.OrderStatus) error {
+func (r *InMemoryOrderRepository) SetStatus(ctx context.Context, orderID int64, expected, status domain.OrderStatus, reason string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
 	v, ok := r.orderStorage[orderID]
 	if !ok {
 		return localErr.OrderNotFoundErr
 	}
+	if v.Status != expected {
+		return fmt.Errorf("order %d is not in status %s: %w", orderID, expected, localErr.InvalidStatusTransitionErr)
+	}
+	r.saveUndo(ctx, orderID)
 	v.Status = status
+	v.UpdatedAt = time.Now()
 
 	r.orderStorage[orderID] = v
-	return nil
+
+	event := domain.NewOrderEvent(orderID, status, reason)
+	r.history[orderID] = append(r.history[orderID], domain.StatusChange{
+		From:      expected,
+		To:        status,
+		Reason:    event.Info,
+		ChangedAt: event.Timestamp,
+	})
+	return r.outbox.Add(ctx, event)
+}
+
+func (r *InMemoryOrderRepository) History(_ context.Context, orderID int64) ([]domain.StatusChange, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return slices.Clone(r.history[orderID]), nil
+}
+
+func (r *InMemoryOrderRepository) List(_ context.Context, filter domain.OrderFilter) ([]domain.Order, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	orders := make([]domain.Order, 0)
+	for _, v := range r.orderStorage {
+		if matchOrder(v, filter) {
+			orders = append(orders, v)
+		}
+	}
+
+	sort.Slice(orders, func(i, j int) bool {
+		return orderBefore(orders[j], orders[i].CreatedAt, orders[i].ID)
+	})
+	if len(orders) > int(filter.Limit) {
+		orders = orders[:filter.Limit]
+	}
+
+	return orders, nil
+}
+
+func (r *InMemoryOrderRepository) ListExpired(_ context.Context, status domain.OrderStatus, updatedBefore time.Time, exclude []int64, limit int32) ([]int64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	ids := make([]int64, 0)
+	for k, v := range r.orderStorage {
+		if v.Status == status && v.UpdatedAt.Before(updatedBefore) && !slices.Contains(exclude, k) {
+			ids = append(ids, k)
+		}
+	}
+
+	sort.Slice(ids, func(i, j int) bool {
+		return r.orderStorage[ids[i]].UpdatedAt.Before(r.orderStorage[ids[j]].UpdatedAt)
+	})
+	if len(ids) > int(limit) {
+		ids = ids[:limit]
+	}
+
+	return ids, nil
+}
+
+func (r *InMemoryOrderRepository) CountByStatus(_ context.Context) (map[domain.OrderStatus]int64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	result := make(map[domain.OrderStatus]int64)
+	for _, v := range r.orderStorage {
+		result[v.Status]++
+	}
+
+	return result, nil
+}
+
+// saveUndo запоминает заказ и длину его истории, чтобы откатить их вместе с транзакцией ctx. Вызывается под r.mu
+func (r *InMemoryOrderRepository) saveUndo(ctx context.Context, orderID OrderID) {
+	prev, existed := r.orderStorage[orderID]
+	historyLen := len(r.history[orderID])
+
+	onRollback(ctx, func() {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+
+		if existed {
+			r.orderStorage[orderID] = prev
+		} else {
+			delete(r.orderStorage, orderID)
+		}
+		if historyLen > 0 {
+			r.history[orderID] = r.history[orderID][:historyLen]
+		} else {
+			delete(r.history, orderID)
+		}
+	})
 }
 
 func (r *InMemoryOrderRepository) GetByID(_ context.Context, orderID int64) (*domain.Order, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
 	v, ok := r.orderStorage[orderID]
 	if !ok {
 		return nil, localErr.OrderNotFoundErr
@@ -51,3 +180,30 @@ func (r *InMemoryOrderRepository) GetByID(_ context.Context, orderID int64) (*do
 
 	return &v, nil
 }
+
+func matchOrder(order domain.Order, filter domain.OrderFilter) bool {
+	if order.UserID != filter.UserID {
+		return false
+	}
+	if len(filter.Statuses) > 0 && !slices.Contains(filter.Statuses, order.Status) {
+		return false
+	}
+	if !filter.CreatedFrom.IsZero() && order.CreatedAt.Before(filter.CreatedFrom) {
+		return false
+	}
+	if !filter.CreatedTo.IsZero() && !order.CreatedAt.Before(filter.CreatedTo) {
+		return false
+	}
+	if filter.After != nil && !orderBefore(order, filter.After.CreatedAt, filter.After.ID) {
+		return false
+	}
+	return true
+}
+
+// orderBefore сообщает, идет ли заказ в выдаче после позиции (createdAt, id)
+func orderBefore(order domain.Order, createdAt time.Time, id int64) bool {
+	if order.CreatedAt.Equal(createdAt) {
+		return order.ID < id
+	}
+	return order.CreatedAt.Before(createdAt)
+}
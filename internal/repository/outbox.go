@@ -0,0 +1,161 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/vestamart/loms/internal/domain"
+)
+
+type outboxRecord struct {
+	message   domain.OutboxMessage
+	attempts  int
+	lastError string
+	// dead relay исчерпал попытки отправки и больше не выбирает событие
+	dead bool
+	// lockedUntil до этого момента событие отправляет забравший его relay
+	lockedUntil time.Time
+}
+
+// InMemoryOutboxRepository хранит неотправленные события по id, отправленные события удаляются
+type InMemoryOutboxRepository struct {
+	mu      sync.Mutex
+	records map[int64]outboxRecord
+	lastID  int64
+}
+
+func NewInMemoryOutboxRepository() *InMemoryOutboxRepository {
+	return &InMemoryOutboxRepository{records: make(map[int64]outboxRecord)}
+}
+
+// Add кладет в outbox событие о смене статуса заказа
+func (r *InMemoryOutboxRepository) Add(ctx context.Context, event domain.OrderEvent) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("marshal order event failed: %w", err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.lastID++
+	r.saveUndo(ctx, r.lastID)
+	r.records[r.lastID] = outboxRecord{message: domain.OutboxMessage{
+		ID:      r.lastID,
+		OrderID: event.OrderID,
+		Payload: payload,
+	}}
+	return nil
+}
+
+// FetchPending забирает до limit неотправленных событий на lockTimeout в порядке id
+func (r *InMemoryOutboxRepository) FetchPending(ctx context.Context, limit int32, lockTimeout time.Duration) ([]domain.OutboxMessage, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	ids := make([]int64, 0, len(r.records))
+	for id, v := range r.records {
+		if !v.dead && !now.Before(v.lockedUntil) {
+			ids = append(ids, id)
+		}
+	}
+	slices.Sort(ids)
+	if len(ids) > int(limit) {
+		ids = ids[:limit]
+	}
+
+	messages := make([]domain.OutboxMessage, 0, len(ids))
+	for _, id := range ids {
+		r.saveUndo(ctx, id)
+		v := r.records[id]
+		v.lockedUntil = now.Add(lockTimeout)
+		r.records[id] = v
+
+		message := v.message
+		message.Attempts = int32(v.attempts)
+		messages = append(messages, message)
+	}
+
+	return messages, nil
+}
+
+// MarkSent удаляет отправленные события
+func (r *InMemoryOutboxRepository) MarkSent(ctx context.Context, ids []int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, id := range ids {
+		if _, ok := r.records[id]; ok {
+			r.saveUndo(ctx, id)
+			delete(r.records, id)
+		}
+	}
+	return nil
+}
+
+// MarkFailed записывает ошибку отправки и освобождает событие
+func (r *InMemoryOutboxRepository) MarkFailed(ctx context.Context, id int64, reason string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if v, ok := r.records[id]; ok {
+		r.saveUndo(ctx, id)
+		v.attempts++
+		v.lastError = reason
+		v.lockedUntil = time.Time{}
+		r.records[id] = v
+	}
+	return nil
+}
+
+// MarkDead записывает последнюю ошибку и исключает событие из отправки
+func (r *InMemoryOutboxRepository) MarkDead(ctx context.Context, id int64, reason string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if v, ok := r.records[id]; ok {
+		r.saveUndo(ctx, id)
+		v.attempts++
+		v.lastError = reason
+		v.lockedUntil = time.Time{}
+		v.dead = true
+		r.records[id] = v
+	}
+	return nil
+}
+
+// Release освобождает забранные, но не отправленные события
+func (r *InMemoryOutboxRepository) Release(ctx context.Context, ids []int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, id := range ids {
+		if v, ok := r.records[id]; ok {
+			r.saveUndo(ctx, id)
+			v.lockedUntil = time.Time{}
+			r.records[id] = v
+		}
+	}
+	return nil
+}
+
+// saveUndo запоминает событие, чтобы откатить его вместе с транзакцией ctx. Вызывается под r.mu
+func (r *InMemoryOutboxRepository) saveUndo(ctx context.Context, id int64) {
+	prev, existed := r.records[id]
+
+	onRollback(ctx, func() {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+
+		if existed {
+			r.records[id] = prev
+		} else {
+			delete(r.records, id)
+		}
+	})
+}
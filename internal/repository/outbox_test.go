@@ -0,0 +1,44 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vestamart/loms/internal/domain"
+	"github.com/vestamart/loms/internal/repository"
+)
+
+func TestInMemoryOutboxMarkSent(t *testing.T) {
+	outbox := repository.NewInMemoryOutboxRepository()
+	txManager := repository.NewInMemoryTxManager()
+	ctx := context.Background()
+	for orderID := range int64(3) {
+		require.NoError(t, outbox.Add(ctx, domain.NewOrderEvent(orderID, domain.New, "")))
+	}
+
+	pending, err := outbox.FetchPending(ctx, 2, 0)
+	require.NoError(t, err)
+	require.Len(t, pending, 2)
+	assert.Less(t, pending[0].ID, pending[1].ID)
+
+	// Откат возвращает удаленное отправленное событие
+	errFail := errors.New("fail")
+	err = txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		require.NoError(t, outbox.MarkSent(ctx, []int64{pending[0].ID}))
+		return errFail
+	})
+	require.ErrorIs(t, err, errFail)
+
+	all, err := outbox.FetchPending(ctx, 10, 0)
+	require.NoError(t, err)
+	assert.Len(t, all, 3)
+
+	require.NoError(t, outbox.MarkSent(ctx, []int64{pending[0].ID, pending[1].ID}))
+	rest, err := outbox.FetchPending(ctx, 10, 0)
+	require.NoError(t, err)
+	require.Len(t, rest, 1)
+	assert.Equal(t, all[2].ID, rest[0].ID)
+}
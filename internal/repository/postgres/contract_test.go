@@ -0,0 +1,24 @@
+package postgres_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/vestamart/loms/internal/repository/postgres"
+	"github.com/vestamart/loms/internal/repository/repotest"
+)
+
+func TestPostgresContract(t *testing.T) {
+	if os.Getenv(testDSNEnv) == "" {
+		t.Skipf("%s is not set", testDSNEnv)
+	}
+
+	repotest.Run(t, func(t *testing.T) repotest.Backend {
+		pool := newTestPool(t)
+
+		return repotest.Backend{
+			Orders: postgres.NewOrderRepositoryPostgres(pool),
+			Stocks: postgres.NewStocksRepositoryPostgres(pool),
+		}
+	})
+}
@@ -0,0 +1,68 @@
+package postgres_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/jackc/pgx/v5/stdlib"
+	"github.com/pressly/goose/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testDSNEnv задает базу для тестов репозиториев Postgres. Миграции применяются
+// во временную схему, которая удаляется после теста
+const testDSNEnv = "LOMS_TEST_DATABASE_DSN"
+
+const migrationsDir = "../../../migrations"
+
+// newTestPool создает схему с примененными миграциями и пул, у которого она стоит в search_path
+func newTestPool(t *testing.T) *pgxpool.Pool {
+	t.Helper()
+
+	dsn := os.Getenv(testDSNEnv)
+	if dsn == "" {
+		t.Skipf("%s is not set", testDSNEnv)
+	}
+	ctx := context.Background()
+
+	admin, err := pgx.Connect(ctx, dsn)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = admin.Close(context.Background()) })
+
+	schema := pgx.Identifier{fmt.Sprintf("loms_test_%d", time.Now().UnixNano())}.Sanitize()
+	_, err = admin.Exec(ctx, "CREATE SCHEMA "+schema)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_, err := admin.Exec(context.Background(), "DROP SCHEMA "+schema+" CASCADE")
+		assert.NoError(t, err)
+	})
+
+	cfg, err := pgxpool.ParseConfig(dsn)
+	require.NoError(t, err)
+	cfg.ConnConfig.RuntimeParams["search_path"] = schema
+
+	migrate(t, cfg.ConnConfig)
+
+	pool, err := pgxpool.NewWithConfig(ctx, cfg)
+	require.NoError(t, err)
+	t.Cleanup(pool.Close)
+	return pool
+}
+
+func migrate(t *testing.T, connConfig *pgx.ConnConfig) {
+	t.Helper()
+
+	db := stdlib.OpenDB(*connConfig.Copy())
+	defer db.Close()
+
+	provider, err := goose.NewProvider(goose.DialectPostgres, db, os.DirFS(migrationsDir))
+	require.NoError(t, err)
+	_, err = provider.Up(context.Background())
+	require.NoError(t, err, "apply migrations")
+}
@@ -0,0 +1,48 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vestamart/loms/internal/domain"
+	"github.com/vestamart/loms/internal/localErr"
+	"github.com/vestamart/loms/internal/repository/postgres"
+)
+
+func TestIdempotencyLockTakeover(t *testing.T) {
+	repo := postgres.NewIdempotencyRepositoryPostgres(newTestPool(t))
+	ctx := context.Background()
+	const method, lockTimeout = "/Loms/OrderPay", 200 * time.Millisecond
+
+	staleLease, record, err := repo.Acquire(ctx, method, "key", []byte("hash"), lockTimeout, time.Hour)
+	require.NoError(t, err)
+	require.Nil(t, record)
+	require.NotEmpty(t, staleLease)
+
+	_, record, err = repo.Acquire(ctx, method, "key", []byte("hash"), lockTimeout, time.Hour)
+	require.NoError(t, err)
+	require.NotNil(t, record)
+	assert.False(t, record.Completed)
+
+	time.Sleep(lockTimeout)
+	lease, record, err := repo.Acquire(ctx, method, "key", []byte("hash"), lockTimeout, time.Hour)
+	require.NoError(t, err)
+	assert.Nil(t, record, "expired lock must be taken over")
+	assert.NotEqual(t, staleLease, lease)
+
+	// Прежний владелец не может ни завершить, ни освободить перехваченный ключ
+	err = repo.Complete(ctx, method, "key", staleLease, domain.IdempotencyRecord{StatusCode: 13})
+	assert.ErrorIs(t, err, localErr.IdempotencyLeaseLostErr)
+	require.NoError(t, repo.Release(ctx, method, "key", staleLease))
+
+	require.NoError(t, repo.Complete(ctx, method, "key", lease, domain.IdempotencyRecord{StatusCode: 0}))
+	time.Sleep(lockTimeout)
+	_, record, err = repo.Acquire(ctx, method, "key", []byte("hash"), lockTimeout, time.Hour)
+	require.NoError(t, err)
+	require.NotNil(t, record, "completed key is kept for ttl")
+	assert.True(t, record.Completed)
+	assert.Zero(t, record.StatusCode)
+}
@@ -0,0 +1,59 @@
+package postgres_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vestamart/loms/internal/app/loms"
+	"github.com/vestamart/loms/internal/domain"
+	"github.com/vestamart/loms/internal/repository/postgres"
+	desc "github.com/vestamart/loms/pkg/api/loms/v1"
+)
+
+// Реплики делят просроченные заказы через SKIP LOCKED: каждый заказ отменяется ровно один раз
+func TestCancelExpiredConcurrentReplicas(t *testing.T) {
+	pool := newTestPool(t)
+	stocks := postgres.NewStocksRepositoryPostgres(pool)
+	svc := loms.NewService(postgres.NewOrderRepositoryPostgres(pool), stocks, postgres.NewTxManager(pool), loms.NearestFirst)
+	ctx := context.Background()
+
+	const orderCount = 30
+	for i := 0; i < orderCount; i++ {
+		_, err := svc.OrderCreate(ctx, &desc.OrderCreateRequest{User: 1, Items: []*desc.Item{{Sku: 1002, Count: 1}}})
+		require.NoError(t, err)
+	}
+
+	var mu sync.Mutex
+	seen := make(map[int64]int)
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				cancelled, failed, err := svc.CancelExpired(ctx, time.Now().Add(time.Minute), nil, 4)
+				if !assert.NoError(t, err) || !assert.Empty(t, failed) || len(cancelled) == 0 {
+					return
+				}
+				mu.Lock()
+				for _, id := range cancelled {
+					seen[id]++
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.Len(t, seen, orderCount)
+	for id, n := range seen {
+		assert.Equal(t, 1, n, "order %d cancelled more than once", id)
+	}
+	got, err := stocks.GetBySKUs(ctx, []uint32{1002})
+	require.NoError(t, err)
+	assert.Equal(t, domain.StocksItem{TotalCount: 200, Reserved: 20}, got[1002])
+}
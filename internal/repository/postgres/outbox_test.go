@@ -0,0 +1,54 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vestamart/loms/internal/domain"
+	"github.com/vestamart/loms/internal/repository/postgres"
+)
+
+// Забранные события не видны другим репликам, пока их не освободят или не истечет lockTimeout
+func TestOutboxClaim(t *testing.T) {
+	pool := newTestPool(t)
+	orders := postgres.NewOrderRepositoryPostgres(pool)
+	outbox := postgres.NewOutboxRepositoryPostgres(pool)
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		_, err := orders.Create(ctx, 1, &[]domain.Item{{Sku: 1002, Count: 1}})
+		require.NoError(t, err)
+	}
+
+	claimed, err := outbox.FetchPending(ctx, 2, time.Minute)
+	require.NoError(t, err)
+	require.Len(t, claimed, 2)
+	assert.Less(t, claimed[0].ID, claimed[1].ID)
+
+	rest, err := outbox.FetchPending(ctx, 10, time.Minute)
+	require.NoError(t, err)
+	require.Len(t, rest, 1)
+	assert.Greater(t, rest[0].ID, claimed[1].ID)
+
+	require.NoError(t, outbox.MarkSent(ctx, []int64{claimed[0].ID}))
+	require.NoError(t, outbox.MarkFailed(ctx, claimed[1].ID, "broker unavailable"))
+	require.NoError(t, outbox.Release(ctx, []int64{rest[0].ID}))
+
+	pending, err := outbox.FetchPending(ctx, 10, time.Minute)
+	require.NoError(t, err)
+	require.Len(t, pending, 2)
+	assert.Equal(t, claimed[1].ID, pending[0].ID)
+	assert.EqualValues(t, 1, pending[0].Attempts)
+	assert.Equal(t, rest[0].ID, pending[1].ID)
+
+	// Событие, исчерпавшее попытки, больше не выбирается
+	require.NoError(t, outbox.MarkDead(ctx, pending[0].ID, "message too large"))
+	require.NoError(t, outbox.Release(ctx, []int64{pending[1].ID}))
+	pending, err = outbox.FetchPending(ctx, 10, time.Minute)
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+	assert.Equal(t, rest[0].ID, pending[0].ID)
+}
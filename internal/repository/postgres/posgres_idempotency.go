@@ -0,0 +1,107 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/vestamart/loms/internal/domain"
+	"github.com/vestamart/loms/internal/localErr"
+	"time"
+)
+
+type IdempotencyRepositoryPostgres struct {
+	conn Conn
+}
+
+func NewIdempotencyRepositoryPostgres(conn Conn) *IdempotencyRepositoryPostgres {
+	return &IdempotencyRepositoryPostgres{conn: conn}
+}
+
+// Acquire занимает ключ на ttl и возвращает токен владельца. Незавершенный ключ, чей lockTimeout истек,
+// занимается заново. Если ключ уже занят, возвращает сохраненную запись, иначе nil. Попутно удаляются
+// ключи с истекшим ttl.
+func (r IdempotencyRepositoryPostgres) Acquire(ctx context.Context, method, key string, requestHash []byte, lockTimeout, ttl time.Duration) (string, *domain.IdempotencyRecord, error) {
+	lease := domain.NewIdempotencyLease()
+	var record *domain.IdempotencyRecord
+	err := inTx(ctx, r.conn, func(internalRepository *Queries) error {
+		if err := internalRepository.DeleteExpiredIdempotencyKeys(ctx); err != nil {
+			return fmt.Errorf("delete expired idempotency keys failed: %w", err)
+		}
+
+		rows, err := internalRepository.InsertIdempotencyKey(ctx, &InsertIdempotencyKeyParams{
+			Key:         key,
+			Method:      method,
+			RequestHash: requestHash,
+			Lease:       lease,
+			LockedUntil: pgtype.Timestamptz{Time: time.Now().Add(lockTimeout), Valid: true},
+			ExpiresAt:   pgtype.Timestamptz{Time: time.Now().Add(ttl), Valid: true},
+		})
+		if err != nil {
+			return fmt.Errorf("insert idempotency key failed: %w", err)
+		}
+		if rows == 1 {
+			return nil
+		}
+
+		resp, err := internalRepository.GetIdempotencyKey(ctx, &GetIdempotencyKeyParams{
+			Key:    key,
+			Method: method,
+		})
+		if err != nil {
+			return fmt.Errorf("get idempotency key failed: %w", err)
+		}
+
+		record = &domain.IdempotencyRecord{
+			RequestHash:   resp.RequestHash,
+			Completed:     resp.Completed,
+			Response:      resp.Response,
+			StatusCode:    uint32(resp.StatusCode),
+			StatusMessage: resp.StatusMessage,
+		}
+		return nil
+	})
+	if err != nil {
+		return "", nil, err
+	}
+	if record != nil {
+		return "", record, nil
+	}
+
+	return lease, nil, nil
+}
+
+// Complete сохраняет результат, если ключ все еще принадлежит lease, иначе возвращает IdempotencyLeaseLostErr
+func (r IdempotencyRepositoryPostgres) Complete(ctx context.Context, method, key, lease string, record domain.IdempotencyRecord) error {
+	internalRepository := New(conn(ctx, r.conn))
+	rows, err := internalRepository.CompleteIdempotencyKey(ctx, &CompleteIdempotencyKeyParams{
+		Response:      record.Response,
+		StatusCode:    int32(record.StatusCode),
+		StatusMessage: record.StatusMessage,
+		Key:           key,
+		Method:        method,
+		Lease:         lease,
+	})
+	if err != nil {
+		return fmt.Errorf("complete idempotency key failed: %w", err)
+	}
+	if rows == 0 {
+		return localErr.IdempotencyLeaseLostErr
+	}
+
+	return nil
+}
+
+// Release освобождает ключ, если он все еще принадлежит lease
+func (r IdempotencyRepositoryPostgres) Release(ctx context.Context, method, key, lease string) error {
+	internalRepository := New(conn(ctx, r.conn))
+	err := internalRepository.DeleteIdempotencyKey(ctx, &DeleteIdempotencyKeyParams{
+		Key:    key,
+		Method: method,
+		Lease:  lease,
+	})
+	if err != nil {
+		return fmt.Errorf("delete idempotency key failed: %w", err)
+	}
+
+	return nil
+}
@@ -3,68 +3,140 @@ package postgres
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/vestamart/loms/internal/domain"
+	"github.com/vestamart/loms/internal/localErr"
+	"log/slog"
+	"time"
 )
 
 type OrderRepositoryPostgres struct {
-	conn *pgx.Conn
+	conn Conn
 }
 
-func NewOrderRepositoryPostgres(conn *pgx.Conn) *OrderRepositoryPostgres {
+func NewOrderRepositoryPostgres(conn Conn) *OrderRepositoryPostgres {
 	return &OrderRepositoryPostgres{conn: conn}
 }
 
 func (r OrderRepositoryPostgres) Create(ctx context.Context, userID int64, items *[]domain.Item) (int64, error) {
-	internalRepository := New(r.conn)
-	orderID, err := internalRepository.InsertOrder(ctx, &InsertOrderParams{
-		UserID: userID,
-		Status: 0,
+	var orderID int64
+	err := inTx(ctx, r.conn, func(internalRepository *Queries) (err error) {
+		orderID, err = internalRepository.InsertOrder(ctx, &InsertOrderParams{
+			UserID: userID,
+			Status: 0,
+		})
+		if err != nil {
+			return fmt.Errorf("create order failed: %w", err)
+		}
+
+		for _, item := range *items {
+			params := &InsertItemsParams{
+				Sku:   int32(item.Sku),
+				Count: int32(item.Count),
+			}
+			if item.WarehouseID != 0 {
+				warehouseID := int32(item.WarehouseID)
+				params.WarehouseID = &warehouseID
+			}
+
+			itemID, err := internalRepository.InsertItems(ctx, params)
+			if err != nil {
+				return fmt.Errorf("insert items failed: %w", err)
+			}
+
+			err = internalRepository.InsertOrderItems(ctx, &InsertOrderItemsParams{
+				OrderID: orderID,
+				ItemID:  itemID,
+			})
+			if err != nil {
+				return fmt.Errorf("insert order items failed : %w", err)
+			}
+		}
+
+		return writeOrderEvent(ctx, internalRepository, domain.NewOrderEvent(orderID, domain.New, "order created"))
 	})
 	if err != nil {
-		return 0, fmt.Errorf("create order failed: %w", err)
+		return 0, err
 	}
+	slog.DebugContext(ctx, "order inserted", "order_id", orderID, "items", len(*items))
+
+	return orderID, nil
+}
 
-	for _, item := range *items {
-		itemID, err := internalRepository.InsertItems(ctx, &InsertItemsParams{
-			Sku:   int32(item.Sku),
-			Count: int32(item.Count),
+// SetStatus переводит заказ в status, только если он находится в статусе expected.
+// Переход с причиной reason записывается в историю статусов и в outbox.
+func (r OrderRepositoryPostgres) SetStatus(ctx context.Context, orderID int64, expected, status domain.OrderStatus, reason string) error {
+	return inTx(ctx, r.conn, func(internalRepository *Queries) error {
+		rows, err := internalRepository.UpdateStatusOrders(ctx, &UpdateStatusOrdersParams{
+			Status:   int16(status),
+			OrderID:  orderID,
+			Expected: int16(expected),
 		})
 		if err != nil {
-			return 0, fmt.Errorf("insert items failed: %w", err)
+			return fmt.Errorf("update status failed: %w", err)
+		}
+		if rows == 0 {
+			exists, err := internalRepository.OrderExists(ctx, orderID)
+			if err != nil {
+				return fmt.Errorf("failed to check order: %w", err)
+			}
+			if !exists {
+				return localErr.OrderNotFoundErr
+			}
+			return fmt.Errorf("order %d is not in status %s: %w", orderID, expected, localErr.InvalidStatusTransitionErr)
 		}
 
-		err = internalRepository.InsertOrderItems(ctx, &InsertOrderItemsParams{
-			OrderID: orderID,
-			ItemID:  itemID,
+		event := domain.NewOrderEvent(orderID, status, reason)
+		err = internalRepository.InsertOrderStatusHistory(ctx, &InsertOrderStatusHistoryParams{
+			OrderID:   orderID,
+			OldStatus: int16(expected),
+			NewStatus: int16(status),
+			Reason:    event.Info,
+			ChangedAt: timestamptz(event.Timestamp),
 		})
 		if err != nil {
-			return 0, fmt.Errorf("insert order items failed : %w", err)
+			return fmt.Errorf("insert status history failed: %w", err)
 		}
-	}
 
-	return orderID, nil
-
-}
+		if err = writeOrderEvent(ctx, internalRepository, event); err != nil {
+			return err
+		}
+		slog.DebugContext(ctx, "order status updated", "from", expected.String(), "to", status.String(), "reason", event.Info)
 
-func (r OrderRepositoryPostgres) SetStatus(ctx context.Context, orderID int64, status domain.OrderStatus) error {
-	internalRepository := New(r.conn)
-	err := internalRepository.UpdateStatusOrders(ctx, &UpdateStatusOrdersParams{
-		Status:  int16(status),
-		OrderID: orderID,
+		return nil
 	})
+}
 
+// History возвращает переходы заказа между статусами в порядке их совершения
+func (r OrderRepositoryPostgres) History(ctx context.Context, orderID int64) ([]domain.StatusChange, error) {
+	internalRepository := New(conn(ctx, r.conn))
+	rows, err := internalRepository.GetOrderStatusHistory(ctx, orderID)
 	if err != nil {
-		return fmt.Errorf("update status failed: %w", err)
+		return nil, fmt.Errorf("get status history failed: %w", err)
+	}
+
+	history := make([]domain.StatusChange, 0, len(rows))
+	for _, row := range rows {
+		history = append(history, domain.StatusChange{
+			From:      domain.OrderStatus(row.OldStatus),
+			To:        domain.OrderStatus(row.NewStatus),
+			Reason:    row.Reason,
+			ChangedAt: row.ChangedAt.Time,
+		})
 	}
 
-	return nil
+	return history, nil
 }
 
 func (r OrderRepositoryPostgres) GetByID(ctx context.Context, orderID int64) (*domain.Order, error) {
-	internalRepository := New(r.conn)
+	internalRepository := New(conn(ctx, r.conn))
 	resp, err := internalRepository.GetInfoFromOrders(ctx, orderID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, localErr.OrderNotFoundErr
+	}
 	if err != nil {
 		return nil, fmt.Errorf("get info from order failed: %w", err)
 	}
@@ -74,10 +146,101 @@ func (r OrderRepositoryPostgres) GetByID(ctx context.Context, orderID int64) (*d
 	}
 
 	response := domain.Order{
-		UserID: resp.UserID,
-		Status: domain.OrderStatus(resp.Status),
-		Items:  items,
+		ID:        orderID,
+		UserID:    resp.UserID,
+		Status:    domain.OrderStatus(resp.Status),
+		Items:     items,
+		CreatedAt: resp.CreatedAt.Time,
+		UpdatedAt: resp.UpdatedAt.Time,
 	}
 
 	return &response, nil
 }
+
+func (r OrderRepositoryPostgres) List(ctx context.Context, filter domain.OrderFilter) ([]domain.Order, error) {
+	// nil-слайс передается как NULL, а пустой массив означает "без фильтра по статусу"
+	statuses := make([]int16, 0, len(filter.Statuses))
+	for _, v := range filter.Statuses {
+		statuses = append(statuses, int16(v))
+	}
+
+	params := &ListOrdersParams{
+		UserID:      filter.UserID,
+		Statuses:    statuses,
+		CreatedFrom: timestamptz(filter.CreatedFrom),
+		CreatedTo:   timestamptz(filter.CreatedTo),
+		PageSize:    filter.Limit,
+	}
+	if filter.After != nil {
+		params.CursorCreatedAt = timestamptz(filter.After.CreatedAt)
+		params.CursorID = &filter.After.ID
+	}
+
+	internalRepository := New(conn(ctx, r.conn))
+	rows, err := internalRepository.ListOrders(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("list orders failed: %w", err)
+	}
+
+	orders := make([]domain.Order, 0, len(rows))
+	for _, row := range rows {
+		var items []domain.Item
+		if err = json.Unmarshal(row.Items, &items); err != nil {
+			return nil, fmt.Errorf("unmarshal items failed: %w", err)
+		}
+
+		orders = append(orders, domain.Order{
+			ID:        row.ID,
+			UserID:    row.UserID,
+			Status:    domain.OrderStatus(row.Status),
+			Items:     items,
+			CreatedAt: row.CreatedAt.Time,
+			UpdatedAt: row.UpdatedAt.Time,
+		})
+	}
+
+	return orders, nil
+}
+
+// ListExpired блокирует до limit заказов в статусе status, не менявшихся с updatedBefore.
+// Заказы из exclude и заблокированные другими репликами пропускаются, поэтому вызывать нужно внутри транзакции.
+func (r OrderRepositoryPostgres) ListExpired(ctx context.Context, status domain.OrderStatus, updatedBefore time.Time, exclude []int64, limit int32) ([]int64, error) {
+	// nil-слайс передается как NULL, а id <> ALL(NULL) не пропускает ни одной строки
+	if exclude == nil {
+		exclude = []int64{}
+	}
+
+	internalRepository := New(conn(ctx, r.conn))
+	ids, err := internalRepository.GetExpiredOrders(ctx, &GetExpiredOrdersParams{
+		Status:        int16(status),
+		UpdatedBefore: timestamptz(updatedBefore),
+		Exclude:       exclude,
+		BatchSize:     limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get expired orders failed: %w", err)
+	}
+
+	return ids, nil
+}
+
+// CountByStatus возвращает количество заказов в каждом статусе
+func (r OrderRepositoryPostgres) CountByStatus(ctx context.Context) (map[domain.OrderStatus]int64, error) {
+	internalRepository := New(conn(ctx, r.conn))
+	rows, err := internalRepository.CountOrdersByStatus(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("count orders failed: %w", err)
+	}
+
+	result := make(map[domain.OrderStatus]int64, len(rows))
+	for _, row := range rows {
+		result[domain.OrderStatus(row.Status)] = row.Count
+	}
+
+	return result, nil
+}
+
+// timestamptz переводит нулевое время в NULL
+func timestamptz(t time.Time) pgtype.Timestamptz {
+	return pgtype.Timestamptz{Time: t, Valid: !t.IsZero()}
+}
@@ -0,0 +1,113 @@
+package postgres
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/vestamart/loms/internal/domain"
+	"slices"
+	"time"
+)
+
+type OutboxRepositoryPostgres struct {
+	conn Conn
+}
+
+func NewOutboxRepositoryPostgres(conn Conn) *OutboxRepositoryPostgres {
+	return &OutboxRepositoryPostgres{conn: conn}
+}
+
+// FetchPending забирает до limit неотправленных событий на lockTimeout. Забранные другими
+// репликами события пропускаются до истечения их lockTimeout
+func (r OutboxRepositoryPostgres) FetchPending(ctx context.Context, limit int32, lockTimeout time.Duration) ([]domain.OutboxMessage, error) {
+	internalRepository := New(conn(ctx, r.conn))
+	rows, err := internalRepository.ClaimPendingOutbox(ctx, &ClaimPendingOutboxParams{
+		LockedUntil: pgtype.Timestamptz{Time: time.Now().Add(lockTimeout), Valid: true},
+		BatchSize:   limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("claim pending outbox failed: %w", err)
+	}
+
+	messages := make([]domain.OutboxMessage, 0, len(rows))
+	for _, row := range rows {
+		messages = append(messages, domain.OutboxMessage{
+			ID:       row.ID,
+			OrderID:  row.OrderID,
+			Payload:  row.Payload,
+			Attempts: row.Attempts,
+		})
+	}
+	// RETURNING не сохраняет порядок подзапроса
+	slices.SortFunc(messages, func(a, b domain.OutboxMessage) int { return cmp.Compare(a.ID, b.ID) })
+
+	return messages, nil
+}
+
+func (r OutboxRepositoryPostgres) MarkSent(ctx context.Context, ids []int64) error {
+	internalRepository := New(conn(ctx, r.conn))
+	if err := internalRepository.MarkSentOutbox(ctx, ids); err != nil {
+		return fmt.Errorf("mark outbox sent failed: %w", err)
+	}
+
+	return nil
+}
+
+// MarkFailed записывает ошибку отправки и освобождает событие
+func (r OutboxRepositoryPostgres) MarkFailed(ctx context.Context, id int64, reason string) error {
+	internalRepository := New(conn(ctx, r.conn))
+	err := internalRepository.MarkFailedOutbox(ctx, &MarkFailedOutboxParams{
+		LastError: &reason,
+		ID:        id,
+	})
+	if err != nil {
+		return fmt.Errorf("mark outbox failed failed: %w", err)
+	}
+
+	return nil
+}
+
+// MarkDead записывает последнюю ошибку и исключает событие из отправки
+func (r OutboxRepositoryPostgres) MarkDead(ctx context.Context, id int64, reason string) error {
+	internalRepository := New(conn(ctx, r.conn))
+	err := internalRepository.MarkDeadOutbox(ctx, &MarkDeadOutboxParams{
+		LastError: &reason,
+		ID:        id,
+	})
+	if err != nil {
+		return fmt.Errorf("mark outbox dead failed: %w", err)
+	}
+
+	return nil
+}
+
+// Release освобождает забранные, но не отправленные события
+func (r OutboxRepositoryPostgres) Release(ctx context.Context, ids []int64) error {
+	internalRepository := New(conn(ctx, r.conn))
+	if err := internalRepository.ReleaseOutbox(ctx, ids); err != nil {
+		return fmt.Errorf("release outbox failed: %w", err)
+	}
+
+	return nil
+}
+
+// writeOrderEvent пишет событие о смене статуса заказа в транзакции q
+func writeOrderEvent(ctx context.Context, q *Queries, event domain.OrderEvent) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("marshal order event failed: %w", err)
+	}
+
+	err = q.InsertOutbox(ctx, &InsertOutboxParams{
+		OrderID:   event.OrderID,
+		EventType: event.EventType,
+		Payload:   payload,
+	})
+	if err != nil {
+		return fmt.Errorf("insert outbox failed: %w", err)
+	}
+
+	return nil
+}
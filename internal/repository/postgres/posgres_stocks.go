@@ -1,102 +1,371 @@
 package postgres
 
 import (
+	"cmp"
 	"context"
+	"errors"
 	"fmt"
 	"github.com/jackc/pgx/v5"
+	"github.com/vestamart/loms/internal/domain"
 	"github.com/vestamart/loms/internal/localErr"
+	"log/slog"
+	"slices"
 )
 
-func NewStocksRepositoryPostgres(conn *pgx.Conn) *StocksRepositoryPostgres {
+func NewStocksRepositoryPostgres(conn Conn) *StocksRepositoryPostgres {
 	return &StocksRepositoryPostgres{conn: conn}
 }
 
 type StocksRepositoryPostgres struct {
-	conn *pgx.Conn
+	conn Conn
 }
 
-func (s StocksRepositoryPostgres) Reserve(ctx context.Context, sku uint32, count uint32) error {
-	err := pgx.BeginFunc(ctx, s.conn, func(tx pgx.Tx) (err error) {
-		internalRepository := New(tx)
-		resp, err := internalRepository.GetBySKIStocks(ctx, int32(sku))
-		if err != nil {
-			return fmt.Errorf("failed to get reserved stocks: %w", err)
-		}
-		if resp.TotalCount-resp.Reserved < int32(count) {
-			return localErr.ItemNotEnoughErr
+// maxStockRetries сколько раз повторяется условный UPDATE стока, который меняют параллельные транзакции
+const maxStockRetries = 3
+
+// errStockChanged условный UPDATE не изменил сток, хотя перечитанный сток проходит проверку:
+// сток успела изменить параллельная транзакция, и UPDATE можно повторить
+var errStockChanged = errors.New("stock changed concurrently")
+
+// retryStock повторяет update, пока сток меняется параллельно, но не больше maxStockRetries раз.
+// В READ COMMITTED каждый повтор видит последнее зафиксированное значение стока
+func retryStock(update func() error) error {
+	var err error
+	for range maxStockRetries {
+		if err = update(); !errors.Is(err, errStockChanged) {
+			return err
 		}
-		err = internalRepository.ReserveStocks(ctx, &ReserveStocksParams{
-			Reserved: resp.Reserved + int32(count),
-			Sku:      int32(sku),
+	}
+	return err
+}
+
+// Reserve резервирует count единиц одним условным UPDATE, поэтому параллельные резервы
+// одного стока не могут превысить свободный остаток. Если сток так и не удалось зарезервировать
+// из-за параллельных изменений, возвращается localErr.ItemNotEnoughErr
+func (s StocksRepositoryPostgres) Reserve(ctx context.Context, key domain.StockKey, count uint32) error {
+	return inTx(ctx, s.conn, func(internalRepository *Queries) error {
+		err := retryStock(func() error {
+			rows, err := internalRepository.ReserveStocks(ctx, &ReserveStocksParams{
+				Count:       int32(count),
+				WarehouseID: int32(key.WarehouseID),
+				Sku:         int32(key.Sku),
+			})
+			if err != nil {
+				return fmt.Errorf("failed to reserve stocks: %w", err)
+			}
+			if rows == 0 {
+				return s.stockError(ctx, internalRepository, key, func(item domain.StocksItem) error {
+					_, err := item.Reserve(count)
+					return err
+				})
+			}
+			return nil
 		})
+		if errors.Is(err, errStockChanged) {
+			return fmt.Errorf("%w: %w", localErr.ItemNotEnoughErr, err)
+		}
 		if err != nil {
-			return fmt.Errorf("failed to reserve stocks: %w", err)
+			return err
 		}
+		slog.DebugContext(ctx, "stocks reserved", "sku", key.Sku, "warehouse_id", key.WarehouseID, "count", count)
 
 		return nil
 	})
-	return err
 }
 
-func (s StocksRepositoryPostgres) ReserveRemove(ctx context.Context, skus map[uint32]uint32) error {
-	err := pgx.BeginFunc(ctx, s.conn, func(tx pgx.Tx) (err error) {
-		repository := New(tx)
-		for k, v := range skus {
-			resp, err := repository.GetBySKIStocks(ctx, int32(k))
+// ReserveRemove списывает зарезервированные товары. Стоки обновляются в порядке sortedKeys,
+// чтобы транзакции с несколькими стоками не блокировали друг друга взаимно
+func (s StocksRepositoryPostgres) ReserveRemove(ctx context.Context, items map[domain.StockKey]uint32) error {
+	return inTx(ctx, s.conn, func(repository *Queries) error {
+		for _, k := range sortedKeys(items) {
+			err := retryStock(func() error {
+				rows, err := repository.ReserveRemoveStocks(ctx, &ReserveRemoveStocksParams{
+					Count:       int32(items[k]),
+					WarehouseID: int32(k.WarehouseID),
+					Sku:         int32(k.Sku),
+				})
+				if err != nil {
+					return fmt.Errorf("failed to reserve remove stocks: %w", err)
+				}
+				if rows == 0 {
+					return s.stockError(ctx, repository, k, func(item domain.StocksItem) error {
+						_, err := item.ReserveRemove(items[k])
+						return err
+					})
+				}
+				return nil
+			})
 			if err != nil {
-				return fmt.Errorf("failed to get stocks: %w", err)
-			}
-
-			if resp.TotalCount-resp.Reserved < int32(v) {
-				return localErr.ItemNotEnoughErr
+				return err
 			}
+		}
+		return nil
+	})
+}
 
-			err = repository.ReserveRemoveStocks(ctx, &ReserveRemoveStocksParams{
-				Reserved:   resp.Reserved + int32(v),
-				Sku:        int32(k),
-				TotalCount: resp.TotalCount - int32(v),
+// ReserveCancel возвращает зарезервированные товары в свободный остаток в порядке sortedKeys
+func (s StocksRepositoryPostgres) ReserveCancel(ctx context.Context, items map[domain.StockKey]uint32) error {
+	return inTx(ctx, s.conn, func(repository *Queries) error {
+		for _, k := range sortedKeys(items) {
+			err := retryStock(func() error {
+				rows, err := repository.ReserveCancelStocks(ctx, &ReserveCancelStocksParams{
+					Count:       int32(items[k]),
+					WarehouseID: int32(k.WarehouseID),
+					Sku:         int32(k.Sku),
+				})
+				if err != nil {
+					return fmt.Errorf("failed to reserve cancel stocks: %w", err)
+				}
+				if rows == 0 {
+					return s.stockError(ctx, repository, k, func(item domain.StocksItem) error {
+						_, err := item.ReserveCancel(items[k])
+						return err
+					})
+				}
+				return nil
 			})
 			if err != nil {
-				return fmt.Errorf("failed to reserve stocks: %w", err)
+				return err
 			}
 		}
 		return nil
 	})
-	return err
 }
 
-func (s StocksRepositoryPostgres) ReserveCancel(ctx context.Context, skus map[uint32]uint32) error {
-	err := pgx.BeginFunc(ctx, s.conn, func(tx pgx.Tx) (err error) {
-		repository := New(tx)
-		for k, v := range skus {
-			resp, err := repository.GetBySKIStocks(ctx, int32(k))
+// Create заводит сток sku на складе с нулевым резервом
+func (s StocksRepositoryPostgres) Create(ctx context.Context, key domain.StockKey, totalCount uint32) error {
+	if totalCount > domain.MaxStockCount {
+		return localErr.StockOverflowErr
+	}
+
+	return inTx(ctx, s.conn, func(internalRepository *Queries) error {
+		exists, err := internalRepository.WarehouseExists(ctx, int32(key.WarehouseID))
+		if err != nil {
+			return fmt.Errorf("failed to check warehouse: %w", err)
+		}
+		if !exists {
+			return localErr.WarehouseNotExistErr
+		}
+
+		rows, err := internalRepository.CreateStock(ctx, &CreateStockParams{
+			Sku:         int32(key.Sku),
+			WarehouseID: int32(key.WarehouseID),
+			TotalCount:  int32(totalCount),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create stock: %w", err)
+		}
+		if rows == 0 {
+			return localErr.StockAlreadyExistErr
+		}
+		slog.DebugContext(ctx, "stock created", "sku", key.Sku, "warehouse_id", key.WarehouseID, "total_count", totalCount)
+
+		return nil
+	})
+}
+
+// Replenish увеличивает total_count на count и возвращает новый сток
+func (s StocksRepositoryPostgres) Replenish(ctx context.Context, key domain.StockKey, count uint32) (domain.StocksItem, error) {
+	if count > domain.MaxStockCount {
+		return domain.StocksItem{}, localErr.StockOverflowErr
+	}
+
+	var resp *ReplenishStockRow
+	err := inTx(ctx, s.conn, func(internalRepository *Queries) error {
+		return retryStock(func() (err error) {
+			resp, err = internalRepository.ReplenishStock(ctx, &ReplenishStockParams{
+				Count:       int32(count),
+				WarehouseID: int32(key.WarehouseID),
+				Sku:         int32(key.Sku),
+			})
+			if errors.Is(err, pgx.ErrNoRows) {
+				return s.stockError(ctx, internalRepository, key, func(item domain.StocksItem) error {
+					_, err := item.Replenish(count)
+					return err
+				})
+			}
 			if err != nil {
-				return fmt.Errorf("failed to get stocks: %w", err)
+				return fmt.Errorf("failed to replenish stock: %w", err)
 			}
-			err = repository.ReserveCancelStocks(ctx, &ReserveCancelStocksParams{
-				Reserved: resp.Reserved + int32(v),
-				Sku:      int32(k),
+			return nil
+		})
+	})
+	if err != nil {
+		return domain.StocksItem{}, err
+	}
+	slog.DebugContext(ctx, "stock replenished", "sku", key.Sku, "warehouse_id", key.WarehouseID, "count", count)
+
+	return domain.StocksItem{TotalCount: uint32(resp.TotalCount), Reserved: uint32(resp.Reserved)}, nil
+}
+
+// Adjust меняет total_count на delta и записывает корректировку с причиной reason.
+// total_count не может стать меньше reserved.
+func (s StocksRepositoryPostgres) Adjust(ctx context.Context, key domain.StockKey, delta int32, reason string) (domain.StocksItem, error) {
+	var item domain.StocksItem
+	err := inTx(ctx, s.conn, func(internalRepository *Queries) error {
+		var resp *AdjustStockRow
+		err := retryStock(func() (err error) {
+			resp, err = internalRepository.AdjustStock(ctx, &AdjustStockParams{
+				Delta:       delta,
+				WarehouseID: int32(key.WarehouseID),
+				Sku:         int32(key.Sku),
 			})
+			if errors.Is(err, pgx.ErrNoRows) {
+				return s.stockError(ctx, internalRepository, key, func(item domain.StocksItem) error {
+					_, err := item.Adjust(int64(delta))
+					return err
+				})
+			}
 			if err != nil {
-				return fmt.Errorf("failed to reserve stocks: %w", err)
+				return fmt.Errorf("failed to adjust stock: %w", err)
 			}
+			return nil
+		})
+		if err != nil {
+			return err
 		}
+
+		err = internalRepository.InsertStockAdjustment(ctx, &InsertStockAdjustmentParams{
+			Sku:         int32(key.Sku),
+			WarehouseID: int32(key.WarehouseID),
+			Delta:       delta,
+			Reason:      reason,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to insert stock adjustment: %w", err)
+		}
+
+		item = domain.StocksItem{TotalCount: uint32(resp.TotalCount), Reserved: uint32(resp.Reserved)}
 		return nil
 	})
-	return err
+	if err != nil {
+		return domain.StocksItem{}, err
+	}
+	slog.DebugContext(ctx, "stock adjusted", "sku", key.Sku, "warehouse_id", key.WarehouseID, "delta", delta)
+
+	return item, nil
+}
+
+// Delete удаляет сток, если под него нет резервов
+func (s StocksRepositoryPostgres) Delete(ctx context.Context, key domain.StockKey) error {
+	return inTx(ctx, s.conn, func(internalRepository *Queries) error {
+		rows, err := internalRepository.DeleteStock(ctx, &DeleteStockParams{
+			WarehouseID: int32(key.WarehouseID),
+			Sku:         int32(key.Sku),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to delete stock: %w", err)
+		}
+		if rows == 0 {
+			return s.stockError(ctx, internalRepository, key, func(item domain.StocksItem) error {
+				return localErr.StockReservedErr
+			})
+		}
+		slog.DebugContext(ctx, "stock deleted", "sku", key.Sku, "warehouse_id", key.WarehouseID)
+
+		return nil
+	})
+}
+
+// stockError объясняет, почему условный UPDATE не изменил сток: localErr.SKUNotExistErr, если стока нет,
+// ошибка правила check из domain.StocksItem для текущего значения стока или errStockChanged, если check проходит
+func (s StocksRepositoryPostgres) stockError(ctx context.Context, internalRepository *Queries, key domain.StockKey, check func(item domain.StocksItem) error) error {
+	resp, err := internalRepository.GetBySKIStocks(ctx, &GetBySKIStocksParams{
+		WarehouseID: int32(key.WarehouseID),
+		Sku:         int32(key.Sku),
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return localErr.SKUNotExistErr
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get stocks: %w", err)
+	}
+
+	if err = check(domain.StocksItem{TotalCount: uint32(resp.TotalCount), Reserved: uint32(resp.Reserved)}); err != nil {
+		return fmt.Errorf("sku %d warehouse %d: %w", key.Sku, key.WarehouseID, err)
+	}
+	return fmt.Errorf("sku %d warehouse %d: %w", key.Sku, key.WarehouseID, errStockChanged)
 }
 
-func (s StocksRepositoryPostgres) GetBySKU(ctx context.Context, sku uint32) (uint32, uint32, error) {
+// GetWarehouseStocks возвращает стоки sku по складам в порядке приоритета складов
+func (s StocksRepositoryPostgres) GetWarehouseStocks(ctx context.Context, sku uint32) ([]domain.WarehouseStock, error) {
+	internalRepository := New(conn(ctx, s.conn))
+	rows, err := internalRepository.GetWarehouseStocks(ctx, int32(sku))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get stocks: %w", err)
+	}
+
+	stocks := make([]domain.WarehouseStock, 0, len(rows))
+	for _, row := range rows {
+		stocks = append(stocks, domain.WarehouseStock{
+			WarehouseID: uint32(row.WarehouseID),
+			Priority:    row.Priority,
+			StocksItem: domain.StocksItem{
+				TotalCount: uint32(row.TotalCount),
+				Reserved:   uint32(row.Reserved),
+			},
+		})
+	}
+
+	return stocks, nil
+}
+
+// GetBySKUs возвращает суммарные по складам стоки найденных sku, отсутствующих sku в результате нет
+func (s StocksRepositoryPostgres) GetBySKUs(ctx context.Context, skus []uint32) (map[uint32]domain.StocksItem, error) {
+	ids := make([]int32, 0, len(skus))
+	for _, v := range skus {
+		ids = append(ids, int32(v))
+	}
+
+	internalRepository := New(conn(ctx, s.conn))
+	rows, err := internalRepository.GetBySKUsStocks(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get stocks: %w", err)
+	}
+
+	result := make(map[uint32]domain.StocksItem, len(rows))
+	for _, row := range rows {
+		result[uint32(row.Sku)] = domain.StocksItem{
+			TotalCount: uint32(row.TotalCount),
+			Reserved:   uint32(row.Reserved),
+		}
+	}
 
-	internalRepository := New(s.conn)
-	resp, err := internalRepository.GetBySKIStocks(ctx, int32(sku))
+	return result, nil
+}
+
+// GetWarehouseTotals возвращает суммарные по всем sku стоки каждого склада
+func (s StocksRepositoryPostgres) GetWarehouseTotals(ctx context.Context) (map[uint32]domain.StocksItem, error) {
+	internalRepository := New(conn(ctx, s.conn))
+	rows, err := internalRepository.GetWarehouseTotals(ctx)
 	if err != nil {
-		return 0, 0, fmt.Errorf("failed to get stocks: %w", err)
+		return nil, fmt.Errorf("failed to get warehouse totals: %w", err)
 	}
 
-	return uint32(resp.TotalCount), uint32(resp.Reserved), nil
+	result := make(map[uint32]domain.StocksItem, len(rows))
+	for _, row := range rows {
+		result[uint32(row.WarehouseID)] = domain.StocksItem{
+			TotalCount: uint32(row.TotalCount),
+			Reserved:   uint32(row.Reserved),
+		}
+	}
+
+	return result, nil
+}
+
+// sortedKeys возвращает ключи стоков по возрастанию sku и склада, задавая единый порядок блокировок строк
+func sortedKeys(items map[domain.StockKey]uint32) []domain.StockKey {
+	keys := make([]domain.StockKey, 0, len(items))
+	for k := range items {
+		keys = append(keys, k)
+	}
+	slices.SortFunc(keys, compareKeys)
+	return keys
 }
 
-func (s StocksRepositoryPostgres) RollbackReserve(ctx context.Context, skus map[uint32]uint32) error {
-	return nil
+func compareKeys(a, b domain.StockKey) int {
+	if c := cmp.Compare(a.Sku, b.Sku); c != 0 {
+		return c
+	}
+	return cmp.Compare(a.WarehouseID, b.WarehouseID)
 }
@@ -9,15 +9,41 @@ import (
 )
 
 type Querier interface {
-	GetBySKIStocks(ctx context.Context, sku int32) (*GetBySKIStocksRow, error)
+	AdjustStock(ctx context.Context, arg *AdjustStockParams) (*AdjustStockRow, error)
+	ClaimPendingOutbox(ctx context.Context, arg *ClaimPendingOutboxParams) ([]*ClaimPendingOutboxRow, error)
+	CompleteIdempotencyKey(ctx context.Context, arg *CompleteIdempotencyKeyParams) (int64, error)
+	CountOrdersByStatus(ctx context.Context) ([]*CountOrdersByStatusRow, error)
+	CreateStock(ctx context.Context, arg *CreateStockParams) (int64, error)
+	DeleteExpiredIdempotencyKeys(ctx context.Context) error
+	DeleteIdempotencyKey(ctx context.Context, arg *DeleteIdempotencyKeyParams) error
+	DeleteStock(ctx context.Context, arg *DeleteStockParams) (int64, error)
+	GetBySKIStocks(ctx context.Context, arg *GetBySKIStocksParams) (*GetBySKIStocksRow, error)
+	GetBySKUsStocks(ctx context.Context, skus []int32) ([]*GetBySKUsStocksRow, error)
+	GetExpiredOrders(ctx context.Context, arg *GetExpiredOrdersParams) ([]int64, error)
+	GetIdempotencyKey(ctx context.Context, arg *GetIdempotencyKeyParams) (*GetIdempotencyKeyRow, error)
 	GetInfoFromOrders(ctx context.Context, orderID int64) (*GetInfoFromOrdersRow, error)
+	GetOrderStatusHistory(ctx context.Context, orderID int64) ([]*GetOrderStatusHistoryRow, error)
+	GetWarehouseStocks(ctx context.Context, sku int32) ([]*GetWarehouseStocksRow, error)
+	GetWarehouseTotals(ctx context.Context) ([]*GetWarehouseTotalsRow, error)
+	InsertIdempotencyKey(ctx context.Context, arg *InsertIdempotencyKeyParams) (int64, error)
 	InsertItems(ctx context.Context, arg *InsertItemsParams) (int64, error)
 	InsertOrder(ctx context.Context, arg *InsertOrderParams) (int64, error)
 	InsertOrderItems(ctx context.Context, arg *InsertOrderItemsParams) error
-	ReserveCancelStocks(ctx context.Context, arg *ReserveCancelStocksParams) error
-	ReserveRemoveStocks(ctx context.Context, arg *ReserveRemoveStocksParams) error
-	ReserveStocks(ctx context.Context, arg *ReserveStocksParams) error
-	UpdateStatusOrders(ctx context.Context, arg *UpdateStatusOrdersParams) error
+	InsertOrderStatusHistory(ctx context.Context, arg *InsertOrderStatusHistoryParams) error
+	InsertOutbox(ctx context.Context, arg *InsertOutboxParams) error
+	InsertStockAdjustment(ctx context.Context, arg *InsertStockAdjustmentParams) error
+	ListOrders(ctx context.Context, arg *ListOrdersParams) ([]*ListOrdersRow, error)
+	MarkDeadOutbox(ctx context.Context, arg *MarkDeadOutboxParams) error
+	MarkFailedOutbox(ctx context.Context, arg *MarkFailedOutboxParams) error
+	MarkSentOutbox(ctx context.Context, ids []int64) error
+	OrderExists(ctx context.Context, orderID int64) (bool, error)
+	ReleaseOutbox(ctx context.Context, ids []int64) error
+	ReplenishStock(ctx context.Context, arg *ReplenishStockParams) (*ReplenishStockRow, error)
+	ReserveCancelStocks(ctx context.Context, arg *ReserveCancelStocksParams) (int64, error)
+	ReserveRemoveStocks(ctx context.Context, arg *ReserveRemoveStocksParams) (int64, error)
+	ReserveStocks(ctx context.Context, arg *ReserveStocksParams) (int64, error)
+	UpdateStatusOrders(ctx context.Context, arg *UpdateStatusOrdersParams) (int64, error)
+	WarehouseExists(ctx context.Context, id int32) (bool, error)
 }
 
 var _ Querier = (*Queries)(nil)
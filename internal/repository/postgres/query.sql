@@ -6,9 +6,9 @@ VALUES (
 RETURNING id;
 
 -- name: InsertItems :one
-INSERT INTO items (sku, count)
+INSERT INTO items (sku, count, warehouse_id)
 VALUES (
-           @sku, @count
+           @sku, @count, sqlc.narg(warehouse_id)
        )
 RETURNING id;
 
@@ -18,35 +18,211 @@ VALUES (
            @order_id,@item_id
        );
 
--- name: UpdateStatusOrders :exec
-UPDATE orders SET status = @status WHERE id= @order_id;
+-- name: UpdateStatusOrders :execrows
+UPDATE orders
+SET status = @status,
+    updated_at = CURRENT_TIMESTAMP
+WHERE id= @order_id AND status = @expected;
+
+-- name: OrderExists :one
+SELECT EXISTS(SELECT 1 FROM orders WHERE id = @order_id);
 
 -- name: GetInfoFromOrders :one
 SELECT
     o.user_id,
     o.status,
-    JSON_AGG(JSON_BUILD_OBJECT('sku', i.sku, 'count', i.count)) AS items
+    o.created_at,
+    o.updated_at,
+    JSON_AGG(JSON_BUILD_OBJECT('sku', i.sku, 'count', i.count, 'warehouse_id', i.warehouse_id)) AS items
 FROM orders o
          JOIN order_items oi ON o.id = oi.order_id
          JOIN items i ON oi.item_id = i.id
 WHERE o.id= @order_id
 GROUP BY o.id;
 
+-- name: ListOrders :many
+SELECT
+    o.id,
+    o.user_id,
+    o.status,
+    o.created_at,
+    o.updated_at,
+    JSON_AGG(JSON_BUILD_OBJECT('sku', i.sku, 'count', i.count, 'warehouse_id', i.warehouse_id)) AS items
+FROM orders o
+         JOIN order_items oi ON o.id = oi.order_id
+         JOIN items i ON oi.item_id = i.id
+WHERE o.user_id= @user_id
+  AND (CARDINALITY(@statuses::SMALLINT[]) = 0 OR o.status = ANY(@statuses::SMALLINT[]))
+  AND (sqlc.narg(created_from)::TIMESTAMPTZ IS NULL OR o.created_at >= sqlc.narg(created_from))
+  AND (sqlc.narg(created_to)::TIMESTAMPTZ IS NULL OR o.created_at < sqlc.narg(created_to))
+  AND (sqlc.narg(cursor_created_at)::TIMESTAMPTZ IS NULL
+    OR (o.created_at, o.id) < (sqlc.narg(cursor_created_at), sqlc.narg(cursor_id)::BIGINT))
+GROUP BY o.id
+ORDER BY o.created_at DESC, o.id DESC
+LIMIT @page_size;
+
+-- name: CountOrdersByStatus :many
+SELECT status, COUNT(*) AS count FROM orders
+GROUP BY status;
+
+-- name: GetExpiredOrders :many
+SELECT id FROM orders
+WHERE status = @status AND updated_at < @updated_before AND id <> ALL(@exclude::BIGINT[])
+ORDER BY updated_at
+LIMIT @batch_size
+FOR UPDATE SKIP LOCKED;
+
+-- name: InsertOrderStatusHistory :exec
+INSERT INTO order_status_history (order_id, old_status, new_status, reason, changed_at)
+VALUES (
+           @order_id, @old_status, @new_status, @reason, @changed_at
+       );
 
--- name: ReserveStocks :exec
-UPDATE stocks SET reserved= @reserved WHERE id= @sku;
+-- name: GetOrderStatusHistory :many
+SELECT old_status, new_status, reason, changed_at
+FROM order_status_history
+WHERE order_id = @order_id
+ORDER BY changed_at, id;
 
--- name: ReserveRemoveStocks :exec
+-- name: ReserveStocks :execrows
 UPDATE stocks
-SET reserved=  @reserved,
-    total_count= @total_count
-WHERE id= @sku;
+SET reserved= reserved + @count
+WHERE warehouse_id= @warehouse_id AND sku= @sku AND total_count - reserved >= @count;
 
--- name: ReserveCancelStocks :exec
+-- name: ReserveRemoveStocks :execrows
 UPDATE stocks
-SET reserved= @reserved
-WHERE id= @sku;
+SET reserved=    reserved - @count,
+    total_count= total_count - @count
+WHERE warehouse_id= @warehouse_id AND sku= @sku AND reserved >= @count;
+
+-- name: ReserveCancelStocks :execrows
+UPDATE stocks
+SET reserved= reserved - @count
+WHERE warehouse_id= @warehouse_id AND sku= @sku AND reserved >= @count;
 
 -- name: GetBySKIStocks :one
 SELECT total_count, reserved FROM stocks
-WHERE id = @sku;
+WHERE warehouse_id= @warehouse_id AND sku = @sku;
+
+-- name: GetBySKUsStocks :many
+SELECT sku, SUM(total_count)::BIGINT AS total_count, SUM(reserved)::BIGINT AS reserved FROM stocks
+WHERE sku = ANY(@skus::INTEGER[])
+GROUP BY sku;
+
+-- name: GetWarehouseTotals :many
+SELECT warehouse_id, SUM(total_count)::BIGINT AS total_count, SUM(reserved)::BIGINT AS reserved FROM stocks
+GROUP BY warehouse_id;
+
+-- name: GetWarehouseStocks :many
+SELECT s.warehouse_id, w.priority, s.total_count, s.reserved
+FROM stocks s
+         JOIN warehouses w ON s.warehouse_id = w.id
+WHERE s.sku = @sku
+ORDER BY w.priority, s.warehouse_id;
+
+-- name: WarehouseExists :one
+SELECT EXISTS(SELECT 1 FROM warehouses WHERE id = @id);
+
+-- name: CreateStock :execrows
+INSERT INTO stocks (sku, warehouse_id, total_count, reserved)
+VALUES (@sku, @warehouse_id, @total_count, 0)
+ON CONFLICT (sku, warehouse_id) DO NOTHING;
+
+-- name: ReplenishStock :one
+UPDATE stocks
+SET total_count= total_count + @count
+WHERE warehouse_id= @warehouse_id AND sku= @sku AND total_count::BIGINT + @count <= 2147483647
+RETURNING total_count, reserved;
+
+-- name: AdjustStock :one
+UPDATE stocks
+SET total_count= total_count + @delta
+WHERE warehouse_id= @warehouse_id AND sku= @sku
+  AND total_count::BIGINT + @delta BETWEEN reserved AND 2147483647
+RETURNING total_count, reserved;
+
+-- name: InsertStockAdjustment :exec
+INSERT INTO stock_adjustments (sku, warehouse_id, delta, reason)
+VALUES (@sku, @warehouse_id, @delta, @reason);
+
+-- name: DeleteStock :execrows
+DELETE FROM stocks
+WHERE warehouse_id= @warehouse_id AND sku= @sku AND reserved = 0;
+
+-- name: InsertOutbox :exec
+INSERT INTO outbox (order_id, event_type, payload)
+VALUES (
+           @order_id, @event_type, @payload
+       );
+
+-- name: ClaimPendingOutbox :many
+UPDATE outbox
+SET locked_until= @locked_until
+WHERE id IN (
+    SELECT id FROM outbox
+    WHERE sent_at IS NULL
+      AND dead_at IS NULL
+      AND (locked_until IS NULL OR locked_until < CURRENT_TIMESTAMP)
+    ORDER BY id
+    LIMIT @batch_size
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING id, order_id, payload, attempts;
+
+-- name: MarkSentOutbox :exec
+UPDATE outbox
+SET sent_at= CURRENT_TIMESTAMP
+WHERE id = ANY(@ids::BIGINT[]);
+
+-- name: MarkFailedOutbox :exec
+UPDATE outbox
+SET attempts= attempts + 1,
+    last_error= @last_error,
+    locked_until= NULL
+WHERE id= @id;
+
+-- name: MarkDeadOutbox :exec
+UPDATE outbox
+SET attempts= attempts + 1,
+    last_error= @last_error,
+    locked_until= NULL,
+    dead_at= CURRENT_TIMESTAMP
+WHERE id= @id;
+
+-- name: ReleaseOutbox :exec
+UPDATE outbox
+SET locked_until= NULL
+WHERE id = ANY(@ids::BIGINT[]);
+
+-- name: DeleteExpiredIdempotencyKeys :exec
+DELETE FROM idempotency_keys
+WHERE expires_at < CURRENT_TIMESTAMP;
+
+-- name: InsertIdempotencyKey :execrows
+INSERT INTO idempotency_keys (key, method, request_hash, lease, locked_until, expires_at)
+VALUES (
+           @key, @method, @request_hash, @lease, @locked_until, @expires_at
+       )
+ON CONFLICT (key, method) DO UPDATE
+SET request_hash= EXCLUDED.request_hash,
+    lease= EXCLUDED.lease,
+    locked_until= EXCLUDED.locked_until,
+    expires_at= EXCLUDED.expires_at,
+    created_at= CURRENT_TIMESTAMP
+WHERE idempotency_keys.completed = FALSE AND idempotency_keys.locked_until < CURRENT_TIMESTAMP;
+
+-- name: GetIdempotencyKey :one
+SELECT request_hash, completed, response, status_code, status_message FROM idempotency_keys
+WHERE key= @key AND method= @method;
+
+-- name: CompleteIdempotencyKey :execrows
+UPDATE idempotency_keys
+SET completed= TRUE,
+    response= @response,
+    status_code= @status_code,
+    status_message= @status_message
+WHERE key= @key AND method= @method AND lease= @lease AND completed = FALSE;
+
+-- name: DeleteIdempotencyKey :exec
+DELETE FROM idempotency_keys
+WHERE key= @key AND method= @method AND lease= @lease AND completed = FALSE;
@@ -7,30 +7,347 @@ package postgres
 
 import (
 	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const adjustStock = `-- name: AdjustStock :one
+UPDATE stocks
+SET total_count= total_count + $1
+WHERE warehouse_id= $2 AND sku= $3
+  AND total_count::BIGINT + $1 BETWEEN reserved AND 2147483647
+RETURNING total_count, reserved
+`
+
+type AdjustStockParams struct {
+	Delta       int32
+	WarehouseID int32
+	Sku         int32
+}
+
+type AdjustStockRow struct {
+	TotalCount int32
+	Reserved   int32
+}
+
+func (q *Queries) AdjustStock(ctx context.Context, arg *AdjustStockParams) (*AdjustStockRow, error) {
+	row := q.db.QueryRow(ctx, adjustStock, arg.Delta, arg.WarehouseID, arg.Sku)
+	var i AdjustStockRow
+	err := row.Scan(&i.TotalCount, &i.Reserved)
+	return &i, err
+}
+
+const claimPendingOutbox = `-- name: ClaimPendingOutbox :many
+UPDATE outbox
+SET locked_until= $1
+WHERE id IN (
+    SELECT id FROM outbox
+    WHERE sent_at IS NULL
+      AND dead_at IS NULL
+      AND (locked_until IS NULL OR locked_until < CURRENT_TIMESTAMP)
+    ORDER BY id
+    LIMIT $2
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING id, order_id, payload, attempts
+`
+
+type ClaimPendingOutboxParams struct {
+	LockedUntil pgtype.Timestamptz
+	BatchSize   int32
+}
+
+type ClaimPendingOutboxRow struct {
+	ID       int64
+	OrderID  int64
+	Payload  []byte
+	Attempts int32
+}
+
+func (q *Queries) ClaimPendingOutbox(ctx context.Context, arg *ClaimPendingOutboxParams) ([]*ClaimPendingOutboxRow, error) {
+	rows, err := q.db.Query(ctx, claimPendingOutbox, arg.LockedUntil, arg.BatchSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ClaimPendingOutboxRow
+	for rows.Next() {
+		var i ClaimPendingOutboxRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrderID,
+			&i.Payload,
+			&i.Attempts,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const completeIdempotencyKey = `-- name: CompleteIdempotencyKey :execrows
+UPDATE idempotency_keys
+SET completed= TRUE,
+    response= $1,
+    status_code= $2,
+    status_message= $3
+WHERE key= $4 AND method= $5 AND lease= $6 AND completed = FALSE
+`
+
+type CompleteIdempotencyKeyParams struct {
+	Response      []byte
+	StatusCode    int32
+	StatusMessage string
+	Key           string
+	Method        string
+	Lease         string
+}
+
+func (q *Queries) CompleteIdempotencyKey(ctx context.Context, arg *CompleteIdempotencyKeyParams) (int64, error) {
+	result, err := q.db.Exec(ctx, completeIdempotencyKey,
+		arg.Response,
+		arg.StatusCode,
+		arg.StatusMessage,
+		arg.Key,
+		arg.Method,
+		arg.Lease,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const countOrdersByStatus = `-- name: CountOrdersByStatus :many
+SELECT status, COUNT(*) AS count FROM orders
+GROUP BY status
+`
+
+type CountOrdersByStatusRow struct {
+	Status int16
+	Count  int64
+}
+
+func (q *Queries) CountOrdersByStatus(ctx context.Context) ([]*CountOrdersByStatusRow, error) {
+	rows, err := q.db.Query(ctx, countOrdersByStatus)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*CountOrdersByStatusRow
+	for rows.Next() {
+		var i CountOrdersByStatusRow
+		if err := rows.Scan(&i.Status, &i.Count); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const createStock = `-- name: CreateStock :execrows
+INSERT INTO stocks (sku, warehouse_id, total_count, reserved)
+VALUES ($1, $2, $3, 0)
+ON CONFLICT (sku, warehouse_id) DO NOTHING
+`
+
+type CreateStockParams struct {
+	Sku         int32
+	WarehouseID int32
+	TotalCount  int32
+}
+
+func (q *Queries) CreateStock(ctx context.Context, arg *CreateStockParams) (int64, error) {
+	result, err := q.db.Exec(ctx, createStock, arg.Sku, arg.WarehouseID, arg.TotalCount)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteExpiredIdempotencyKeys = `-- name: DeleteExpiredIdempotencyKeys :exec
+DELETE FROM idempotency_keys
+WHERE expires_at < CURRENT_TIMESTAMP
+`
+
+func (q *Queries) DeleteExpiredIdempotencyKeys(ctx context.Context) error {
+	_, err := q.db.Exec(ctx, deleteExpiredIdempotencyKeys)
+	return err
+}
+
+const deleteIdempotencyKey = `-- name: DeleteIdempotencyKey :exec
+DELETE FROM idempotency_keys
+WHERE key= $1 AND method= $2 AND lease= $3 AND completed = FALSE
+`
+
+type DeleteIdempotencyKeyParams struct {
+	Key    string
+	Method string
+	Lease  string
+}
+
+func (q *Queries) DeleteIdempotencyKey(ctx context.Context, arg *DeleteIdempotencyKeyParams) error {
+	_, err := q.db.Exec(ctx, deleteIdempotencyKey, arg.Key, arg.Method, arg.Lease)
+	return err
+}
+
+const deleteStock = `-- name: DeleteStock :execrows
+DELETE FROM stocks
+WHERE warehouse_id= $1 AND sku= $2 AND reserved = 0
+`
+
+type DeleteStockParams struct {
+	WarehouseID int32
+	Sku         int32
+}
+
+func (q *Queries) DeleteStock(ctx context.Context, arg *DeleteStockParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteStock, arg.WarehouseID, arg.Sku)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const getBySKIStocks = `-- name: GetBySKIStocks :one
 SELECT total_count, reserved FROM stocks
-WHERE id = $1
+WHERE warehouse_id= $1 AND sku = $2
 `
 
+type GetBySKIStocksParams struct {
+	WarehouseID int32
+	Sku         int32
+}
+
 type GetBySKIStocksRow struct {
 	TotalCount int32
 	Reserved   int32
 }
 
-func (q *Queries) GetBySKIStocks(ctx context.Context, sku int32) (*GetBySKIStocksRow, error) {
-	row := q.db.QueryRow(ctx, getBySKIStocks, sku)
+func (q *Queries) GetBySKIStocks(ctx context.Context, arg *GetBySKIStocksParams) (*GetBySKIStocksRow, error) {
+	row := q.db.QueryRow(ctx, getBySKIStocks, arg.WarehouseID, arg.Sku)
 	var i GetBySKIStocksRow
 	err := row.Scan(&i.TotalCount, &i.Reserved)
 	return &i, err
 }
 
+const getBySKUsStocks = `-- name: GetBySKUsStocks :many
+SELECT sku, SUM(total_count)::BIGINT AS total_count, SUM(reserved)::BIGINT AS reserved FROM stocks
+WHERE sku = ANY($1::INTEGER[])
+GROUP BY sku
+`
+
+type GetBySKUsStocksRow struct {
+	Sku        int32
+	TotalCount int64
+	Reserved   int64
+}
+
+func (q *Queries) GetBySKUsStocks(ctx context.Context, skus []int32) ([]*GetBySKUsStocksRow, error) {
+	rows, err := q.db.Query(ctx, getBySKUsStocks, skus)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*GetBySKUsStocksRow
+	for rows.Next() {
+		var i GetBySKUsStocksRow
+		if err := rows.Scan(&i.Sku, &i.TotalCount, &i.Reserved); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getExpiredOrders = `-- name: GetExpiredOrders :many
+SELECT id FROM orders
+WHERE status = $1 AND updated_at < $2 AND id <> ALL($3::BIGINT[])
+ORDER BY updated_at
+LIMIT $4
+FOR UPDATE SKIP LOCKED
+`
+
+type GetExpiredOrdersParams struct {
+	Status        int16
+	UpdatedBefore pgtype.Timestamptz
+	Exclude       []int64
+	BatchSize     int32
+}
+
+func (q *Queries) GetExpiredOrders(ctx context.Context, arg *GetExpiredOrdersParams) ([]int64, error) {
+	rows, err := q.db.Query(ctx, getExpiredOrders,
+		arg.Status,
+		arg.UpdatedBefore,
+		arg.Exclude,
+		arg.BatchSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []int64
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getIdempotencyKey = `-- name: GetIdempotencyKey :one
+SELECT request_hash, completed, response, status_code, status_message FROM idempotency_keys
+WHERE key= $1 AND method= $2
+`
+
+type GetIdempotencyKeyParams struct {
+	Key    string
+	Method string
+}
+
+type GetIdempotencyKeyRow struct {
+	RequestHash   []byte
+	Completed     bool
+	Response      []byte
+	StatusCode    int32
+	StatusMessage string
+}
+
+func (q *Queries) GetIdempotencyKey(ctx context.Context, arg *GetIdempotencyKeyParams) (*GetIdempotencyKeyRow, error) {
+	row := q.db.QueryRow(ctx, getIdempotencyKey, arg.Key, arg.Method)
+	var i GetIdempotencyKeyRow
+	err := row.Scan(
+		&i.RequestHash,
+		&i.Completed,
+		&i.Response,
+		&i.StatusCode,
+		&i.StatusMessage,
+	)
+	return &i, err
+}
+
 const getInfoFromOrders = `-- name: GetInfoFromOrders :one
 SELECT
     o.user_id,
     o.status,
-    JSON_AGG(JSON_BUILD_OBJECT('sku', i.sku, 'count', i.count)) AS items
+    o.created_at,
+    o.updated_at,
+    JSON_AGG(JSON_BUILD_OBJECT('sku', i.sku, 'count', i.count, 'warehouse_id', i.warehouse_id)) AS items
 FROM orders o
          JOIN order_items oi ON o.id = oi.order_id
          JOIN items i ON oi.item_id = i.id
@@ -39,33 +356,190 @@ GROUP BY o.id
 `
 
 type GetInfoFromOrdersRow struct {
-	UserID int64
-	Status int16
-	Items  []byte
+	UserID    int64
+	Status    int16
+	CreatedAt pgtype.Timestamptz
+	UpdatedAt pgtype.Timestamptz
+	Items     []byte
 }
 
 func (q *Queries) GetInfoFromOrders(ctx context.Context, orderID int64) (*GetInfoFromOrdersRow, error) {
 	row := q.db.QueryRow(ctx, getInfoFromOrders, orderID)
 	var i GetInfoFromOrdersRow
-	err := row.Scan(&i.UserID, &i.Status, &i.Items)
+	err := row.Scan(
+		&i.UserID,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Items,
+	)
 	return &i, err
 }
 
+const getOrderStatusHistory = `-- name: GetOrderStatusHistory :many
+SELECT old_status, new_status, reason, changed_at
+FROM order_status_history
+WHERE order_id = $1
+ORDER BY changed_at, id
+`
+
+type GetOrderStatusHistoryRow struct {
+	OldStatus int16
+	NewStatus int16
+	Reason    string
+	ChangedAt pgtype.Timestamptz
+}
+
+func (q *Queries) GetOrderStatusHistory(ctx context.Context, orderID int64) ([]*GetOrderStatusHistoryRow, error) {
+	rows, err := q.db.Query(ctx, getOrderStatusHistory, orderID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*GetOrderStatusHistoryRow
+	for rows.Next() {
+		var i GetOrderStatusHistoryRow
+		if err := rows.Scan(
+			&i.OldStatus,
+			&i.NewStatus,
+			&i.Reason,
+			&i.ChangedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getWarehouseStocks = `-- name: GetWarehouseStocks :many
+SELECT s.warehouse_id, w.priority, s.total_count, s.reserved
+FROM stocks s
+         JOIN warehouses w ON s.warehouse_id = w.id
+WHERE s.sku = $1
+ORDER BY w.priority, s.warehouse_id
+`
+
+type GetWarehouseStocksRow struct {
+	WarehouseID int32
+	Priority    int32
+	TotalCount  int32
+	Reserved    int32
+}
+
+func (q *Queries) GetWarehouseStocks(ctx context.Context, sku int32) ([]*GetWarehouseStocksRow, error) {
+	rows, err := q.db.Query(ctx, getWarehouseStocks, sku)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*GetWarehouseStocksRow
+	for rows.Next() {
+		var i GetWarehouseStocksRow
+		if err := rows.Scan(
+			&i.WarehouseID,
+			&i.Priority,
+			&i.TotalCount,
+			&i.Reserved,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getWarehouseTotals = `-- name: GetWarehouseTotals :many
+SELECT warehouse_id, SUM(total_count)::BIGINT AS total_count, SUM(reserved)::BIGINT AS reserved FROM stocks
+GROUP BY warehouse_id
+`
+
+type GetWarehouseTotalsRow struct {
+	WarehouseID int32
+	TotalCount  int64
+	Reserved    int64
+}
+
+func (q *Queries) GetWarehouseTotals(ctx context.Context) ([]*GetWarehouseTotalsRow, error) {
+	rows, err := q.db.Query(ctx, getWarehouseTotals)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*GetWarehouseTotalsRow
+	for rows.Next() {
+		var i GetWarehouseTotalsRow
+		if err := rows.Scan(&i.WarehouseID, &i.TotalCount, &i.Reserved); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const insertIdempotencyKey = `-- name: InsertIdempotencyKey :execrows
+INSERT INTO idempotency_keys (key, method, request_hash, lease, locked_until, expires_at)
+VALUES (
+           $1, $2, $3, $4, $5, $6
+       )
+ON CONFLICT (key, method) DO UPDATE
+SET request_hash= EXCLUDED.request_hash,
+    lease= EXCLUDED.lease,
+    locked_until= EXCLUDED.locked_until,
+    expires_at= EXCLUDED.expires_at,
+    created_at= CURRENT_TIMESTAMP
+WHERE idempotency_keys.completed = FALSE AND idempotency_keys.locked_until < CURRENT_TIMESTAMP
+`
+
+type InsertIdempotencyKeyParams struct {
+	Key         string
+	Method      string
+	RequestHash []byte
+	Lease       string
+	LockedUntil pgtype.Timestamptz
+	ExpiresAt   pgtype.Timestamptz
+}
+
+func (q *Queries) InsertIdempotencyKey(ctx context.Context, arg *InsertIdempotencyKeyParams) (int64, error) {
+	result, err := q.db.Exec(ctx, insertIdempotencyKey,
+		arg.Key,
+		arg.Method,
+		arg.RequestHash,
+		arg.Lease,
+		arg.LockedUntil,
+		arg.ExpiresAt,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const insertItems = `-- name: InsertItems :one
-INSERT INTO items (sku, count)
+INSERT INTO items (sku, count, warehouse_id)
 VALUES (
-           $1, $2
+           $1, $2, $3
        )
 RETURNING id
 `
 
 type InsertItemsParams struct {
-	Sku   int32
-	Count int32
+	Sku         int32
+	Count       int32
+	WarehouseID *int32
 }
 
 func (q *Queries) InsertItems(ctx context.Context, arg *InsertItemsParams) (int64, error) {
-	row := q.db.QueryRow(ctx, insertItems, arg.Sku, arg.Count)
+	row := q.db.QueryRow(ctx, insertItems, arg.Sku, arg.Count, arg.WarehouseID)
 	var id int64
 	err := row.Scan(&id)
 	return id, err
@@ -108,64 +582,332 @@ func (q *Queries) InsertOrderItems(ctx context.Context, arg *InsertOrderItemsPar
 	return err
 }
 
-const reserveCancelStocks = `-- name: ReserveCancelStocks :exec
-UPDATE stocks
-SET reserved= $1
+const insertOrderStatusHistory = `-- name: InsertOrderStatusHistory :exec
+INSERT INTO order_status_history (order_id, old_status, new_status, reason, changed_at)
+VALUES (
+           $1, $2, $3, $4, $5
+       )
+`
+
+type InsertOrderStatusHistoryParams struct {
+	OrderID   int64
+	OldStatus int16
+	NewStatus int16
+	Reason    string
+	ChangedAt pgtype.Timestamptz
+}
+
+func (q *Queries) InsertOrderStatusHistory(ctx context.Context, arg *InsertOrderStatusHistoryParams) error {
+	_, err := q.db.Exec(ctx, insertOrderStatusHistory,
+		arg.OrderID,
+		arg.OldStatus,
+		arg.NewStatus,
+		arg.Reason,
+		arg.ChangedAt,
+	)
+	return err
+}
+
+const insertOutbox = `-- name: InsertOutbox :exec
+INSERT INTO outbox (order_id, event_type, payload)
+VALUES (
+           $1, $2, $3
+       )
+`
+
+type InsertOutboxParams struct {
+	OrderID   int64
+	EventType string
+	Payload   []byte
+}
+
+func (q *Queries) InsertOutbox(ctx context.Context, arg *InsertOutboxParams) error {
+	_, err := q.db.Exec(ctx, insertOutbox, arg.OrderID, arg.EventType, arg.Payload)
+	return err
+}
+
+const insertStockAdjustment = `-- name: InsertStockAdjustment :exec
+INSERT INTO stock_adjustments (sku, warehouse_id, delta, reason)
+VALUES ($1, $2, $3, $4)
+`
+
+type InsertStockAdjustmentParams struct {
+	Sku         int32
+	WarehouseID int32
+	Delta       int32
+	Reason      string
+}
+
+func (q *Queries) InsertStockAdjustment(ctx context.Context, arg *InsertStockAdjustmentParams) error {
+	_, err := q.db.Exec(ctx, insertStockAdjustment,
+		arg.Sku,
+		arg.WarehouseID,
+		arg.Delta,
+		arg.Reason,
+	)
+	return err
+}
+
+const listOrders = `-- name: ListOrders :many
+SELECT
+    o.id,
+    o.user_id,
+    o.status,
+    o.created_at,
+    o.updated_at,
+    JSON_AGG(JSON_BUILD_OBJECT('sku', i.sku, 'count', i.count, 'warehouse_id', i.warehouse_id)) AS items
+FROM orders o
+         JOIN order_items oi ON o.id = oi.order_id
+         JOIN items i ON oi.item_id = i.id
+WHERE o.user_id= $1
+  AND (CARDINALITY($2::SMALLINT[]) = 0 OR o.status = ANY($2::SMALLINT[]))
+  AND ($3::TIMESTAMPTZ IS NULL OR o.created_at >= $3)
+  AND ($4::TIMESTAMPTZ IS NULL OR o.created_at < $4)
+  AND ($5::TIMESTAMPTZ IS NULL
+    OR (o.created_at, o.id) < ($5, $6::BIGINT))
+GROUP BY o.id
+ORDER BY o.created_at DESC, o.id DESC
+LIMIT $7
+`
+
+type ListOrdersParams struct {
+	UserID          int64
+	Statuses        []int16
+	CreatedFrom     pgtype.Timestamptz
+	CreatedTo       pgtype.Timestamptz
+	CursorCreatedAt pgtype.Timestamptz
+	CursorID        *int64
+	PageSize        int32
+}
+
+type ListOrdersRow struct {
+	ID        int64
+	UserID    int64
+	Status    int16
+	CreatedAt pgtype.Timestamptz
+	UpdatedAt pgtype.Timestamptz
+	Items     []byte
+}
+
+func (q *Queries) ListOrders(ctx context.Context, arg *ListOrdersParams) ([]*ListOrdersRow, error) {
+	rows, err := q.db.Query(ctx, listOrders,
+		arg.UserID,
+		arg.Statuses,
+		arg.CreatedFrom,
+		arg.CreatedTo,
+		arg.CursorCreatedAt,
+		arg.CursorID,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListOrdersRow
+	for rows.Next() {
+		var i ListOrdersRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Status,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Items,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const markDeadOutbox = `-- name: MarkDeadOutbox :exec
+UPDATE outbox
+SET attempts= attempts + 1,
+    last_error= $1,
+    locked_until= NULL,
+    dead_at= CURRENT_TIMESTAMP
 WHERE id= $2
 `
 
-type ReserveCancelStocksParams struct {
-	Reserved int32
-	Sku      int32
+type MarkDeadOutboxParams struct {
+	LastError *string
+	ID        int64
 }
 
-func (q *Queries) ReserveCancelStocks(ctx context.Context, arg *ReserveCancelStocksParams) error {
-	_, err := q.db.Exec(ctx, reserveCancelStocks, arg.Reserved, arg.Sku)
+func (q *Queries) MarkDeadOutbox(ctx context.Context, arg *MarkDeadOutboxParams) error {
+	_, err := q.db.Exec(ctx, markDeadOutbox, arg.LastError, arg.ID)
 	return err
 }
 
-const reserveRemoveStocks = `-- name: ReserveRemoveStocks :exec
+const markFailedOutbox = `-- name: MarkFailedOutbox :exec
+UPDATE outbox
+SET attempts= attempts + 1,
+    last_error= $1,
+    locked_until= NULL
+WHERE id= $2
+`
+
+type MarkFailedOutboxParams struct {
+	LastError *string
+	ID        int64
+}
+
+func (q *Queries) MarkFailedOutbox(ctx context.Context, arg *MarkFailedOutboxParams) error {
+	_, err := q.db.Exec(ctx, markFailedOutbox, arg.LastError, arg.ID)
+	return err
+}
+
+const markSentOutbox = `-- name: MarkSentOutbox :exec
+UPDATE outbox
+SET sent_at= CURRENT_TIMESTAMP
+WHERE id = ANY($1::BIGINT[])
+`
+
+func (q *Queries) MarkSentOutbox(ctx context.Context, ids []int64) error {
+	_, err := q.db.Exec(ctx, markSentOutbox, ids)
+	return err
+}
+
+const orderExists = `-- name: OrderExists :one
+SELECT EXISTS(SELECT 1 FROM orders WHERE id = $1)
+`
+
+func (q *Queries) OrderExists(ctx context.Context, orderID int64) (bool, error) {
+	row := q.db.QueryRow(ctx, orderExists, orderID)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const releaseOutbox = `-- name: ReleaseOutbox :exec
+UPDATE outbox
+SET locked_until= NULL
+WHERE id = ANY($1::BIGINT[])
+`
+
+func (q *Queries) ReleaseOutbox(ctx context.Context, ids []int64) error {
+	_, err := q.db.Exec(ctx, releaseOutbox, ids)
+	return err
+}
+
+const replenishStock = `-- name: ReplenishStock :one
 UPDATE stocks
-SET reserved=  $1,
-    total_count= $2
-WHERE id= $3
+SET total_count= total_count + $1
+WHERE warehouse_id= $2 AND sku= $3 AND total_count::BIGINT + $1 <= 2147483647
+RETURNING total_count, reserved
 `
 
-type ReserveRemoveStocksParams struct {
-	Reserved   int32
+type ReplenishStockParams struct {
+	Count       int32
+	WarehouseID int32
+	Sku         int32
+}
+
+type ReplenishStockRow struct {
 	TotalCount int32
-	Sku        int32
+	Reserved   int32
 }
 
-func (q *Queries) ReserveRemoveStocks(ctx context.Context, arg *ReserveRemoveStocksParams) error {
-	_, err := q.db.Exec(ctx, reserveRemoveStocks, arg.Reserved, arg.TotalCount, arg.Sku)
-	return err
+func (q *Queries) ReplenishStock(ctx context.Context, arg *ReplenishStockParams) (*ReplenishStockRow, error) {
+	row := q.db.QueryRow(ctx, replenishStock, arg.Count, arg.WarehouseID, arg.Sku)
+	var i ReplenishStockRow
+	err := row.Scan(&i.TotalCount, &i.Reserved)
+	return &i, err
+}
+
+const reserveCancelStocks = `-- name: ReserveCancelStocks :execrows
+UPDATE stocks
+SET reserved= reserved - $1
+WHERE warehouse_id= $2 AND sku= $3 AND reserved >= $1
+`
+
+type ReserveCancelStocksParams struct {
+	Count       int32
+	WarehouseID int32
+	Sku         int32
+}
+
+func (q *Queries) ReserveCancelStocks(ctx context.Context, arg *ReserveCancelStocksParams) (int64, error) {
+	result, err := q.db.Exec(ctx, reserveCancelStocks, arg.Count, arg.WarehouseID, arg.Sku)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const reserveRemoveStocks = `-- name: ReserveRemoveStocks :execrows
+UPDATE stocks
+SET reserved=    reserved - $1,
+    total_count= total_count - $1
+WHERE warehouse_id= $2 AND sku= $3 AND reserved >= $1
+`
+
+type ReserveRemoveStocksParams struct {
+	Count       int32
+	WarehouseID int32
+	Sku         int32
 }
 
-const reserveStocks = `-- name: ReserveStocks :exec
-UPDATE stocks SET reserved= $1 WHERE id= $2
+func (q *Queries) ReserveRemoveStocks(ctx context.Context, arg *ReserveRemoveStocksParams) (int64, error) {
+	result, err := q.db.Exec(ctx, reserveRemoveStocks, arg.Count, arg.WarehouseID, arg.Sku)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const reserveStocks = `-- name: ReserveStocks :execrows
+UPDATE stocks
+SET reserved= reserved + $1
+WHERE warehouse_id= $2 AND sku= $3 AND total_count - reserved >= $1
 `
 
 type ReserveStocksParams struct {
-	Reserved int32
-	Sku      int32
+	Count       int32
+	WarehouseID int32
+	Sku         int32
 }
 
-func (q *Queries) ReserveStocks(ctx context.Context, arg *ReserveStocksParams) error {
-	_, err := q.db.Exec(ctx, reserveStocks, arg.Reserved, arg.Sku)
-	return err
+func (q *Queries) ReserveStocks(ctx context.Context, arg *ReserveStocksParams) (int64, error) {
+	result, err := q.db.Exec(ctx, reserveStocks, arg.Count, arg.WarehouseID, arg.Sku)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
 }
 
-const updateStatusOrders = `-- name: UpdateStatusOrders :exec
-UPDATE orders SET status = $1 WHERE id= $2
+const updateStatusOrders = `-- name: UpdateStatusOrders :execrows
+UPDATE orders
+SET status = $1,
+    updated_at = CURRENT_TIMESTAMP
+WHERE id= $2 AND status = $3
 `
 
 type UpdateStatusOrdersParams struct {
-	Status  int16
-	OrderID int64
+	Status   int16
+	OrderID  int64
+	Expected int16
 }
 
-func (q *Queries) UpdateStatusOrders(ctx context.Context, arg *UpdateStatusOrdersParams) error {
-	_, err := q.db.Exec(ctx, updateStatusOrders, arg.Status, arg.OrderID)
-	return err
+func (q *Queries) UpdateStatusOrders(ctx context.Context, arg *UpdateStatusOrdersParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateStatusOrders, arg.Status, arg.OrderID, arg.Expected)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const warehouseExists = `-- name: WarehouseExists :one
+SELECT EXISTS(SELECT 1 FROM warehouses WHERE id = $1)
+`
+
+func (q *Queries) WarehouseExists(ctx context.Context, id int32) (bool, error) {
+	row := q.db.QueryRow(ctx, warehouseExists, id)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
 }
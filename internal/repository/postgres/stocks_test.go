@@ -0,0 +1,178 @@
+package postgres_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vestamart/loms/internal/app/loms"
+	"github.com/vestamart/loms/internal/delivery"
+	"github.com/vestamart/loms/internal/domain"
+	"github.com/vestamart/loms/internal/localErr"
+	"github.com/vestamart/loms/internal/repository/postgres"
+	desc "github.com/vestamart/loms/pkg/api/loms/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// newTestStock заводит сток с уникальным sku
+func newTestStock(t *testing.T, repo *postgres.StocksRepositoryPostgres, totalCount uint32) domain.StockKey {
+	t.Helper()
+
+	key := domain.StockKey{WarehouseID: domain.DefaultWarehouseID, Sku: uint32(900_000_000 + time.Now().UnixNano()%100_000_000)}
+	require.NoError(t, repo.Create(context.Background(), key, totalCount))
+	return key
+}
+
+// Заказы перечисляют sku в разном порядке, сервис должен блокировать стоки в одном порядке
+func TestReserveMultipleStocksWithoutDeadlock(t *testing.T) {
+	pool := newTestPool(t)
+	repo := postgres.NewStocksRepositoryPostgres(pool)
+	svc := loms.NewService(postgres.NewOrderRepositoryPostgres(pool), repo, postgres.NewTxManager(pool), loms.NearestFirst)
+	first := newTestStock(t, repo, 1000)
+	second := newTestStock(t, repo, 1000)
+	ctx := context.Background()
+
+	const workers = 20
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			items := []*desc.Item{{Sku: first.Sku, Count: 1}, {Sku: second.Sku, Count: 1}}
+			if i%2 == 1 {
+				items[0], items[1] = items[1], items[0]
+			}
+			resp, err := svc.OrderCreate(ctx, &desc.OrderCreateRequest{User: 1, Items: items})
+			if !assert.NoError(t, err) {
+				return
+			}
+			if i%2 == 0 {
+				_, err = svc.OrderPay(ctx, &desc.OrderPayRequest{OrderID: resp.OrderId})
+			} else {
+				_, err = svc.OrderCancel(ctx, &desc.OrderCancelRequest{OrderID: resp.OrderId})
+			}
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	stocks, err := repo.GetBySKUs(ctx, []uint32{first.Sku, second.Sku})
+	require.NoError(t, err)
+	for _, key := range []domain.StockKey{first, second} {
+		assert.Equal(t, domain.StocksItem{TotalCount: 1000 - workers/2, Reserved: 0}, stocks[key.Sku])
+	}
+}
+
+// Резерв, которому помешали параллельные отмены, не должен превращаться в Internal:
+// клиент получает ResourceExhausted, а заказ сохраняется со статусом failed
+func TestOrderCreateUnderContention(t *testing.T) {
+	pool := newTestPool(t)
+	repo := postgres.NewStocksRepositoryPostgres(pool)
+	svc := loms.NewService(postgres.NewOrderRepositoryPostgres(pool), repo, postgres.NewTxManager(pool), loms.NearestFirst)
+	server := delivery.NewServer(*svc)
+	key := newTestStock(t, repo, 5)
+	ctx := context.Background()
+
+	initial := make([]int64, 0, 5)
+	for range 5 {
+		resp, err := server.OrderCreate(ctx, &desc.OrderCreateRequest{User: 1, Items: []*desc.Item{{Sku: key.Sku, Count: 1}}})
+		require.NoError(t, err)
+		initial = append(initial, resp.OrderId)
+	}
+
+	const workers = 20
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	created, failed := 0, 0
+	for _, id := range initial {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := server.OrderCancel(ctx, &desc.OrderCancelRequest{OrderID: id})
+			assert.NoError(t, err)
+		}()
+	}
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := server.OrderCreate(ctx, &desc.OrderCreateRequest{User: 2, Items: []*desc.Item{{Sku: key.Sku, Count: 1}}})
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				assert.Equal(t, codes.ResourceExhausted, status.Code(err), "%v", err)
+				failed++
+				return
+			}
+			created++
+		}()
+	}
+	wg.Wait()
+
+	assert.LessOrEqual(t, created, 5)
+	stocks, err := repo.GetBySKUs(ctx, []uint32{key.Sku})
+	require.NoError(t, err)
+	assert.Equal(t, domain.StocksItem{TotalCount: 5, Reserved: uint32(created)}, stocks[key.Sku])
+
+	orders, err := svc.ListOrders(ctx, &desc.ListOrdersRequest{User: 2, Statuses: []desc.OrderStatus{desc.OrderStatus_FAILED}, PageSize: workers})
+	require.NoError(t, err)
+	assert.Len(t, orders.Orders, failed, "every rejected order must be saved as failed")
+}
+
+// ReserveRemove и ReserveCancel по нескольким стокам блокируют их в одном порядке
+func TestReserveRemoveCancelWithoutDeadlock(t *testing.T) {
+	pool := newTestPool(t)
+	repo := postgres.NewStocksRepositoryPostgres(pool)
+	first := newTestStock(t, repo, 1000)
+	second := newTestStock(t, repo, 1000)
+	ctx := context.Background()
+
+	const workers = 20
+	require.NoError(t, repo.Reserve(ctx, first, workers))
+	require.NoError(t, repo.Reserve(ctx, second, workers))
+
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			items := map[domain.StockKey]uint32{first: 1, second: 1}
+			if i%2 == 0 {
+				assert.NoError(t, repo.ReserveRemove(ctx, items))
+			} else {
+				assert.NoError(t, repo.ReserveCancel(ctx, items))
+			}
+		}()
+	}
+	wg.Wait()
+
+	stocks, err := repo.GetBySKUs(ctx, []uint32{first.Sku, second.Sku})
+	require.NoError(t, err)
+	for _, key := range []domain.StockKey{first, second} {
+		assert.Equal(t, domain.StocksItem{TotalCount: 1000 - workers/2, Reserved: 0}, stocks[key.Sku])
+	}
+}
+
+func TestReserveCancelCannotGoNegative(t *testing.T) {
+	pool := newTestPool(t)
+	repo := postgres.NewStocksRepositoryPostgres(pool)
+	key := newTestStock(t, repo, 10)
+
+	require.NoError(t, repo.Reserve(context.Background(), key, 2))
+	err := repo.ReserveCancel(context.Background(), map[domain.StockKey]uint32{key: 3})
+	assert.ErrorIs(t, err, localErr.ReservedNotEnoughErr)
+	err = repo.ReserveRemove(context.Background(), map[domain.StockKey]uint32{key: 3})
+	assert.ErrorIs(t, err, localErr.ReservedNotEnoughErr)
+
+	require.NoError(t, repo.ReserveRemove(context.Background(), map[domain.StockKey]uint32{key: 2}))
+	stocks, err := repo.GetBySKUs(context.Background(), []uint32{key.Sku})
+	require.NoError(t, err)
+	assert.Equal(t, domain.StocksItem{TotalCount: 8, Reserved: 0}, stocks[key.Sku])
+
+	_, err = pool.Exec(context.Background(), "UPDATE stocks SET reserved = total_count + 1 WHERE sku = $1", int32(key.Sku))
+	assert.Error(t, err, "stocks_reserved_check must reject reserved > total_count")
+}
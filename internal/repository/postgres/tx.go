@@ -0,0 +1,51 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// Conn общий интерфейс для *pgx.Conn, *pgxpool.Pool и pgx.Tx
+type Conn interface {
+	DBTX
+	Begin(ctx context.Context) (pgx.Tx, error)
+}
+
+type txKey struct{}
+
+// TxManager реализует unit of work поверх транзакций pgx.
+// Транзакция кладется в контекст, репозитории достают её оттуда через conn.
+type TxManager struct {
+	conn Conn
+}
+
+func NewTxManager(conn Conn) *TxManager {
+	return &TxManager{conn: conn}
+}
+
+// WithinTransaction выполняет fn в транзакции. Если в контексте уже есть транзакция,
+// создается savepoint, который откатывается независимо от внешней транзакции.
+func (m TxManager) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return pgx.BeginFunc(ctx, conn(ctx, m.conn), func(tx pgx.Tx) error {
+		return fn(context.WithValue(ctx, txKey{}, tx))
+	})
+}
+
+// conn возвращает транзакцию из контекста или fallback, если транзакции нет
+func conn(ctx context.Context, fallback Conn) Conn {
+	if tx, ok := ctx.Value(txKey{}).(pgx.Tx); ok {
+		return tx
+	}
+	return fallback
+}
+
+// inTx выполняет fn в транзакции из контекста, а если её нет - в новой
+func inTx(ctx context.Context, fallback Conn, fn func(q *Queries) error) error {
+	if tx, ok := ctx.Value(txKey{}).(pgx.Tx); ok {
+		return fn(New(tx))
+	}
+	return pgx.BeginFunc(ctx, fallback, func(tx pgx.Tx) error {
+		return fn(New(tx))
+	})
+}
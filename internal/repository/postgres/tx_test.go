@@ -0,0 +1,69 @@
+package postgres_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vestamart/loms/internal/app/loms"
+	"github.com/vestamart/loms/internal/domain"
+	"github.com/vestamart/loms/internal/localErr"
+	"github.com/vestamart/loms/internal/repository/postgres"
+	desc "github.com/vestamart/loms/pkg/api/loms/v1"
+)
+
+func TestTxManagerSavepointRollback(t *testing.T) {
+	pool := newTestPool(t)
+	stocks := postgres.NewStocksRepositoryPostgres(pool)
+	txManager := postgres.NewTxManager(pool)
+	ctx := context.Background()
+	first := domain.StockKey{WarehouseID: domain.DefaultWarehouseID, Sku: 1002}
+	second := domain.StockKey{WarehouseID: domain.DefaultWarehouseID, Sku: 1003}
+	errFail := errors.New("fail")
+
+	err := txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		require.NoError(t, stocks.Reserve(ctx, first, 1))
+		err := txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+			require.NoError(t, stocks.Reserve(ctx, second, 1))
+			return errFail
+		})
+		assert.ErrorIs(t, err, errFail)
+		return nil
+	})
+	require.NoError(t, err)
+
+	got, err := stocks.GetBySKUs(ctx, []uint32{1002, 1003})
+	require.NoError(t, err)
+	assert.Equal(t, domain.StocksItem{TotalCount: 200, Reserved: 21}, got[1002])
+	assert.Equal(t, domain.StocksItem{TotalCount: 250, Reserved: 30}, got[1003])
+}
+
+func TestOrderCreateRollsBackReservations(t *testing.T) {
+	pool := newTestPool(t)
+	orders := postgres.NewOrderRepositoryPostgres(pool)
+	stocks := postgres.NewStocksRepositoryPostgres(pool)
+	svc := loms.NewService(orders, stocks, postgres.NewTxManager(pool), loms.NearestFirst)
+	ctx := context.Background()
+
+	// 1002 и 1003 резервируются раньше 1005, которого не хватает
+	_, err := svc.OrderCreate(ctx, &desc.OrderCreateRequest{User: 7, Items: []*desc.Item{
+		{Sku: 1002, Count: 10},
+		{Sku: 1005, Count: 1000},
+		{Sku: 1003, Count: 10},
+	}})
+	require.ErrorIs(t, err, localErr.ItemNotEnoughErr)
+
+	got, err := stocks.GetBySKUs(ctx, []uint32{1002, 1003, 1005})
+	require.NoError(t, err)
+	assert.Equal(t, domain.StocksItem{TotalCount: 200, Reserved: 20}, got[1002])
+	assert.Equal(t, domain.StocksItem{TotalCount: 250, Reserved: 30}, got[1003])
+	assert.Equal(t, domain.StocksItem{TotalCount: 350, Reserved: 50}, got[1005])
+
+	list, err := orders.List(ctx, domain.OrderFilter{UserID: 7, Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	assert.Equal(t, domain.Failed, list[0].Status)
+	assert.ElementsMatch(t, []domain.Item{{Sku: 1002, Count: 10}, {Sku: 1005, Count: 1000}, {Sku: 1003, Count: 10}}, list[0].Items)
+}
@@ -0,0 +1,352 @@
+// Package repotest содержит общий контракт репозиториев LOMS.
+// Любой бэкенд подключается через Run и должен вести себя так же, как остальные.
+package repotest
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vestamart/loms/internal/app/loms"
+	"github.com/vestamart/loms/internal/domain"
+	"github.com/vestamart/loms/internal/localErr"
+)
+
+// Backend репозитории одного бэкенда. Стоки и заказы должны работать с одним хранилищем
+type Backend struct {
+	Orders loms.OrdersRepository
+	Stocks loms.StocksStorage
+}
+
+// NewBackend создает пустой бэкенд для одного теста. Стоки из начальных данных допустимы,
+// но sku контракта начинаются с baseSKU и не должны с ними пересекаться
+type NewBackend func(t *testing.T) Backend
+
+const baseSKU = 1_000_000
+
+// Run прогоняет контракт против бэкенда, каждый подтест получает новый Backend
+func Run(t *testing.T, newBackend NewBackend) {
+	t.Run("orders", func(t *testing.T) {
+		t.Run("create and get", func(t *testing.T) { testOrderCreateGet(t, newBackend(t)) })
+		t.Run("set status", func(t *testing.T) { testOrderSetStatus(t, newBackend(t)) })
+		t.Run("not found", func(t *testing.T) { testOrderNotFound(t, newBackend(t)) })
+		t.Run("list", func(t *testing.T) { testOrderList(t, newBackend(t)) })
+		t.Run("list expired", func(t *testing.T) { testOrderListExpired(t, newBackend(t)) })
+	})
+	t.Run("stocks", func(t *testing.T) {
+		t.Run("reserve pay cancel", func(t *testing.T) { testStockReservePayCancel(t, newBackend(t)) })
+		t.Run("insufficient stock", func(t *testing.T) { testStockInsufficient(t, newBackend(t)) })
+		t.Run("not found", func(t *testing.T) { testStockNotFound(t, newBackend(t)) })
+		t.Run("get by skus", func(t *testing.T) { testStockGetBySKUs(t, newBackend(t)) })
+		t.Run("admin", func(t *testing.T) { testStockAdmin(t, newBackend(t)) })
+		t.Run("concurrent reserve", func(t *testing.T) { testStockConcurrentReserve(t, newBackend(t)) })
+	})
+}
+
+func stockKey(n uint32) domain.StockKey {
+	return domain.StockKey{WarehouseID: domain.DefaultWarehouseID, Sku: baseSKU + n}
+}
+
+func createStock(t *testing.T, b Backend, key domain.StockKey, totalCount uint32) {
+	t.Helper()
+	require.NoError(t, b.Stocks.Create(context.Background(), key, totalCount))
+}
+
+func requireStock(t *testing.T, b Backend, key domain.StockKey, want domain.StocksItem) {
+	t.Helper()
+
+	got, err := b.Stocks.GetBySKUs(context.Background(), []uint32{key.Sku})
+	require.NoError(t, err)
+	require.Equal(t, want, got[key.Sku], "sku %d", key.Sku)
+}
+
+func createOrder(t *testing.T, b Backend, userID int64, items ...domain.Item) int64 {
+	t.Helper()
+
+	id, err := b.Orders.Create(context.Background(), userID, &items)
+	require.NoError(t, err)
+	return id
+}
+
+func testOrderCreateGet(t *testing.T, b Backend) {
+	ctx := context.Background()
+	items := []domain.Item{
+		{Sku: baseSKU + 1, Count: 2, WarehouseID: domain.DefaultWarehouseID},
+		{Sku: baseSKU + 2, Count: 1},
+	}
+
+	first := createOrder(t, b, 10, items...)
+	second := createOrder(t, b, 10, items[0])
+	assert.Greater(t, second, first, "order ids must increase")
+
+	order, err := b.Orders.GetByID(ctx, first)
+	require.NoError(t, err)
+	assert.Equal(t, first, order.ID)
+	assert.EqualValues(t, 10, order.UserID)
+	assert.Equal(t, domain.New, order.Status)
+	assert.ElementsMatch(t, items, order.Items)
+	assert.False(t, order.CreatedAt.IsZero())
+	assert.False(t, order.UpdatedAt.IsZero())
+
+	history, err := b.Orders.History(ctx, first)
+	require.NoError(t, err)
+	assert.Empty(t, history, "creation is not a status change")
+}
+
+func testOrderSetStatus(t *testing.T, b Backend) {
+	ctx := context.Background()
+	id := createOrder(t, b, 11, domain.Item{Sku: baseSKU + 1, Count: 1})
+
+	require.NoError(t, b.Orders.SetStatus(ctx, id, domain.New, domain.AwaitingPayment, "reserved"))
+	require.NoError(t, b.Orders.SetStatus(ctx, id, domain.AwaitingPayment, domain.Payed, "paid"))
+
+	err := b.Orders.SetStatus(ctx, id, domain.AwaitingPayment, domain.Cancelled, "late cancel")
+	assert.ErrorIs(t, err, localErr.InvalidStatusTransitionErr)
+
+	order, err := b.Orders.GetByID(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, domain.Payed, order.Status)
+
+	history, err := b.Orders.History(ctx, id)
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+	assert.Equal(t, domain.StatusChange{From: domain.New, To: domain.AwaitingPayment, Reason: "reserved", ChangedAt: history[0].ChangedAt}, history[0])
+	assert.Equal(t, domain.StatusChange{From: domain.AwaitingPayment, To: domain.Payed, Reason: "paid", ChangedAt: history[1].ChangedAt}, history[1])
+}
+
+func testOrderNotFound(t *testing.T, b Backend) {
+	ctx := context.Background()
+	const missing = int64(1) << 40
+
+	_, err := b.Orders.GetByID(ctx, missing)
+	assert.ErrorIs(t, err, localErr.OrderNotFoundErr)
+
+	err = b.Orders.SetStatus(ctx, missing, domain.New, domain.AwaitingPayment, "")
+	assert.ErrorIs(t, err, localErr.OrderNotFoundErr)
+
+	history, err := b.Orders.History(ctx, missing)
+	require.NoError(t, err)
+	assert.Empty(t, history)
+}
+
+func testOrderList(t *testing.T, b Backend) {
+	ctx := context.Background()
+	const userID = 12
+	item := domain.Item{Sku: baseSKU + 1, Count: 1}
+
+	ids := make([]int64, 0, 3)
+	for i := 0; i < 3; i++ {
+		ids = append(ids, createOrder(t, b, userID, item))
+	}
+	createOrder(t, b, userID+1, item)
+	require.NoError(t, b.Orders.SetStatus(ctx, ids[1], domain.New, domain.Failed, "no stock"))
+
+	orders, err := b.Orders.List(ctx, domain.OrderFilter{UserID: userID, Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, orders, 3)
+	for i, order := range orders {
+		assert.Equal(t, ids[len(ids)-1-i], order.ID, "newest orders first")
+	}
+
+	page, err := b.Orders.List(ctx, domain.OrderFilter{UserID: userID, Limit: 1})
+	require.NoError(t, err)
+	require.Len(t, page, 1)
+	next, err := b.Orders.List(ctx, domain.OrderFilter{
+		UserID: userID,
+		Limit:  10,
+		After:  &domain.OrderCursor{CreatedAt: page[0].CreatedAt, ID: page[0].ID},
+	})
+	require.NoError(t, err)
+	require.Len(t, next, 2)
+	assert.Equal(t, ids[1], next[0].ID)
+
+	failed, err := b.Orders.List(ctx, domain.OrderFilter{UserID: userID, Statuses: []domain.OrderStatus{domain.Failed}, Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, failed, 1)
+	assert.Equal(t, ids[1], failed[0].ID)
+}
+
+func testOrderListExpired(t *testing.T, b Backend) {
+	ctx := context.Background()
+	item := domain.Item{Sku: baseSKU + 1, Count: 1}
+	waiting := createOrder(t, b, 13, item)
+	other := createOrder(t, b, 13, item)
+	require.NoError(t, b.Orders.SetStatus(ctx, waiting, domain.New, domain.AwaitingPayment, "reserved"))
+
+	ids, err := b.Orders.ListExpired(ctx, domain.AwaitingPayment, time.Now().Add(time.Minute), nil, 100)
+	require.NoError(t, err)
+	assert.Contains(t, ids, waiting)
+	assert.NotContains(t, ids, other)
+
+	ids, err = b.Orders.ListExpired(ctx, domain.AwaitingPayment, time.Now().Add(time.Minute), []int64{waiting}, 100)
+	require.NoError(t, err)
+	assert.NotContains(t, ids, waiting, "excluded order must be skipped")
+
+	ids, err = b.Orders.ListExpired(ctx, domain.AwaitingPayment, time.Now().Add(-time.Hour), nil, 100)
+	require.NoError(t, err)
+	assert.NotContains(t, ids, waiting, "recently updated order is not expired")
+}
+
+func testStockReservePayCancel(t *testing.T, b Backend) {
+	ctx := context.Background()
+	paid, cancelled := stockKey(1), stockKey(2)
+	createStock(t, b, paid, 10)
+	createStock(t, b, cancelled, 10)
+
+	require.NoError(t, b.Stocks.Reserve(ctx, paid, 4))
+	require.NoError(t, b.Stocks.Reserve(ctx, cancelled, 6))
+	requireStock(t, b, paid, domain.StocksItem{TotalCount: 10, Reserved: 4})
+
+	require.NoError(t, b.Stocks.ReserveRemove(ctx, map[domain.StockKey]uint32{paid: 3}))
+	requireStock(t, b, paid, domain.StocksItem{TotalCount: 7, Reserved: 1})
+
+	require.NoError(t, b.Stocks.ReserveCancel(ctx, map[domain.StockKey]uint32{cancelled: 6, paid: 1}))
+	requireStock(t, b, cancelled, domain.StocksItem{TotalCount: 10, Reserved: 0})
+	requireStock(t, b, paid, domain.StocksItem{TotalCount: 7, Reserved: 0})
+
+	stocks, err := b.Stocks.GetWarehouseStocks(ctx, paid.Sku)
+	require.NoError(t, err)
+	require.Len(t, stocks, 1)
+	assert.EqualValues(t, domain.DefaultWarehouseID, stocks[0].WarehouseID)
+	assert.Equal(t, domain.StocksItem{TotalCount: 7, Reserved: 0}, stocks[0].StocksItem)
+}
+
+func testStockInsufficient(t *testing.T, b Backend) {
+	ctx := context.Background()
+	key, other := stockKey(1), stockKey(2)
+	createStock(t, b, key, 5)
+	createStock(t, b, other, 5)
+	require.NoError(t, b.Stocks.Reserve(ctx, key, 3))
+	require.NoError(t, b.Stocks.Reserve(ctx, other, 1))
+
+	err := b.Stocks.Reserve(ctx, key, 3)
+	assert.ErrorIs(t, err, localErr.ItemNotEnoughErr)
+
+	err = b.Stocks.ReserveRemove(ctx, map[domain.StockKey]uint32{key: 1, other: 2})
+	assert.ErrorIs(t, err, localErr.ReservedNotEnoughErr)
+	err = b.Stocks.ReserveCancel(ctx, map[domain.StockKey]uint32{key: 1, other: 2})
+	assert.ErrorIs(t, err, localErr.ReservedNotEnoughErr)
+
+	// Ошибка по одному стоку не должна менять остальные
+	requireStock(t, b, key, domain.StocksItem{TotalCount: 5, Reserved: 3})
+	requireStock(t, b, other, domain.StocksItem{TotalCount: 5, Reserved: 1})
+}
+
+func testStockNotFound(t *testing.T, b Backend) {
+	ctx := context.Background()
+	existing, missing := stockKey(1), stockKey(2)
+	createStock(t, b, existing, 5)
+	require.NoError(t, b.Stocks.Reserve(ctx, existing, 1))
+
+	assert.ErrorIs(t, b.Stocks.Reserve(ctx, missing, 1), localErr.SKUNotExistErr)
+	assert.ErrorIs(t, b.Stocks.ReserveRemove(ctx, map[domain.StockKey]uint32{existing: 1, missing: 1}), localErr.SKUNotExistErr)
+	assert.ErrorIs(t, b.Stocks.ReserveCancel(ctx, map[domain.StockKey]uint32{existing: 1, missing: 1}), localErr.SKUNotExistErr)
+	_, err := b.Stocks.Replenish(ctx, missing, 1)
+	assert.ErrorIs(t, err, localErr.SKUNotExistErr)
+	_, err = b.Stocks.Adjust(ctx, missing, 1, "inventory")
+	assert.ErrorIs(t, err, localErr.SKUNotExistErr)
+	assert.ErrorIs(t, b.Stocks.Delete(ctx, missing), localErr.SKUNotExistErr)
+
+	requireStock(t, b, existing, domain.StocksItem{TotalCount: 5, Reserved: 1})
+
+	found, err := b.Stocks.GetBySKUs(ctx, []uint32{existing.Sku, missing.Sku})
+	require.NoError(t, err)
+	assert.NotContains(t, found, missing.Sku)
+	stocks, err := b.Stocks.GetWarehouseStocks(ctx, missing.Sku)
+	require.NoError(t, err)
+	assert.Empty(t, stocks)
+}
+
+func testStockGetBySKUs(t *testing.T, b Backend) {
+	ctx := context.Background()
+	first, second, missing := stockKey(1), stockKey(2), stockKey(3)
+	createStock(t, b, first, 10)
+	createStock(t, b, second, 20)
+	require.NoError(t, b.Stocks.Reserve(ctx, first, 3))
+
+	// Повтор sku не должен суммировать его стоки дважды
+	got, err := b.Stocks.GetBySKUs(ctx, []uint32{first.Sku, missing.Sku, first.Sku, second.Sku, missing.Sku})
+	require.NoError(t, err)
+	assert.Equal(t, map[uint32]domain.StocksItem{
+		first.Sku:  {TotalCount: 10, Reserved: 3},
+		second.Sku: {TotalCount: 20},
+	}, got)
+}
+
+func testStockAdmin(t *testing.T, b Backend) {
+	ctx := context.Background()
+	key := stockKey(1)
+	createStock(t, b, key, 5)
+	assert.ErrorIs(t, b.Stocks.Create(ctx, key, 1), localErr.StockAlreadyExistErr)
+
+	unknownWarehouse := domain.StockKey{WarehouseID: 1_000_000, Sku: key.Sku}
+	assert.ErrorIs(t, b.Stocks.Create(ctx, unknownWarehouse, 1), localErr.WarehouseNotExistErr)
+	stocks, err := b.Stocks.GetWarehouseStocks(ctx, key.Sku)
+	require.NoError(t, err)
+	assert.Len(t, stocks, 1)
+
+	got, err := b.Stocks.Replenish(ctx, key, 5)
+	require.NoError(t, err)
+	assert.Equal(t, domain.StocksItem{TotalCount: 10}, got)
+	_, err = b.Stocks.Replenish(ctx, key, domain.MaxStockCount)
+	assert.ErrorIs(t, err, localErr.StockOverflowErr)
+
+	require.NoError(t, b.Stocks.Reserve(ctx, key, 4))
+	got, err = b.Stocks.Adjust(ctx, key, -6, "damaged")
+	require.NoError(t, err)
+	assert.Equal(t, domain.StocksItem{TotalCount: 4, Reserved: 4}, got)
+	_, err = b.Stocks.Adjust(ctx, key, -1, "damaged")
+	assert.ErrorIs(t, err, localErr.StockBelowReservedErr)
+
+	assert.ErrorIs(t, b.Stocks.Delete(ctx, key), localErr.StockReservedErr)
+	require.NoError(t, b.Stocks.ReserveCancel(ctx, map[domain.StockKey]uint32{key: 4}))
+	require.NoError(t, b.Stocks.Delete(ctx, key))
+	assert.ErrorIs(t, b.Stocks.Reserve(ctx, key, 1), localErr.SKUNotExistErr)
+}
+
+func testStockConcurrentReserve(t *testing.T, b Backend) {
+	ctx := context.Background()
+	key := stockKey(1)
+	createStock(t, b, key, 50)
+
+	const workers = 40
+	var reserved atomic.Int32
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			err := b.Stocks.Reserve(ctx, key, 3)
+			if err == nil {
+				reserved.Add(1)
+				return
+			}
+			assert.ErrorIs(t, err, localErr.ItemNotEnoughErr)
+		}()
+	}
+	wg.Wait()
+
+	assert.EqualValues(t, 16, reserved.Load())
+	requireStock(t, b, key, domain.StocksItem{TotalCount: 50, Reserved: 48})
+
+	// Параллельные оплаты и отмены должны сойтись в тот же итог, что и последовательные
+	for i := 0; i < 16; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			items := map[domain.StockKey]uint32{key: 3}
+			if i%2 == 0 {
+				assert.NoError(t, b.Stocks.ReserveRemove(ctx, items))
+			} else {
+				assert.NoError(t, b.Stocks.ReserveCancel(ctx, items))
+			}
+		}()
+	}
+	wg.Wait()
+
+	requireStock(t, b, key, domain.StocksItem{TotalCount: 50 - 8*3, Reserved: 0})
+}
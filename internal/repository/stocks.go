@@ -2,109 +2,310 @@ package repository
 
 import (
 	"context"
+	_ "embed"
 	"encoding/json"
+	"fmt"
 	"github.com/vestamart/loms/internal/domain"
 	"github.com/vestamart/loms/internal/localErr"
 	"os"
+	"sort"
+	"sync"
 )
 
 // Error
 type SKUID = uint32
 
-type StocksRepository = map[SKUID]domain.StocksItem
+// DefaultWarehouseID склад, на который попадают стоки без явно указанного склада
+const DefaultWarehouseID = domain.DefaultWarehouseID
 
+type StocksRepository = map[domain.StockKey]domain.StocksItem
+
+// stockData начальные стоки in-memory репозитория
+//
+//go:embed stock-data.json
+var stockData []byte
+
+// InMemoryStocksRepository хранит стоки по складам. Приоритет склада равен его номеру.
+// Склады - DefaultWarehouseID и склады из начальных стоков, другие склады не заводятся.
+// Методы безопасны для конкурентного вызова, операции над несколькими стоками атомарны.
 type InMemoryStocksRepository struct {
+	mu               sync.RWMutex
 	stocksRepository StocksRepository
+	// warehouses индекс складов, на которых заведен sku, чтобы чтение по sku не перебирало все стоки
+	warehouses map[SKUID]map[uint32]struct{}
+	// warehouseIDs известные склады
+	warehouseIDs map[uint32]struct{}
 }
 
-// Создание репозитория и считываение данных в json файла
-func NewInMemoryStocksRepositoryFromFile() (*InMemoryStocksRepository, error) {
-
-	file, err := os.Open("stock-data.json")
-	if err != nil {
-		return nil, err
+// NewInMemoryStocksRepository создает репозиторий со стоками из json файла seedPath,
+// а если путь пустой - из встроенного stock-data.json
+func NewInMemoryStocksRepository(seedPath string) (*InMemoryStocksRepository, error) {
+	data := stockData
+	if seedPath != "" {
+		var err error
+		if data, err = os.ReadFile(seedPath); err != nil {
+			return nil, fmt.Errorf("read stocks seed: %w", err)
+		}
 	}
-	defer file.Close()
 
 	var jsonStocks []struct {
-		SKU        uint32 `json:"sku"`
-		TotalCount uint32 `json:"total_count"`
-		Reserved   uint32 `json:"reserved"`
+		SKU         uint32 `json:"sku"`
+		WarehouseID uint32 `json:"warehouse_id"`
+		TotalCount  uint32 `json:"total_count"`
+		Reserved    uint32 `json:"reserved"`
 	}
 
-	if err = json.NewDecoder(file).Decode(&jsonStocks); err != nil {
-		return nil, err
+	if err := json.Unmarshal(data, &jsonStocks); err != nil {
+		return nil, fmt.Errorf("parse stocks seed: %w", err)
 	}
 
 	repo := &InMemoryStocksRepository{
 		stocksRepository: make(StocksRepository, len(jsonStocks)),
+		warehouses:       make(map[SKUID]map[uint32]struct{}, len(jsonStocks)),
+		warehouseIDs:     map[uint32]struct{}{DefaultWarehouseID: {}},
 	}
 
 	for _, item := range jsonStocks {
-		repo.stocksRepository[item.SKU] = domain.StocksItem{
+		if item.WarehouseID == 0 {
+			item.WarehouseID = DefaultWarehouseID
+		}
+		stock := domain.StocksItem{
 			TotalCount: item.TotalCount,
 			Reserved:   item.Reserved,
 		}
+		if err := stock.Validate(); err != nil {
+			return nil, fmt.Errorf("sku %d warehouse %d: %w", item.SKU, item.WarehouseID, err)
+		}
+		repo.put(domain.StockKey{WarehouseID: item.WarehouseID, Sku: item.SKU}, stock)
+		repo.warehouseIDs[item.WarehouseID] = struct{}{}
 	}
 
 	return repo, nil
 }
 
-func (r *InMemoryStocksRepository) Reserve(_ context.Context, sku SKUID, count uint32) error {
-	v, ok := r.stocksRepository[sku]
+// put добавляет новый сток и обновляет индекс. Вызывается под r.mu
+func (r *InMemoryStocksRepository) put(key domain.StockKey, stock domain.StocksItem) {
+	r.stocksRepository[key] = stock
+	r.index(key)
+}
+
+func (r *InMemoryStocksRepository) index(key domain.StockKey) {
+	if r.warehouses[key.Sku] == nil {
+		r.warehouses[key.Sku] = make(map[uint32]struct{}, 1)
+	}
+	r.warehouses[key.Sku][key.WarehouseID] = struct{}{}
+}
+
+// remove удаляет сток и его запись в индексе. Вызывается под r.mu
+func (r *InMemoryStocksRepository) remove(key domain.StockKey) {
+	delete(r.stocksRepository, key)
+	delete(r.warehouses[key.Sku], key.WarehouseID)
+	if len(r.warehouses[key.Sku]) == 0 {
+		delete(r.warehouses, key.Sku)
+	}
+}
+
+// saveUndo запоминает сток, чтобы откатить его вместе с транзакцией ctx. Вызывается под r.mu
+func (r *InMemoryStocksRepository) saveUndo(ctx context.Context, key domain.StockKey) {
+	prev, existed := r.stocksRepository[key]
+
+	onRollback(ctx, func() {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+
+		if existed {
+			r.put(key, prev)
+		} else {
+			r.remove(key)
+		}
+	})
+}
+
+func (r *InMemoryStocksRepository) Reserve(ctx context.Context, key domain.StockKey, count uint32) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	v, ok := r.stocksRepository[key]
 	if !ok {
 		return localErr.SKUNotExistErr
 	}
 
-	v.Reserved += count
-	if v.Reserved > v.TotalCount {
-		return localErr.ItemNotEnoughErr
+	v, err := v.Reserve(count)
+	if err != nil {
+		return err
 	}
 
-	r.stocksRepository[sku] = v
+	r.saveUndo(ctx, key)
+	r.stocksRepository[key] = v
 	return nil
 }
 
-func (r *InMemoryStocksRepository) ReserveRemove(_ context.Context, sku SKUID, count uint32) error {
-	v, ok := r.stocksRepository[sku]
-	if !ok {
-		return localErr.SKUNotExistErr
+// ReserveRemove списывает резервы при оплате. При ошибке по любому стоку не меняется ни один
+func (r *InMemoryStocksRepository) ReserveRemove(ctx context.Context, items map[domain.StockKey]uint32) error {
+	return r.applyAll(ctx, items, domain.StocksItem.ReserveRemove)
+}
+
+// ReserveCancel возвращает резервы в свободный остаток. При ошибке по любому стоку не меняется ни один
+func (r *InMemoryStocksRepository) ReserveCancel(ctx context.Context, items map[domain.StockKey]uint32) error {
+	return r.applyAll(ctx, items, domain.StocksItem.ReserveCancel)
+}
+
+func (r *InMemoryStocksRepository) applyAll(ctx context.Context, items map[domain.StockKey]uint32, apply func(domain.StocksItem, uint32) (domain.StocksItem, error)) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	updated := make(StocksRepository, len(items))
+	for k, count := range items {
+		v, ok := r.stocksRepository[k]
+		if !ok {
+			return localErr.SKUNotExistErr
+		}
+
+		v, err := apply(v, count)
+		if err != nil {
+			return fmt.Errorf("sku %d warehouse %d: %w", k.Sku, k.WarehouseID, err)
+		}
+		updated[k] = v
 	}
 
-	v.TotalCount -= count
-	v.Reserved -= count
-	r.stocksRepository[sku] = v
+	for k, v := range updated {
+		r.saveUndo(ctx, k)
+		r.stocksRepository[k] = v
+	}
 	return nil
 }
 
-func (r *InMemoryStocksRepository) ReserveCancel(_ context.Context, sku SKUID, count uint32) error {
-	v, ok := r.stocksRepository[sku]
-	if !ok {
-		return localErr.SKUNotExistErr
+func (r *InMemoryStocksRepository) Create(ctx context.Context, key domain.StockKey, totalCount uint32) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.warehouseIDs[key.WarehouseID]; !ok {
+		return localErr.WarehouseNotExistErr
 	}
-	v.Reserved -= count
-	r.stocksRepository[sku] = v
+	if _, ok := r.stocksRepository[key]; ok {
+		return localErr.StockAlreadyExistErr
+	}
+
+	v, err := domain.StocksItem{}.Replenish(totalCount)
+	if err != nil {
+		return err
+	}
+
+	r.saveUndo(ctx, key)
+	r.put(key, v)
 	return nil
 }
 
-func (r *InMemoryStocksRepository) GetBySKU(_ context.Context, sku SKUID) (uint32, uint32, error) {
-	v, ok := r.stocksRepository[sku]
+func (r *InMemoryStocksRepository) Replenish(ctx context.Context, key domain.StockKey, count uint32) (domain.StocksItem, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	v, ok := r.stocksRepository[key]
 	if !ok {
-		return 0, 0, localErr.SKUNotExistErr
+		return domain.StocksItem{}, localErr.SKUNotExistErr
+	}
+
+	v, err := v.Replenish(count)
+	if err != nil {
+		return domain.StocksItem{}, err
 	}
 
-	return v.TotalCount, v.Reserved, nil
+	r.saveUndo(ctx, key)
+	r.stocksRepository[key] = v
+	return v, nil
 }
 
-func (r *InMemoryStocksRepository) RollbackReserve(_ context.Context, skus map[SKUID]uint32) error {
-	if skus == nil {
-		return nil
+// Adjust меняет total_count на delta, не опуская его ниже reserved. reason в памяти не хранится
+func (r *InMemoryStocksRepository) Adjust(ctx context.Context, key domain.StockKey, delta int32, _ string) (domain.StocksItem, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	v, ok := r.stocksRepository[key]
+	if !ok {
+		return domain.StocksItem{}, localErr.SKUNotExistErr
 	}
-	for sku, count := range skus {
-		stock := r.stocksRepository[sku]
 
-		stock.Reserved -= count
-		r.stocksRepository[sku] = stock
+	v, err := v.Adjust(int64(delta))
+	if err != nil {
+		return domain.StocksItem{}, err
 	}
+
+	r.saveUndo(ctx, key)
+	r.stocksRepository[key] = v
+	return v, nil
+}
+
+func (r *InMemoryStocksRepository) Delete(ctx context.Context, key domain.StockKey) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	v, ok := r.stocksRepository[key]
+	if !ok {
+		return localErr.SKUNotExistErr
+	}
+	if v.Reserved > 0 {
+		return localErr.StockReservedErr
+	}
+
+	r.saveUndo(ctx, key)
+	r.remove(key)
 	return nil
 }
+
+func (r *InMemoryStocksRepository) GetWarehouseStocks(_ context.Context, sku SKUID) ([]domain.WarehouseStock, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	stocks := make([]domain.WarehouseStock, 0, len(r.warehouses[sku]))
+	for warehouseID := range r.warehouses[sku] {
+		stocks = append(stocks, domain.WarehouseStock{
+			WarehouseID: warehouseID,
+			Priority:    int32(warehouseID),
+			StocksItem:  r.stocksRepository[domain.StockKey{WarehouseID: warehouseID, Sku: sku}],
+		})
+	}
+
+	sort.Slice(stocks, func(i, j int) bool {
+		return stocks[i].WarehouseID < stocks[j].WarehouseID
+	})
+	return stocks, nil
+}
+
+func (r *InMemoryStocksRepository) GetBySKUs(_ context.Context, skus []SKUID) (map[SKUID]domain.StocksItem, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	result := make(map[SKUID]domain.StocksItem, len(skus))
+	for _, sku := range skus {
+		warehouses, ok := r.warehouses[sku]
+		if _, seen := result[sku]; seen || !ok {
+			continue
+		}
+
+		var total domain.StocksItem
+		for warehouseID := range warehouses {
+			v := r.stocksRepository[domain.StockKey{WarehouseID: warehouseID, Sku: sku}]
+			total.TotalCount += v.TotalCount
+			total.Reserved += v.Reserved
+		}
+		result[sku] = total
+	}
+
+	return result, nil
+}
+
+// GetWarehouseTotals возвращает суммарные по всем sku стоки каждого склада
+func (r *InMemoryStocksRepository) GetWarehouseTotals(_ context.Context) (map[uint32]domain.StocksItem, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	result := make(map[uint32]domain.StocksItem, len(r.warehouseIDs))
+	for k, v := range r.stocksRepository {
+		total := result[k.WarehouseID]
+		total.TotalCount += v.TotalCount
+		total.Reserved += v.Reserved
+		result[k.WarehouseID] = total
+	}
+
+	return result, nil
+}
@@ -0,0 +1,152 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vestamart/loms/internal/app/loms"
+	"github.com/vestamart/loms/internal/domain"
+	"github.com/vestamart/loms/internal/localErr"
+	"github.com/vestamart/loms/internal/repository"
+	desc "github.com/vestamart/loms/pkg/api/loms/v1"
+)
+
+var _ loms.StocksStorage = (*repository.InMemoryStocksRepository)(nil)
+var _ loms.OrdersRepository = (*repository.InMemoryOrderRepository)(nil)
+
+func TestInMemoryStocksSeed(t *testing.T) {
+	stocks, err := repository.NewInMemoryStocksRepository("")
+	require.NoError(t, err)
+
+	got, err := stocks.GetBySKUs(context.Background(), []uint32{1002})
+	require.NoError(t, err)
+	assert.Equal(t, domain.StocksItem{TotalCount: 200, Reserved: 20}, got[1002])
+
+	path := filepath.Join(t.TempDir(), "stocks.json")
+	require.NoError(t, os.WriteFile(path, []byte(`[{"sku": 7, "warehouse_id": 2, "total_count": 5, "reserved": 1}]`), 0o600))
+	stocks, err = repository.NewInMemoryStocksRepository(path)
+	require.NoError(t, err)
+
+	warehouses, err := stocks.GetWarehouseStocks(context.Background(), 7)
+	require.NoError(t, err)
+	require.Len(t, warehouses, 1)
+	assert.EqualValues(t, 2, warehouses[0].WarehouseID)
+
+	// Склады заводятся начальными стоками
+	require.NoError(t, stocks.Create(context.Background(), domain.StockKey{WarehouseID: 2, Sku: 8}, 1))
+	err = stocks.Create(context.Background(), domain.StockKey{WarehouseID: 3, Sku: 8}, 1)
+	assert.ErrorIs(t, err, localErr.WarehouseNotExistErr)
+
+	require.NoError(t, os.WriteFile(path, []byte(`[{"sku": 7, "total_count": 1, "reserved": 2}]`), 0o600))
+	_, err = repository.NewInMemoryStocksRepository(path)
+	assert.ErrorIs(t, err, localErr.StockBelowReservedErr)
+}
+
+func TestInMemoryStocksGetBySKUs(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "stocks.json")
+	require.NoError(t, os.WriteFile(path, []byte(`[
+		{"sku": 7, "warehouse_id": 1, "total_count": 5, "reserved": 1},
+		{"sku": 7, "warehouse_id": 2, "total_count": 10, "reserved": 2},
+		{"sku": 8, "warehouse_id": 2, "total_count": 3}
+	]`), 0o600))
+	stocks, err := repository.NewInMemoryStocksRepository(path)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	got, err := stocks.GetBySKUs(ctx, []uint32{7, 7, 9, 8})
+	require.NoError(t, err)
+	assert.Equal(t, map[uint32]domain.StocksItem{7: {TotalCount: 15, Reserved: 3}, 8: {TotalCount: 3}}, got)
+
+	// Индекс складов должен следовать за удалением стока и откатом транзакции
+	errRollback := errors.New("rollback")
+	err = repository.NewInMemoryTxManager().WithinTransaction(ctx, func(ctx context.Context) error {
+		require.NoError(t, stocks.Delete(ctx, domain.StockKey{WarehouseID: 2, Sku: 8}))
+		got, err := stocks.GetBySKUs(ctx, []uint32{8})
+		require.NoError(t, err)
+		assert.Empty(t, got)
+		return errRollback
+	})
+	require.ErrorIs(t, err, errRollback)
+
+	warehouses, err := stocks.GetWarehouseStocks(ctx, 8)
+	require.NoError(t, err)
+	require.Len(t, warehouses, 1)
+	assert.EqualValues(t, 2, warehouses[0].WarehouseID)
+}
+
+func TestInMemoryStocksAllOrNothing(t *testing.T) {
+	stocks, err := repository.NewInMemoryStocksRepository("")
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	ok := domain.StockKey{WarehouseID: domain.DefaultWarehouseID, Sku: 1002}
+	missing := domain.StockKey{WarehouseID: domain.DefaultWarehouseID, Sku: 1}
+	err = stocks.ReserveCancel(ctx, map[domain.StockKey]uint32{ok: 5, missing: 1})
+	assert.ErrorIs(t, err, localErr.SKUNotExistErr)
+
+	tooMuch := domain.StockKey{WarehouseID: domain.DefaultWarehouseID, Sku: 1003}
+	err = stocks.ReserveRemove(ctx, map[domain.StockKey]uint32{ok: 5, tooMuch: 31})
+	assert.ErrorIs(t, err, localErr.ReservedNotEnoughErr)
+
+	got, err := stocks.GetBySKUs(ctx, []uint32{1002, 1003})
+	require.NoError(t, err)
+	assert.Equal(t, domain.StocksItem{TotalCount: 200, Reserved: 20}, got[1002])
+	assert.Equal(t, domain.StocksItem{TotalCount: 250, Reserved: 30}, got[1003])
+}
+
+func TestInMemoryConcurrentOrders(t *testing.T) {
+	stocks, err := repository.NewInMemoryStocksRepository("")
+	require.NoError(t, err)
+	outbox := repository.NewInMemoryOutboxRepository()
+	orders := repository.NewInMemoryOrderRepository(100, outbox)
+	svc := loms.NewService(orders, stocks, repository.NewInMemoryTxManager(), loms.NearestFirst)
+	ctx := context.Background()
+
+	// Свободно 180 единиц 1002: заказы по 10 единиц, половина оплачивается, половина отменяется
+	const workers = 30
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	created := 0
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := svc.OrderCreate(ctx, &desc.OrderCreateRequest{User: 1, Items: []*desc.Item{{Sku: 1002, Count: 10}, {Sku: 1003, Count: 1}}})
+			if err != nil {
+				assert.ErrorIs(t, err, localErr.ItemNotEnoughErr)
+				return
+			}
+			mu.Lock()
+			created++
+			mu.Unlock()
+
+			_, _ = svc.StocksInfo(ctx, &desc.StocksInfoRequest{Sku: 1002})
+			if resp.OrderId%2 == 0 {
+				_, err = svc.OrderPay(ctx, &desc.OrderPayRequest{OrderID: resp.OrderId})
+			} else {
+				_, err = svc.OrderCancel(ctx, &desc.OrderCancelRequest{OrderID: resp.OrderId})
+			}
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	got, err := stocks.GetBySKUs(ctx, []uint32{1002})
+	require.NoError(t, err)
+	assert.EqualValues(t, 20, got[1002].Reserved, "all order reservations must be released")
+	assert.EqualValues(t, 200, got[1002].TotalCount+uint32(10*paidOrders(t, svc, created)))
+}
+
+func paidOrders(t *testing.T, svc *loms.Service, created int) int {
+	t.Helper()
+
+	resp, err := svc.ListOrders(context.Background(), &desc.ListOrdersRequest{User: 1, Statuses: []desc.OrderStatus{desc.OrderStatus_PAYED}, PageSize: uint32(created) + 1})
+	require.NoError(t, err)
+	return len(resp.Orders)
+}
@@ -0,0 +1,73 @@
+package repository
+
+import (
+	"context"
+	"sync"
+)
+
+type inMemoryTxKey struct{}
+
+// inMemoryTx журнал отмены транзакции: репозитории записывают в него, как вернуть каждое свое изменение
+type inMemoryTx struct {
+	mu   sync.Mutex
+	undo []func()
+}
+
+// onRollback регистрирует отмену изменения, сделанного в транзакции ctx.
+// Вне транзакции изменение сразу окончательное, и отмена не нужна.
+func onRollback(ctx context.Context, undo func()) {
+	tx, ok := ctx.Value(inMemoryTxKey{}).(*inMemoryTx)
+	if !ok {
+		return
+	}
+
+	tx.mu.Lock()
+	defer tx.mu.Unlock()
+	tx.undo = append(tx.undo, undo)
+}
+
+// rollback отменяет изменения, записанные после отметки savepoint, в обратном порядке
+func (tx *inMemoryTx) rollback(savepoint int) {
+	tx.mu.Lock()
+	undo := tx.undo[savepoint:]
+	tx.undo = tx.undo[:savepoint]
+	tx.mu.Unlock()
+
+	for i := len(undo) - 1; i >= 0; i-- {
+		undo[i]()
+	}
+}
+
+// InMemoryTxManager реализует unit of work для in-memory репозиториев:
+// транзакции выполняются последовательно, при ошибке изменения транзакции отменяются.
+// Откатываются только затронутые транзакцией ключи, изменения вне транзакций не теряются.
+type InMemoryTxManager struct {
+	mu sync.Mutex
+}
+
+func NewInMemoryTxManager() *InMemoryTxManager {
+	return &InMemoryTxManager{}
+}
+
+// WithinTransaction выполняет fn в транзакции. Вложенный вызов работает как savepoint:
+// при ошибке откатываются только изменения, сделанные внутри него.
+func (m *InMemoryTxManager) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	tx, ok := ctx.Value(inMemoryTxKey{}).(*inMemoryTx)
+	if !ok {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		tx = &inMemoryTx{}
+		ctx = context.WithValue(ctx, inMemoryTxKey{}, tx)
+	}
+
+	tx.mu.Lock()
+	savepoint := len(tx.undo)
+	tx.mu.Unlock()
+
+	if err := fn(ctx); err != nil {
+		tx.rollback(savepoint)
+		return err
+	}
+
+	return nil
+}
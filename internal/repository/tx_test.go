@@ -0,0 +1,84 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vestamart/loms/internal/domain"
+	"github.com/vestamart/loms/internal/repository"
+)
+
+func TestInMemoryTxManagerRollback(t *testing.T) {
+	stocks, err := repository.NewInMemoryStocksRepository("")
+	require.NoError(t, err)
+	outbox := repository.NewInMemoryOutboxRepository()
+	orders := repository.NewInMemoryOrderRepository(10, outbox)
+	txManager := repository.NewInMemoryTxManager()
+	ctx := context.Background()
+	first := domain.StockKey{WarehouseID: domain.DefaultWarehouseID, Sku: 1002}
+	second := domain.StockKey{WarehouseID: domain.DefaultWarehouseID, Sku: 1003}
+	errFail := errors.New("fail")
+
+	// Вложенная транзакция откатывает только свои изменения
+	err = txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		require.NoError(t, stocks.Reserve(ctx, first, 1))
+		err := txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+			require.NoError(t, stocks.Reserve(ctx, second, 1))
+			return errFail
+		})
+		assert.ErrorIs(t, err, errFail)
+		return nil
+	})
+	require.NoError(t, err)
+
+	got, err := stocks.GetBySKUs(ctx, []uint32{1002, 1003})
+	require.NoError(t, err)
+	assert.Equal(t, domain.StocksItem{TotalCount: 200, Reserved: 21}, got[1002])
+	assert.Equal(t, domain.StocksItem{TotalCount: 250, Reserved: 30}, got[1003])
+
+	// Ошибка внешней транзакции откатывает изменения всех репозиториев
+	err = txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		require.NoError(t, stocks.Reserve(ctx, second, 5))
+		_, err := orders.Create(ctx, 1, &[]domain.Item{{Sku: 1003, Count: 5}})
+		require.NoError(t, err)
+		return errFail
+	})
+	assert.ErrorIs(t, err, errFail)
+
+	got, err = stocks.GetBySKUs(ctx, []uint32{1003})
+	require.NoError(t, err)
+	assert.Equal(t, domain.StocksItem{TotalCount: 250, Reserved: 30}, got[1003])
+	list, err := orders.List(ctx, domain.OrderFilter{UserID: 1, Limit: 10})
+	require.NoError(t, err)
+	assert.Empty(t, list)
+	messages, err := outbox.FetchPending(ctx, 10, 0)
+	require.NoError(t, err)
+	assert.Empty(t, messages)
+}
+
+func TestInMemoryTxManagerRollbackKeepsOutsideWrites(t *testing.T) {
+	stocks, err := repository.NewInMemoryStocksRepository("")
+	require.NoError(t, err)
+	txManager := repository.NewInMemoryTxManager()
+	ctx := context.Background()
+	touched := domain.StockKey{WarehouseID: domain.DefaultWarehouseID, Sku: 1002}
+	outside := domain.StockKey{WarehouseID: domain.DefaultWarehouseID, Sku: 1003}
+	errFail := errors.New("fail")
+
+	err = txManager.WithinTransaction(ctx, func(txCtx context.Context) error {
+		require.NoError(t, stocks.Reserve(txCtx, touched, 1))
+		// Админская запись идет мимо менеджера транзакций и не должна потеряться при откате
+		_, err := stocks.Replenish(ctx, outside, 10)
+		require.NoError(t, err)
+		return errFail
+	})
+	assert.ErrorIs(t, err, errFail)
+
+	got, err := stocks.GetBySKUs(ctx, []uint32{1002, 1003})
+	require.NoError(t, err)
+	assert.Equal(t, domain.StocksItem{TotalCount: 200, Reserved: 20}, got[1002])
+	assert.Equal(t, domain.StocksItem{TotalCount: 260, Reserved: 30}, got[1003])
+}
@@ -0,0 +1,45 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE warehouses (
+    id SERIAL PRIMARY KEY,
+    name TEXT NOT NULL,
+    priority INTEGER NOT NULL DEFAULT 0
+);
+
+COMMENT ON COLUMN warehouses.priority IS 'Чем меньше, тем ближе склад к покупателю';
+
+INSERT INTO warehouses (id, name, priority)
+VALUES (1, 'main', 0);
+
+SELECT setval(pg_get_serial_sequence('warehouses', 'id'), 1);
+
+ALTER TABLE stocks RENAME COLUMN id TO sku;
+ALTER TABLE stocks ALTER COLUMN sku DROP DEFAULT;
+DROP SEQUENCE stocks_id_seq;
+ALTER TABLE stocks ADD COLUMN warehouse_id INTEGER NOT NULL DEFAULT 1 REFERENCES warehouses (id);
+ALTER TABLE stocks ALTER COLUMN warehouse_id DROP DEFAULT;
+ALTER TABLE stocks DROP CONSTRAINT stocks_pkey;
+ALTER TABLE stocks ADD PRIMARY KEY (sku, warehouse_id);
+
+ALTER TABLE items ADD COLUMN warehouse_id INTEGER REFERENCES warehouses (id);
+-- Резерв на единственном складе был только у заказов, которые дошли до AWAITING_PAYMENT (1) или PAYED (3)
+UPDATE items SET warehouse_id = 1
+FROM order_items oi
+JOIN orders o ON o.id = oi.order_id
+WHERE oi.item_id = items.id AND o.status IN (1, 3);
+
+COMMENT ON COLUMN items.warehouse_id IS 'Склад, с которого зарезервирован товар. NULL, если резерв не удался';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE items DROP COLUMN warehouse_id;
+
+DELETE FROM stocks WHERE warehouse_id <> 1;
+ALTER TABLE stocks DROP CONSTRAINT stocks_pkey;
+ALTER TABLE stocks DROP COLUMN warehouse_id;
+ALTER TABLE stocks RENAME COLUMN sku TO id;
+ALTER TABLE stocks ADD PRIMARY KEY (id);
+
+DROP TABLE warehouses;
+-- +goose StatementEnd
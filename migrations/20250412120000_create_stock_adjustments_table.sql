@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE stock_adjustments (
+    id BIGSERIAL PRIMARY KEY,
+    sku INTEGER NOT NULL,
+    warehouse_id INTEGER NOT NULL REFERENCES warehouses (id),
+    delta INTEGER NOT NULL,
+    reason TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+COMMENT ON TABLE stock_adjustments IS 'Ручные корректировки total_count через StockAdjust';
+
+CREATE INDEX stock_adjustments_sku_idx ON stock_adjustments (sku, warehouse_id, id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE stock_adjustments;
+-- +goose StatementEnd
@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE stocks
+    ADD CONSTRAINT stocks_reserved_check CHECK (reserved >= 0 AND reserved <= total_count);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE stocks DROP CONSTRAINT stocks_reserved_check;
+-- +goose StatementEnd
@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE idempotency_keys
+    ADD COLUMN locked_until TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP;
+
+COMMENT ON COLUMN idempotency_keys.locked_until IS 'До этого момента незавершенный ключ принадлежит выполняющемуся запросу';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE idempotency_keys DROP COLUMN locked_until;
+-- +goose StatementEnd
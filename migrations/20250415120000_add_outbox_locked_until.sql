@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE outbox
+    ADD COLUMN locked_until TIMESTAMP WITH TIME ZONE;
+
+COMMENT ON COLUMN outbox.locked_until IS 'До этого момента событие отправляет забравший его relay, другие реплики его пропускают';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE outbox DROP COLUMN locked_until;
+-- +goose StatementEnd
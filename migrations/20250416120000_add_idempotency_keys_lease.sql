@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE idempotency_keys
+    ADD COLUMN lease TEXT NOT NULL DEFAULT '';
+
+COMMENT ON COLUMN idempotency_keys.lease IS 'Токен владельца ключа: завершить или освободить ключ может только занявший его запрос';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE idempotency_keys DROP COLUMN lease;
+-- +goose StatementEnd
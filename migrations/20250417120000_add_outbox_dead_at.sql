@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE outbox
+    ADD COLUMN dead_at TIMESTAMP WITH TIME ZONE;
+
+COMMENT ON COLUMN outbox.dead_at IS 'Момент, когда relay исчерпал попытки отправки и перестал выбирать событие';
+
+DROP INDEX outbox_pending_idx;
+CREATE INDEX outbox_pending_idx ON outbox (id) WHERE sent_at IS NULL AND dead_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX outbox_pending_idx;
+CREATE INDEX outbox_pending_idx ON outbox (id) WHERE sent_at IS NULL;
+
+ALTER TABLE outbox DROP COLUMN dead_at;
+-- +goose StatementEnd
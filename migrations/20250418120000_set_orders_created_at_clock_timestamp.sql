@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+-- CURRENT_TIMESTAMP равен началу транзакции: заказ из долгой транзакции мог получить created_at
+-- раньше уже выданного курсора OrderList и не попасть на страницы
+ALTER TABLE orders
+    ALTER COLUMN created_at SET DEFAULT clock_timestamp();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE orders
+    ALTER COLUMN created_at SET DEFAULT CURRENT_TIMESTAMP;
+-- +goose StatementEnd
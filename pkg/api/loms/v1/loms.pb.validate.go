@@ -0,0 +1,3874 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: loms.proto
+
+package loms
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on Item with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *Item) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Item with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in ItemMultiError, or nil if none found.
+func (m *Item) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Item) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetSku() <= 0 {
+		err := ItemValidationError{
+			field:  "Sku",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetCount() <= 0 {
+		err := ItemValidationError{
+			field:  "Count",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ItemMultiError(errors)
+	}
+
+	return nil
+}
+
+// ItemMultiError is an error wrapping multiple validation errors returned by
+// Item.ValidateAll() if the designated constraints aren't met.
+type ItemMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ItemMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ItemMultiError) AllErrors() []error { return m }
+
+// ItemValidationError is the validation error returned by Item.Validate if the
+// designated constraints aren't met.
+type ItemValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ItemValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ItemValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ItemValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ItemValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ItemValidationError) ErrorName() string { return "ItemValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ItemValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sItem.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ItemValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ItemValidationError{}
+
+// Validate checks the field values on OrderCreateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *OrderCreateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OrderCreateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// OrderCreateRequestMultiError, or nil if none found.
+func (m *OrderCreateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OrderCreateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUser() <= 0 {
+		err := OrderCreateRequestValidationError{
+			field:  "User",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetItems()) < 1 {
+		err := OrderCreateRequestValidationError{
+			field:  "Items",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, OrderCreateRequestValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, OrderCreateRequestValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return OrderCreateRequestValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for IdempotencyKey
+
+	if len(errors) > 0 {
+		return OrderCreateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// OrderCreateRequestMultiError is an error wrapping multiple validation errors
+// returned by OrderCreateRequest.ValidateAll() if the designated constraints
+// aren't met.
+type OrderCreateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrderCreateRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrderCreateRequestMultiError) AllErrors() []error { return m }
+
+// OrderCreateRequestValidationError is the validation error returned by
+// OrderCreateRequest.Validate if the designated constraints aren't met.
+type OrderCreateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrderCreateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrderCreateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrderCreateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrderCreateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrderCreateRequestValidationError) ErrorName() string {
+	return "OrderCreateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e OrderCreateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrderCreateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrderCreateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrderCreateRequestValidationError{}
+
+// Validate checks the field values on OrderCreateResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *OrderCreateResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OrderCreateResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// OrderCreateResponseMultiError, or nil if none found.
+func (m *OrderCreateResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OrderCreateResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OrderId
+
+	if len(errors) > 0 {
+		return OrderCreateResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// OrderCreateResponseMultiError is an error wrapping multiple validation
+// errors returned by OrderCreateResponse.ValidateAll() if the designated
+// constraints aren't met.
+type OrderCreateResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrderCreateResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrderCreateResponseMultiError) AllErrors() []error { return m }
+
+// OrderCreateResponseValidationError is the validation error returned by
+// OrderCreateResponse.Validate if the designated constraints aren't met.
+type OrderCreateResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrderCreateResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrderCreateResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrderCreateResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrderCreateResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrderCreateResponseValidationError) ErrorName() string {
+	return "OrderCreateResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e OrderCreateResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrderCreateResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrderCreateResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrderCreateResponseValidationError{}
+
+// Validate checks the field values on OrderInfoRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *OrderInfoRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OrderInfoRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// OrderInfoRequestMultiError, or nil if none found.
+func (m *OrderInfoRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OrderInfoRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetOrderId() <= 0 {
+		err := OrderInfoRequestValidationError{
+			field:  "OrderId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return OrderInfoRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// OrderInfoRequestMultiError is an error wrapping multiple validation errors
+// returned by OrderInfoRequest.ValidateAll() if the designated constraints
+// aren't met.
+type OrderInfoRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrderInfoRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrderInfoRequestMultiError) AllErrors() []error { return m }
+
+// OrderInfoRequestValidationError is the validation error returned by
+// OrderInfoRequest.Validate if the designated constraints aren't met.
+type OrderInfoRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrderInfoRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrderInfoRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrderInfoRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrderInfoRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrderInfoRequestValidationError) ErrorName() string { return "OrderInfoRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OrderInfoRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrderInfoRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrderInfoRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrderInfoRequestValidationError{}
+
+// Validate checks the field values on OrderInfoResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *OrderInfoResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OrderInfoResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// OrderInfoResponseMultiError, or nil if none found.
+func (m *OrderInfoResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OrderInfoResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Status
+
+	// no validation rules for User
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, OrderInfoResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, OrderInfoResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return OrderInfoResponseValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetReservations() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, OrderInfoResponseValidationError{
+						field:  fmt.Sprintf("Reservations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, OrderInfoResponseValidationError{
+						field:  fmt.Sprintf("Reservations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return OrderInfoResponseValidationError{
+					field:  fmt.Sprintf("Reservations[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return OrderInfoResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// OrderInfoResponseMultiError is an error wrapping multiple validation errors
+// returned by OrderInfoResponse.ValidateAll() if the designated constraints
+// aren't met.
+type OrderInfoResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrderInfoResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrderInfoResponseMultiError) AllErrors() []error { return m }
+
+// OrderInfoResponseValidationError is the validation error returned by
+// OrderInfoResponse.Validate if the designated constraints aren't met.
+type OrderInfoResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrderInfoResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrderInfoResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrderInfoResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrderInfoResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrderInfoResponseValidationError) ErrorName() string {
+	return "OrderInfoResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e OrderInfoResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrderInfoResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrderInfoResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrderInfoResponseValidationError{}
+
+// Validate checks the field values on Reservation with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Reservation) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Reservation with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ReservationMultiError, or
+// nil if none found.
+func (m *Reservation) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Reservation) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Sku
+
+	// no validation rules for WarehouseId
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return ReservationMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReservationMultiError is an error wrapping multiple validation errors
+// returned by Reservation.ValidateAll() if the designated constraints aren't met.
+type ReservationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReservationMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReservationMultiError) AllErrors() []error { return m }
+
+// ReservationValidationError is the validation error returned by
+// Reservation.Validate if the designated constraints aren't met.
+type ReservationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReservationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReservationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReservationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReservationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReservationValidationError) ErrorName() string { return "ReservationValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReservationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReservation.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReservationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReservationValidationError{}
+
+// Validate checks the field values on OrderPayRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *OrderPayRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OrderPayRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// OrderPayRequestMultiError, or nil if none found.
+func (m *OrderPayRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OrderPayRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetOrderID() <= 0 {
+		err := OrderPayRequestValidationError{
+			field:  "OrderID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for IdempotencyKey
+
+	if len(errors) > 0 {
+		return OrderPayRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// OrderPayRequestMultiError is an error wrapping multiple validation errors
+// returned by OrderPayRequest.ValidateAll() if the designated constraints
+// aren't met.
+type OrderPayRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrderPayRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrderPayRequestMultiError) AllErrors() []error { return m }
+
+// OrderPayRequestValidationError is the validation error returned by
+// OrderPayRequest.Validate if the designated constraints aren't met.
+type OrderPayRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrderPayRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrderPayRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrderPayRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrderPayRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrderPayRequestValidationError) ErrorName() string { return "OrderPayRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OrderPayRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrderPayRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrderPayRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrderPayRequestValidationError{}
+
+// Validate checks the field values on OrderPayResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *OrderPayResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OrderPayResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// OrderPayResponseMultiError, or nil if none found.
+func (m *OrderPayResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OrderPayResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return OrderPayResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// OrderPayResponseMultiError is an error wrapping multiple validation errors
+// returned by OrderPayResponse.ValidateAll() if the designated constraints
+// aren't met.
+type OrderPayResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrderPayResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrderPayResponseMultiError) AllErrors() []error { return m }
+
+// OrderPayResponseValidationError is the validation error returned by
+// OrderPayResponse.Validate if the designated constraints aren't met.
+type OrderPayResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrderPayResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrderPayResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrderPayResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrderPayResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrderPayResponseValidationError) ErrorName() string { return "OrderPayResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OrderPayResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrderPayResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrderPayResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrderPayResponseValidationError{}
+
+// Validate checks the field values on OrderCancelRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *OrderCancelRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OrderCancelRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// OrderCancelRequestMultiError, or nil if none found.
+func (m *OrderCancelRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OrderCancelRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetOrderID() <= 0 {
+		err := OrderCancelRequestValidationError{
+			field:  "OrderID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for IdempotencyKey
+
+	if len(errors) > 0 {
+		return OrderCancelRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// OrderCancelRequestMultiError is an error wrapping multiple validation errors
+// returned by OrderCancelRequest.ValidateAll() if the designated constraints
+// aren't met.
+type OrderCancelRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrderCancelRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrderCancelRequestMultiError) AllErrors() []error { return m }
+
+// OrderCancelRequestValidationError is the validation error returned by
+// OrderCancelRequest.Validate if the designated constraints aren't met.
+type OrderCancelRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrderCancelRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrderCancelRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrderCancelRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrderCancelRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrderCancelRequestValidationError) ErrorName() string {
+	return "OrderCancelRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e OrderCancelRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrderCancelRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrderCancelRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrderCancelRequestValidationError{}
+
+// Validate checks the field values on OrderCancelResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *OrderCancelResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OrderCancelResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// OrderCancelResponseMultiError, or nil if none found.
+func (m *OrderCancelResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OrderCancelResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return OrderCancelResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// OrderCancelResponseMultiError is an error wrapping multiple validation
+// errors returned by OrderCancelResponse.ValidateAll() if the designated
+// constraints aren't met.
+type OrderCancelResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrderCancelResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrderCancelResponseMultiError) AllErrors() []error { return m }
+
+// OrderCancelResponseValidationError is the validation error returned by
+// OrderCancelResponse.Validate if the designated constraints aren't met.
+type OrderCancelResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrderCancelResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrderCancelResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrderCancelResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrderCancelResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrderCancelResponseValidationError) ErrorName() string {
+	return "OrderCancelResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e OrderCancelResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrderCancelResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrderCancelResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrderCancelResponseValidationError{}
+
+// Validate checks the field values on StocksInfoRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *StocksInfoRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StocksInfoRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StocksInfoRequestMultiError, or nil if none found.
+func (m *StocksInfoRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StocksInfoRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetSku() <= 0 {
+		err := StocksInfoRequestValidationError{
+			field:  "Sku",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for WarehouseId
+
+	if len(errors) > 0 {
+		return StocksInfoRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// StocksInfoRequestMultiError is an error wrapping multiple validation errors
+// returned by StocksInfoRequest.ValidateAll() if the designated constraints
+// aren't met.
+type StocksInfoRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StocksInfoRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StocksInfoRequestMultiError) AllErrors() []error { return m }
+
+// StocksInfoRequestValidationError is the validation error returned by
+// StocksInfoRequest.Validate if the designated constraints aren't met.
+type StocksInfoRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StocksInfoRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StocksInfoRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StocksInfoRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StocksInfoRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StocksInfoRequestValidationError) ErrorName() string {
+	return "StocksInfoRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StocksInfoRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStocksInfoRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StocksInfoRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StocksInfoRequestValidationError{}
+
+// Validate checks the field values on StocksInfoResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StocksInfoResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StocksInfoResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StocksInfoResponseMultiError, or nil if none found.
+func (m *StocksInfoResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StocksInfoResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Count
+
+	for idx, item := range m.GetWarehouses() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, StocksInfoResponseValidationError{
+						field:  fmt.Sprintf("Warehouses[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, StocksInfoResponseValidationError{
+						field:  fmt.Sprintf("Warehouses[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StocksInfoResponseValidationError{
+					field:  fmt.Sprintf("Warehouses[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return StocksInfoResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// StocksInfoResponseMultiError is an error wrapping multiple validation errors
+// returned by StocksInfoResponse.ValidateAll() if the designated constraints
+// aren't met.
+type StocksInfoResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StocksInfoResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StocksInfoResponseMultiError) AllErrors() []error { return m }
+
+// StocksInfoResponseValidationError is the validation error returned by
+// StocksInfoResponse.Validate if the designated constraints aren't met.
+type StocksInfoResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StocksInfoResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StocksInfoResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StocksInfoResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StocksInfoResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StocksInfoResponseValidationError) ErrorName() string {
+	return "StocksInfoResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StocksInfoResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStocksInfoResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StocksInfoResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StocksInfoResponseValidationError{}
+
+// Validate checks the field values on WarehouseStock with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *WarehouseStock) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WarehouseStock with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in WarehouseStockMultiError,
+// or nil if none found.
+func (m *WarehouseStock) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WarehouseStock) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for WarehouseId
+
+	// no validation rules for Count
+
+	// no validation rules for TotalCount
+
+	// no validation rules for Reserved
+
+	if len(errors) > 0 {
+		return WarehouseStockMultiError(errors)
+	}
+
+	return nil
+}
+
+// WarehouseStockMultiError is an error wrapping multiple validation errors
+// returned by WarehouseStock.ValidateAll() if the designated constraints
+// aren't met.
+type WarehouseStockMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WarehouseStockMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WarehouseStockMultiError) AllErrors() []error { return m }
+
+// WarehouseStockValidationError is the validation error returned by
+// WarehouseStock.Validate if the designated constraints aren't met.
+type WarehouseStockValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WarehouseStockValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WarehouseStockValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WarehouseStockValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WarehouseStockValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WarehouseStockValidationError) ErrorName() string { return "WarehouseStockValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WarehouseStockValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWarehouseStock.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WarehouseStockValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WarehouseStockValidationError{}
+
+// Validate checks the field values on ListOrdersRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListOrdersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListOrdersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListOrdersRequestMultiError, or nil if none found.
+func (m *ListOrdersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListOrdersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUser() <= 0 {
+		err := ListOrdersRequestValidationError{
+			field:  "User",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedFrom()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListOrdersRequestValidationError{
+					field:  "CreatedFrom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListOrdersRequestValidationError{
+					field:  "CreatedFrom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedFrom()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListOrdersRequestValidationError{
+				field:  "CreatedFrom",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedTo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListOrdersRequestValidationError{
+					field:  "CreatedTo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListOrdersRequestValidationError{
+					field:  "CreatedTo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedTo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListOrdersRequestValidationError{
+				field:  "CreatedTo",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for PageSize
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return ListOrdersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListOrdersRequestMultiError is an error wrapping multiple validation errors
+// returned by ListOrdersRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListOrdersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListOrdersRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListOrdersRequestMultiError) AllErrors() []error { return m }
+
+// ListOrdersRequestValidationError is the validation error returned by
+// ListOrdersRequest.Validate if the designated constraints aren't met.
+type ListOrdersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListOrdersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListOrdersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListOrdersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListOrdersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListOrdersRequestValidationError) ErrorName() string {
+	return "ListOrdersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListOrdersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListOrdersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListOrdersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListOrdersRequestValidationError{}
+
+// Validate checks the field values on Order with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Order) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Order with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in OrderMultiError, or nil if none found.
+func (m *Order) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Order) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OrderId
+
+	// no validation rules for Status
+
+	// no validation rules for User
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, OrderValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, OrderValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return OrderValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrderValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrderValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return OrderMultiError(errors)
+	}
+
+	return nil
+}
+
+// OrderMultiError is an error wrapping multiple validation errors returned by
+// Order.ValidateAll() if the designated constraints aren't met.
+type OrderMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrderMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrderMultiError) AllErrors() []error { return m }
+
+// OrderValidationError is the validation error returned by Order.Validate if
+// the designated constraints aren't met.
+type OrderValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrderValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrderValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrderValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrderValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrderValidationError) ErrorName() string { return "OrderValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OrderValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrder.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrderValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrderValidationError{}
+
+// Validate checks the field values on ListOrdersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListOrdersResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListOrdersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListOrdersResponseMultiError, or nil if none found.
+func (m *ListOrdersResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListOrdersResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetOrders() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListOrdersResponseValidationError{
+						field:  fmt.Sprintf("Orders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListOrdersResponseValidationError{
+						field:  fmt.Sprintf("Orders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListOrdersResponseValidationError{
+					field:  fmt.Sprintf("Orders[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListOrdersResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListOrdersResponseMultiError is an error wrapping multiple validation errors
+// returned by ListOrdersResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListOrdersResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListOrdersResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListOrdersResponseMultiError) AllErrors() []error { return m }
+
+// ListOrdersResponseValidationError is the validation error returned by
+// ListOrdersResponse.Validate if the designated constraints aren't met.
+type ListOrdersResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListOrdersResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListOrdersResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListOrdersResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListOrdersResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListOrdersResponseValidationError) ErrorName() string {
+	return "ListOrdersResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListOrdersResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListOrdersResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListOrdersResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListOrdersResponseValidationError{}
+
+// Validate checks the field values on StocksInfoBatchRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StocksInfoBatchRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StocksInfoBatchRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StocksInfoBatchRequestMultiError, or nil if none found.
+func (m *StocksInfoBatchRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StocksInfoBatchRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := len(m.GetSkus()); l < 1 || l > 1000 {
+		err := StocksInfoBatchRequestValidationError{
+			field:  "Skus",
+			reason: "value must contain between 1 and 1000 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetSkus() {
+		_, _ = idx, item
+
+		if item <= 0 {
+			err := StocksInfoBatchRequestValidationError{
+				field:  fmt.Sprintf("Skus[%v]", idx),
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return StocksInfoBatchRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// StocksInfoBatchRequestMultiError is an error wrapping multiple validation
+// errors returned by StocksInfoBatchRequest.ValidateAll() if the designated
+// constraints aren't met.
+type StocksInfoBatchRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StocksInfoBatchRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StocksInfoBatchRequestMultiError) AllErrors() []error { return m }
+
+// StocksInfoBatchRequestValidationError is the validation error returned by
+// StocksInfoBatchRequest.Validate if the designated constraints aren't met.
+type StocksInfoBatchRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StocksInfoBatchRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StocksInfoBatchRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StocksInfoBatchRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StocksInfoBatchRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StocksInfoBatchRequestValidationError) ErrorName() string {
+	return "StocksInfoBatchRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StocksInfoBatchRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStocksInfoBatchRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StocksInfoBatchRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StocksInfoBatchRequestValidationError{}
+
+// Validate checks the field values on StockInfo with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *StockInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StockInfo with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in StockInfoMultiError, or nil
+// if none found.
+func (m *StockInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StockInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Sku
+
+	// no validation rules for Found
+
+	// no validation rules for Count
+
+	// no validation rules for TotalCount
+
+	// no validation rules for Reserved
+
+	if len(errors) > 0 {
+		return StockInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// StockInfoMultiError is an error wrapping multiple validation errors returned
+// by StockInfo.ValidateAll() if the designated constraints aren't met.
+type StockInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StockInfoMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StockInfoMultiError) AllErrors() []error { return m }
+
+// StockInfoValidationError is the validation error returned by
+// StockInfo.Validate if the designated constraints aren't met.
+type StockInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StockInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StockInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StockInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StockInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StockInfoValidationError) ErrorName() string { return "StockInfoValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StockInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStockInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StockInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StockInfoValidationError{}
+
+// Validate checks the field values on StocksInfoBatchResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StocksInfoBatchResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StocksInfoBatchResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StocksInfoBatchResponseMultiError, or nil if none found.
+func (m *StocksInfoBatchResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StocksInfoBatchResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetStocks() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, StocksInfoBatchResponseValidationError{
+						field:  fmt.Sprintf("Stocks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, StocksInfoBatchResponseValidationError{
+						field:  fmt.Sprintf("Stocks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StocksInfoBatchResponseValidationError{
+					field:  fmt.Sprintf("Stocks[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return StocksInfoBatchResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// StocksInfoBatchResponseMultiError is an error wrapping multiple validation
+// errors returned by StocksInfoBatchResponse.ValidateAll() if the designated
+// constraints aren't met.
+type StocksInfoBatchResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StocksInfoBatchResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StocksInfoBatchResponseMultiError) AllErrors() []error { return m }
+
+// StocksInfoBatchResponseValidationError is the validation error returned by
+// StocksInfoBatchResponse.Validate if the designated constraints aren't met.
+type StocksInfoBatchResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StocksInfoBatchResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StocksInfoBatchResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StocksInfoBatchResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StocksInfoBatchResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StocksInfoBatchResponseValidationError) ErrorName() string {
+	return "StocksInfoBatchResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StocksInfoBatchResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStocksInfoBatchResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StocksInfoBatchResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StocksInfoBatchResponseValidationError{}
+
+// Validate checks the field values on OrderHistoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *OrderHistoryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OrderHistoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// OrderHistoryRequestMultiError, or nil if none found.
+func (m *OrderHistoryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OrderHistoryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetOrderId() <= 0 {
+		err := OrderHistoryRequestValidationError{
+			field:  "OrderId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return OrderHistoryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// OrderHistoryRequestMultiError is an error wrapping multiple validation
+// errors returned by OrderHistoryRequest.ValidateAll() if the designated
+// constraints aren't met.
+type OrderHistoryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrderHistoryRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrderHistoryRequestMultiError) AllErrors() []error { return m }
+
+// OrderHistoryRequestValidationError is the validation error returned by
+// OrderHistoryRequest.Validate if the designated constraints aren't met.
+type OrderHistoryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrderHistoryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrderHistoryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrderHistoryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrderHistoryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrderHistoryRequestValidationError) ErrorName() string {
+	return "OrderHistoryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e OrderHistoryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrderHistoryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrderHistoryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrderHistoryRequestValidationError{}
+
+// Validate checks the field values on StatusChange with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *StatusChange) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StatusChange with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in StatusChangeMultiError, or
+// nil if none found.
+func (m *StatusChange) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StatusChange) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OldStatus
+
+	// no validation rules for NewStatus
+
+	// no validation rules for Reason
+
+	if all {
+		switch v := interface{}(m.GetChangedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StatusChangeValidationError{
+					field:  "ChangedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StatusChangeValidationError{
+					field:  "ChangedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetChangedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StatusChangeValidationError{
+				field:  "ChangedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return StatusChangeMultiError(errors)
+	}
+
+	return nil
+}
+
+// StatusChangeMultiError is an error wrapping multiple validation errors
+// returned by StatusChange.ValidateAll() if the designated constraints aren't met.
+type StatusChangeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StatusChangeMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StatusChangeMultiError) AllErrors() []error { return m }
+
+// StatusChangeValidationError is the validation error returned by
+// StatusChange.Validate if the designated constraints aren't met.
+type StatusChangeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StatusChangeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StatusChangeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StatusChangeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StatusChangeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StatusChangeValidationError) ErrorName() string { return "StatusChangeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StatusChangeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStatusChange.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StatusChangeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StatusChangeValidationError{}
+
+// Validate checks the field values on OrderHistoryResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *OrderHistoryResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OrderHistoryResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// OrderHistoryResponseMultiError, or nil if none found.
+func (m *OrderHistoryResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OrderHistoryResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetChanges() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, OrderHistoryResponseValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, OrderHistoryResponseValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return OrderHistoryResponseValidationError{
+					field:  fmt.Sprintf("Changes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return OrderHistoryResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// OrderHistoryResponseMultiError is an error wrapping multiple validation
+// errors returned by OrderHistoryResponse.ValidateAll() if the designated
+// constraints aren't met.
+type OrderHistoryResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrderHistoryResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrderHistoryResponseMultiError) AllErrors() []error { return m }
+
+// OrderHistoryResponseValidationError is the validation error returned by
+// OrderHistoryResponse.Validate if the designated constraints aren't met.
+type OrderHistoryResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrderHistoryResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrderHistoryResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrderHistoryResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrderHistoryResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrderHistoryResponseValidationError) ErrorName() string {
+	return "OrderHistoryResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e OrderHistoryResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrderHistoryResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrderHistoryResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrderHistoryResponseValidationError{}
+
+// Validate checks the field values on StockCreateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StockCreateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StockCreateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StockCreateRequestMultiError, or nil if none found.
+func (m *StockCreateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StockCreateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetSku() <= 0 {
+		err := StockCreateRequestValidationError{
+			field:  "Sku",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for WarehouseId
+
+	if m.GetTotalCount() > 2147483647 {
+		err := StockCreateRequestValidationError{
+			field:  "TotalCount",
+			reason: "value must be less than or equal to 2147483647",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return StockCreateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// StockCreateRequestMultiError is an error wrapping multiple validation errors
+// returned by StockCreateRequest.ValidateAll() if the designated constraints
+// aren't met.
+type StockCreateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StockCreateRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StockCreateRequestMultiError) AllErrors() []error { return m }
+
+// StockCreateRequestValidationError is the validation error returned by
+// StockCreateRequest.Validate if the designated constraints aren't met.
+type StockCreateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StockCreateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StockCreateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StockCreateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StockCreateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StockCreateRequestValidationError) ErrorName() string {
+	return "StockCreateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StockCreateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStockCreateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StockCreateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StockCreateRequestValidationError{}
+
+// Validate checks the field values on StockCreateResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StockCreateResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StockCreateResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StockCreateResponseMultiError, or nil if none found.
+func (m *StockCreateResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StockCreateResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetStock()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StockCreateResponseValidationError{
+					field:  "Stock",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StockCreateResponseValidationError{
+					field:  "Stock",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStock()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StockCreateResponseValidationError{
+				field:  "Stock",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return StockCreateResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// StockCreateResponseMultiError is an error wrapping multiple validation
+// errors returned by StockCreateResponse.ValidateAll() if the designated
+// constraints aren't met.
+type StockCreateResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StockCreateResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StockCreateResponseMultiError) AllErrors() []error { return m }
+
+// StockCreateResponseValidationError is the validation error returned by
+// StockCreateResponse.Validate if the designated constraints aren't met.
+type StockCreateResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StockCreateResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StockCreateResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StockCreateResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StockCreateResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StockCreateResponseValidationError) ErrorName() string {
+	return "StockCreateResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StockCreateResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStockCreateResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StockCreateResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StockCreateResponseValidationError{}
+
+// Validate checks the field values on StockReplenishRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StockReplenishRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StockReplenishRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StockReplenishRequestMultiError, or nil if none found.
+func (m *StockReplenishRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StockReplenishRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetSku() <= 0 {
+		err := StockReplenishRequestValidationError{
+			field:  "Sku",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for WarehouseId
+
+	if val := m.GetCount(); val <= 0 || val > 2147483647 {
+		err := StockReplenishRequestValidationError{
+			field:  "Count",
+			reason: "value must be inside range (0, 2147483647]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for IdempotencyKey
+
+	if len(errors) > 0 {
+		return StockReplenishRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// StockReplenishRequestMultiError is an error wrapping multiple validation
+// errors returned by StockReplenishRequest.ValidateAll() if the designated
+// constraints aren't met.
+type StockReplenishRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StockReplenishRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StockReplenishRequestMultiError) AllErrors() []error { return m }
+
+// StockReplenishRequestValidationError is the validation error returned by
+// StockReplenishRequest.Validate if the designated constraints aren't met.
+type StockReplenishRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StockReplenishRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StockReplenishRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StockReplenishRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StockReplenishRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StockReplenishRequestValidationError) ErrorName() string {
+	return "StockReplenishRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StockReplenishRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStockReplenishRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StockReplenishRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StockReplenishRequestValidationError{}
+
+// Validate checks the field values on StockReplenishResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StockReplenishResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StockReplenishResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StockReplenishResponseMultiError, or nil if none found.
+func (m *StockReplenishResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StockReplenishResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetStock()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StockReplenishResponseValidationError{
+					field:  "Stock",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StockReplenishResponseValidationError{
+					field:  "Stock",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStock()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StockReplenishResponseValidationError{
+				field:  "Stock",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return StockReplenishResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// StockReplenishResponseMultiError is an error wrapping multiple validation
+// errors returned by StockReplenishResponse.ValidateAll() if the designated
+// constraints aren't met.
+type StockReplenishResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StockReplenishResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StockReplenishResponseMultiError) AllErrors() []error { return m }
+
+// StockReplenishResponseValidationError is the validation error returned by
+// StockReplenishResponse.Validate if the designated constraints aren't met.
+type StockReplenishResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StockReplenishResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StockReplenishResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StockReplenishResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StockReplenishResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StockReplenishResponseValidationError) ErrorName() string {
+	return "StockReplenishResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StockReplenishResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStockReplenishResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StockReplenishResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StockReplenishResponseValidationError{}
+
+// Validate checks the field values on StockAdjustRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StockAdjustRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StockAdjustRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StockAdjustRequestMultiError, or nil if none found.
+func (m *StockAdjustRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StockAdjustRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetSku() <= 0 {
+		err := StockAdjustRequestValidationError{
+			field:  "Sku",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for WarehouseId
+
+	if _, ok := _StockAdjustRequest_Delta_NotInLookup[m.GetDelta()]; ok {
+		err := StockAdjustRequestValidationError{
+			field:  "Delta",
+			reason: "value must not be in list [0]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetReason()); l < 1 || l > 500 {
+		err := StockAdjustRequestValidationError{
+			field:  "Reason",
+			reason: "value length must be between 1 and 500 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for IdempotencyKey
+
+	if len(errors) > 0 {
+		return StockAdjustRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// StockAdjustRequestMultiError is an error wrapping multiple validation errors
+// returned by StockAdjustRequest.ValidateAll() if the designated constraints
+// aren't met.
+type StockAdjustRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StockAdjustRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StockAdjustRequestMultiError) AllErrors() []error { return m }
+
+// StockAdjustRequestValidationError is the validation error returned by
+// StockAdjustRequest.Validate if the designated constraints aren't met.
+type StockAdjustRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StockAdjustRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StockAdjustRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StockAdjustRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StockAdjustRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StockAdjustRequestValidationError) ErrorName() string {
+	return "StockAdjustRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StockAdjustRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStockAdjustRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StockAdjustRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StockAdjustRequestValidationError{}
+
+var _StockAdjustRequest_Delta_NotInLookup = map[int32]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on StockAdjustResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StockAdjustResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StockAdjustResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StockAdjustResponseMultiError, or nil if none found.
+func (m *StockAdjustResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StockAdjustResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetStock()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StockAdjustResponseValidationError{
+					field:  "Stock",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StockAdjustResponseValidationError{
+					field:  "Stock",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStock()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StockAdjustResponseValidationError{
+				field:  "Stock",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return StockAdjustResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// StockAdjustResponseMultiError is an error wrapping multiple validation
+// errors returned by StockAdjustResponse.ValidateAll() if the designated
+// constraints aren't met.
+type StockAdjustResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StockAdjustResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StockAdjustResponseMultiError) AllErrors() []error { return m }
+
+// StockAdjustResponseValidationError is the validation error returned by
+// StockAdjustResponse.Validate if the designated constraints aren't met.
+type StockAdjustResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StockAdjustResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StockAdjustResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StockAdjustResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StockAdjustResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StockAdjustResponseValidationError) ErrorName() string {
+	return "StockAdjustResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StockAdjustResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStockAdjustResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StockAdjustResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StockAdjustResponseValidationError{}
+
+// Validate checks the field values on StockDeleteRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StockDeleteRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StockDeleteRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StockDeleteRequestMultiError, or nil if none found.
+func (m *StockDeleteRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StockDeleteRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetSku() <= 0 {
+		err := StockDeleteRequestValidationError{
+			field:  "Sku",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for WarehouseId
+
+	if len(errors) > 0 {
+		return StockDeleteRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// StockDeleteRequestMultiError is an error wrapping multiple validation errors
+// returned by StockDeleteRequest.ValidateAll() if the designated constraints
+// aren't met.
+type StockDeleteRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StockDeleteRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StockDeleteRequestMultiError) AllErrors() []error { return m }
+
+// StockDeleteRequestValidationError is the validation error returned by
+// StockDeleteRequest.Validate if the designated constraints aren't met.
+type StockDeleteRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StockDeleteRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StockDeleteRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StockDeleteRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StockDeleteRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StockDeleteRequestValidationError) ErrorName() string {
+	return "StockDeleteRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StockDeleteRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStockDeleteRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StockDeleteRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StockDeleteRequestValidationError{}
+
+// Validate checks the field values on StockDeleteResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StockDeleteResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StockDeleteResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StockDeleteResponseMultiError, or nil if none found.
+func (m *StockDeleteResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StockDeleteResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return StockDeleteResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// StockDeleteResponseMultiError is an error wrapping multiple validation
+// errors returned by StockDeleteResponse.ValidateAll() if the designated
+// constraints aren't met.
+type StockDeleteResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StockDeleteResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StockDeleteResponseMultiError) AllErrors() []error { return m }
+
+// StockDeleteResponseValidationError is the validation error returned by
+// StockDeleteResponse.Validate if the designated constraints aren't met.
+type StockDeleteResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StockDeleteResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StockDeleteResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StockDeleteResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StockDeleteResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StockDeleteResponseValidationError) ErrorName() string {
+	return "StockDeleteResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StockDeleteResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStockDeleteResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StockDeleteResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StockDeleteResponseValidationError{}
@@ -27,6 +27,14 @@ type LomsClient interface {
 	OrderPay(ctx context.Context, in *OrderPayRequest, opts ...grpc.CallOption) (*OrderPayResponse, error)
 	OrderCancel(ctx context.Context, in *OrderCancelRequest, opts ...grpc.CallOption) (*OrderCancelResponse, error)
 	StocksInfo(ctx context.Context, in *StocksInfoRequest, opts ...grpc.CallOption) (*StocksInfoResponse, error)
+	ListOrders(ctx context.Context, in *ListOrdersRequest, opts ...grpc.CallOption) (*ListOrdersResponse, error)
+	StocksInfoBatch(ctx context.Context, in *StocksInfoBatchRequest, opts ...grpc.CallOption) (*StocksInfoBatchResponse, error)
+	OrderHistory(ctx context.Context, in *OrderHistoryRequest, opts ...grpc.CallOption) (*OrderHistoryResponse, error)
+	// Администрирование стоков
+	StockCreate(ctx context.Context, in *StockCreateRequest, opts ...grpc.CallOption) (*StockCreateResponse, error)
+	StockReplenish(ctx context.Context, in *StockReplenishRequest, opts ...grpc.CallOption) (*StockReplenishResponse, error)
+	StockAdjust(ctx context.Context, in *StockAdjustRequest, opts ...grpc.CallOption) (*StockAdjustResponse, error)
+	StockDelete(ctx context.Context, in *StockDeleteRequest, opts ...grpc.CallOption) (*StockDeleteResponse, error)
 }
 
 type lomsClient struct {
@@ -82,6 +90,69 @@ func (c *lomsClient) StocksInfo(ctx context.Context, in *StocksInfoRequest, opts
 	return out, nil
 }
 
+func (c *lomsClient) ListOrders(ctx context.Context, in *ListOrdersRequest, opts ...grpc.CallOption) (*ListOrdersResponse, error) {
+	out := new(ListOrdersResponse)
+	err := c.cc.Invoke(ctx, "/Loms/ListOrders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lomsClient) StocksInfoBatch(ctx context.Context, in *StocksInfoBatchRequest, opts ...grpc.CallOption) (*StocksInfoBatchResponse, error) {
+	out := new(StocksInfoBatchResponse)
+	err := c.cc.Invoke(ctx, "/Loms/StocksInfoBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lomsClient) OrderHistory(ctx context.Context, in *OrderHistoryRequest, opts ...grpc.CallOption) (*OrderHistoryResponse, error) {
+	out := new(OrderHistoryResponse)
+	err := c.cc.Invoke(ctx, "/Loms/OrderHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lomsClient) StockCreate(ctx context.Context, in *StockCreateRequest, opts ...grpc.CallOption) (*StockCreateResponse, error) {
+	out := new(StockCreateResponse)
+	err := c.cc.Invoke(ctx, "/Loms/StockCreate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lomsClient) StockReplenish(ctx context.Context, in *StockReplenishRequest, opts ...grpc.CallOption) (*StockReplenishResponse, error) {
+	out := new(StockReplenishResponse)
+	err := c.cc.Invoke(ctx, "/Loms/StockReplenish", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lomsClient) StockAdjust(ctx context.Context, in *StockAdjustRequest, opts ...grpc.CallOption) (*StockAdjustResponse, error) {
+	out := new(StockAdjustResponse)
+	err := c.cc.Invoke(ctx, "/Loms/StockAdjust", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lomsClient) StockDelete(ctx context.Context, in *StockDeleteRequest, opts ...grpc.CallOption) (*StockDeleteResponse, error) {
+	out := new(StockDeleteResponse)
+	err := c.cc.Invoke(ctx, "/Loms/StockDelete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // LomsServer is the server API for Loms service.
 // All implementations must embed UnimplementedLomsServer
 // for forward compatibility
@@ -91,6 +162,14 @@ type LomsServer interface {
 	OrderPay(context.Context, *OrderPayRequest) (*OrderPayResponse, error)
 	OrderCancel(context.Context, *OrderCancelRequest) (*OrderCancelResponse, error)
 	StocksInfo(context.Context, *StocksInfoRequest) (*StocksInfoResponse, error)
+	ListOrders(context.Context, *ListOrdersRequest) (*ListOrdersResponse, error)
+	StocksInfoBatch(context.Context, *StocksInfoBatchRequest) (*StocksInfoBatchResponse, error)
+	OrderHistory(context.Context, *OrderHistoryRequest) (*OrderHistoryResponse, error)
+	// Администрирование стоков
+	StockCreate(context.Context, *StockCreateRequest) (*StockCreateResponse, error)
+	StockReplenish(context.Context, *StockReplenishRequest) (*StockReplenishResponse, error)
+	StockAdjust(context.Context, *StockAdjustRequest) (*StockAdjustResponse, error)
+	StockDelete(context.Context, *StockDeleteRequest) (*StockDeleteResponse, error)
 	mustEmbedUnimplementedLomsServer()
 }
 
@@ -113,6 +192,27 @@ func (UnimplementedLomsServer) OrderCancel(context.Context, *OrderCancelRequest)
 func (UnimplementedLomsServer) StocksInfo(context.Context, *StocksInfoRequest) (*StocksInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StocksInfo not implemented")
 }
+func (UnimplementedLomsServer) ListOrders(context.Context, *ListOrdersRequest) (*ListOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOrders not implemented")
+}
+func (UnimplementedLomsServer) StocksInfoBatch(context.Context, *StocksInfoBatchRequest) (*StocksInfoBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StocksInfoBatch not implemented")
+}
+func (UnimplementedLomsServer) OrderHistory(context.Context, *OrderHistoryRequest) (*OrderHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OrderHistory not implemented")
+}
+func (UnimplementedLomsServer) StockCreate(context.Context, *StockCreateRequest) (*StockCreateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StockCreate not implemented")
+}
+func (UnimplementedLomsServer) StockReplenish(context.Context, *StockReplenishRequest) (*StockReplenishResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StockReplenish not implemented")
+}
+func (UnimplementedLomsServer) StockAdjust(context.Context, *StockAdjustRequest) (*StockAdjustResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StockAdjust not implemented")
+}
+func (UnimplementedLomsServer) StockDelete(context.Context, *StockDeleteRequest) (*StockDeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StockDelete not implemented")
+}
 func (UnimplementedLomsServer) mustEmbedUnimplementedLomsServer() {}
 
 // UnsafeLomsServer may be embedded to opt out of forward compatibility for this service.
@@ -216,6 +316,132 @@ func _Loms_StocksInfo_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Loms_ListOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LomsServer).ListOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Loms/ListOrders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LomsServer).ListOrders(ctx, req.(*ListOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Loms_StocksInfoBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StocksInfoBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LomsServer).StocksInfoBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Loms/StocksInfoBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LomsServer).StocksInfoBatch(ctx, req.(*StocksInfoBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Loms_OrderHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrderHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LomsServer).OrderHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Loms/OrderHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LomsServer).OrderHistory(ctx, req.(*OrderHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Loms_StockCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StockCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LomsServer).StockCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Loms/StockCreate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LomsServer).StockCreate(ctx, req.(*StockCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Loms_StockReplenish_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StockReplenishRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LomsServer).StockReplenish(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Loms/StockReplenish",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LomsServer).StockReplenish(ctx, req.(*StockReplenishRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Loms_StockAdjust_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StockAdjustRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LomsServer).StockAdjust(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Loms/StockAdjust",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LomsServer).StockAdjust(ctx, req.(*StockAdjustRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Loms_StockDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StockDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LomsServer).StockDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Loms/StockDelete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LomsServer).StockDelete(ctx, req.(*StockDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Loms_ServiceDesc is the grpc.ServiceDesc for Loms service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -243,6 +469,34 @@ var Loms_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "StocksInfo",
 			Handler:    _Loms_StocksInfo_Handler,
 		},
+		{
+			MethodName: "ListOrders",
+			Handler:    _Loms_ListOrders_Handler,
+		},
+		{
+			MethodName: "StocksInfoBatch",
+			Handler:    _Loms_StocksInfoBatch_Handler,
+		},
+		{
+			MethodName: "OrderHistory",
+			Handler:    _Loms_OrderHistory_Handler,
+		},
+		{
+			MethodName: "StockCreate",
+			Handler:    _Loms_StockCreate_Handler,
+		},
+		{
+			MethodName: "StockReplenish",
+			Handler:    _Loms_StockReplenish_Handler,
+		},
+		{
+			MethodName: "StockAdjust",
+			Handler:    _Loms_StockAdjust_Handler,
+		},
+		{
+			MethodName: "StockDelete",
+			Handler:    _Loms_StockDelete_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "loms.proto",
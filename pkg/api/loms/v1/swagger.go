@@ -0,0 +1,8 @@
+package loms
+
+import _ "embed"
+
+// SwaggerJSON OpenAPI-спецификация, сгенерированная protoc-gen-openapiv2 из loms.proto
+//
+//go:embed loms.swagger.json
+var SwaggerJSON []byte